@@ -0,0 +1,267 @@
+// Command protoc-gen-go-resource generates the parsers and the formatters of
+// the resource names declared with the google.api.resource_definition file
+// option.
+//
+// Every variable of a pattern matches a single segment, except a trailing
+// {name=**} variable, which matches the rest of the name. Its value is escaped
+// as a URL path that keeps its slashes, so that it round-trips through the
+// parser and the formatter.
+//
+// The go-resource plugin of buf.gen.yaml runs it with go run, so that the
+// generated files always come from this template.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	annotations "google.golang.org/genproto/googleapis/api/annotations"
+	protogen "google.golang.org/protobuf/compiler/protogen"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func main() {
+	protogen.Options{}.Run(func(plugin *protogen.Plugin) error {
+		for _, file := range plugin.Files {
+			if !file.Generate {
+				continue
+			}
+
+			if err := generate(plugin, file); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// resource is a resource definition of a file.
+type resource struct {
+	// Type is the name of the Go type, such as ParsedObjectName.
+	Type string
+	// Service is the service of the resource type, such as storage.google.com.
+	Service string
+	// Segments are the segments of the pattern.
+	Segments []segment
+}
+
+// segment is a literal or a variable segment of a pattern.
+type segment struct {
+	// Index is the position of the segment in the pattern.
+	Index int
+	// Literal is the value of a literal segment.
+	Literal string
+	// Field is the Go field of a variable segment, such as ObjectID.
+	Field string
+	// Rest reports whether the variable matches the rest of the name.
+	Rest bool
+}
+
+// Var is the name of the local variable of an escaped segment.
+func (x segment) Var() string {
+	return strings.ToLower(x.Field[:1]) + x.Field[1:]
+}
+
+// Rest reports whether the last segment matches the rest of the name.
+func (x resource) Rest() bool {
+	return x.Segments[len(x.Segments)-1].Rest
+}
+
+func generate(plugin *protogen.Plugin, file *protogen.File) error {
+	definitions, _ := proto.GetExtension(file.Desc.Options(), annotations.E_ResourceDefinition).([]*annotations.ResourceDescriptor)
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	var (
+		resources []resource
+		escaped   bool
+	)
+
+	for _, definition := range definitions {
+		for _, pattern := range definition.GetPattern() {
+			r, err := parse(definition.GetType(), pattern)
+			if err != nil {
+				return fmt.Errorf("%s: %w", file.Desc.Path(), err)
+			}
+
+			escaped = escaped || r.Rest()
+			resources = append(resources, r)
+		}
+	}
+
+	var out bytes.Buffer
+	// render the file
+	if err := source.Execute(&out, map[string]any{
+		"Package":   file.GoPackageName,
+		"Escaped":   escaped,
+		"Resources": resources,
+	}); err != nil {
+		return err
+	}
+
+	g := plugin.NewGeneratedFile(file.GeneratedFilenamePrefix+".pb.resource.go", file.GoImportPath)
+	// done!
+	_, err := g.Write(out.Bytes())
+	return err
+}
+
+// parse parses a resource type, such as storage.google.com/Object, and its
+// pattern, such as projects/{project}/buckets/{bucket}/objects/{object=**}.
+func parse(kind, pattern string) (resource, error) {
+	service, name, ok := strings.Cut(kind, "/")
+	if !ok || service == "" || name == "" {
+		return resource{}, fmt.Errorf("resource %q: invalid type", kind)
+	}
+
+	out := resource{
+		Type:    "Parsed" + name + "Name",
+		Service: service,
+	}
+
+	parts := strings.Split(pattern, "/")
+	for index, part := range parts {
+		value, ok := strings.CutPrefix(part, "{")
+		if !ok {
+			out.Segments = append(out.Segments, segment{Index: index, Literal: part})
+			continue
+		}
+
+		value, ok = strings.CutSuffix(value, "}")
+		if !ok {
+			return resource{}, fmt.Errorf("resource %q: invalid segment %q", kind, part)
+		}
+
+		value, match, _ := strings.Cut(value, "=")
+		switch match {
+		case "", "*":
+		case "**":
+			if index != len(parts)-1 {
+				return resource{}, fmt.Errorf("resource %q: segment %q is not the last one", kind, part)
+			}
+		default:
+			return resource{}, fmt.Errorf("resource %q: invalid segment %q", kind, part)
+		}
+
+		out.Segments = append(out.Segments, segment{
+			Index: index,
+			Field: field(value),
+			Rest:  match == "**",
+		})
+	}
+
+	return out, nil
+}
+
+// field returns the Go field of a variable, such as ObjectID for object.
+func field(name string) string {
+	var out string
+
+	for _, word := range strings.Split(name, "_") {
+		if word != "" {
+			out += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	return out + "ID"
+}
+
+var source = template.Must(template.New("resource").Parse(`// Code generated by protoc-gen-go-resource. DO NOT EDIT.
+package {{ .Package }}
+
+import (
+	"fmt"
+{{- if .Escaped }}
+	"net/url"
+{{- end }}
+	"strings"
+)
+{{- range .Resources }}
+{{- $type := .Type }}
+
+type {{ $type }} struct {
+{{- $first := true }}
+{{- range .Segments }}{{ if .Field }}
+{{- if not $first }}
+{{ end }}
+	{{ .Field }} string
+{{- $first = false }}
+{{- end }}{{ end }}
+}
+
+func Parse{{ slice $type 6 }}(s string) ({{ $type }}, error) {
+{{- if .Rest }}
+	p := strings.SplitN(s, "/", {{ len .Segments }})
+{{- else }}
+	p := strings.Split(s, "/")
+{{- end }}
+	if len(p) != {{ len .Segments }} {
+		return {{ $type }}{}, fmt.Errorf("parse %q: bad number of segments, want: {{ len .Segments }}, got: %d", s, len(p))
+	}
+
+	var out {{ $type }}
+{{- range .Segments }}
+{{- if .Field }}
+{{- if .Rest }}
+
+	{{ .Var }}, err := url.PathUnescape(p[{{ .Index }}])
+	if err != nil {
+		return {{ $type }}{}, fmt.Errorf("parse %q: bad segment {{ .Index }}: %w", s, err)
+	}
+
+	out.{{ .Field }} = {{ .Var }}
+{{- else }}
+
+	out.{{ .Field }} = p[{{ .Index }}]
+{{- end }}
+{{- else }}
+
+	if p[{{ .Index }}] != "{{ .Literal }}" {
+		return {{ $type }}{}, fmt.Errorf("parse %q: bad segment {{ .Index }}, want: %q, got: %q", s, "{{ .Literal }}", p[{{ .Index }}])
+	}
+{{- end }}
+{{- end }}
+
+	return out, nil
+}
+
+func ParseFull{{ slice $type 6 }}(s string) ({{ $type }}, error) {
+	if !strings.HasPrefix(s, "//{{ .Service }}/") {
+		return {{ $type }}{}, fmt.Errorf("parse %q: invalid prefix, want: %q", s, "//{{ .Service }}/")
+	}
+
+	return Parse{{ slice $type 6 }}(strings.TrimPrefix(s, "//{{ .Service }}/"))
+}
+
+func (n {{ $type }}) Name() string {
+	var out string
+{{- range $index, $segment := .Segments }}
+{{- if $index }}
+
+	out += "/"
+{{- end }}
+{{- if .Field }}
+{{- if .Rest }}
+
+	out += strings.ReplaceAll(url.PathEscape(n.{{ .Field }}), "%2F", "/")
+{{- else }}
+
+	out += n.{{ .Field }}
+{{- end }}
+{{- else }}
+
+	out += "{{ .Literal }}"
+{{- end }}
+{{- end }}
+
+	return out
+}
+
+func (n {{ $type }}) FullName() string {
+	return "//{{ .Service }}/" + n.Name()
+}
+{{- end }}
+`))
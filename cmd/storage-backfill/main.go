@@ -0,0 +1,152 @@
+// Command storage-backfill publishes a synthetic OBJECT_FINALIZE notification
+// for every existing object of a bucket to a Pub/Sub topic, so that a new
+// event-driven processor catches up with the objects that exist already.
+//
+// Usage:
+//
+//	storage-backfill -bucket my-bucket -project my-project -topic my-topic \
+//		-prefix logs/ -concurrency 8 -rate 100 -checkpoint backfill.json
+//
+// The checkpoint file keeps the name of the last processed object, so that
+// running the command again resumes after it. The -dry-run flag prints the
+// names of the objects without publishing anything: it starts after the
+// checkpoint, but it leaves the checkpoint file as it is.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"time"
+
+	pubsub "cloud.google.com/go/pubsub/v2"
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	storagev1fs "github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fs"
+	storagev1sdk "github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "storage-backfill:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, args []string) error {
+	var (
+		flags         = flag.NewFlagSet("storage-backfill", flag.ExitOnError)
+		bucket        = flags.String("bucket", "", "the bucket to backfill")
+		project       = flags.String("project", "", "the project of the Pub/Sub topic")
+		topic         = flags.String("topic", "", "the Pub/Sub topic the events are published to")
+		prefix        = flags.String("prefix", "", "the prefix of the objects")
+		glob          = flags.String("glob", "", "the glob of the object names")
+		createdAfter  = flags.String("created-after", "", "skip the objects created before this RFC 3339 time")
+		createdBefore = flags.String("created-before", "", "skip the objects created at or after this RFC 3339 time")
+		startAfter    = flags.String("start-after", "", "skip the objects whose name is not after this name")
+		concurrency   = flags.Int("concurrency", 1, "the number of the events published at a time")
+		limit         = flags.Float64("rate", 0, "the maximum number of the events per second, or zero")
+		checkpoint    = flags.String("checkpoint", "", "the file that keeps the name of the last processed object")
+		dryRun        = flags.Bool("dry-run", false, "print the objects without publishing their events")
+	)
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *bucket == "" {
+		return fmt.Errorf("the -bucket flag is required")
+	}
+
+	if !*dryRun && (*project == "" || *topic == "") {
+		return fmt.Errorf("the -project and -topic flags are required without -dry-run")
+	}
+
+	backfill := &storagev1.StorageObjectEventBackfill{
+		Prefix:      *prefix,
+		Glob:        *glob,
+		StartAfter:  *startAfter,
+		Concurrency: *concurrency,
+		Rate:        *limit,
+		DryRun:      *dryRun,
+	}
+
+	var err error
+	// parse the time range
+	if backfill.CreatedAfter, err = parseTime(*createdAfter); err != nil {
+		return err
+	}
+
+	if backfill.CreatedBefore, err = parseTime(*createdBefore); err != nil {
+		return err
+	}
+
+	if *checkpoint != "" {
+		if backfill.Checkpoint, err = storagev1fs.NewStorageObjectEventCheckpoint(*checkpoint); err != nil {
+			return err
+		}
+	}
+
+	client, err := storagev1sdk.NewStorageServiceClient(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	backfill.Bucket = client.Bucket(*bucket)
+
+	if !*dryRun {
+		pclient, err := pubsub.NewClient(ctx, *project)
+		if err != nil {
+			return err
+		}
+		defer pclient.Close()
+
+		publisher := pclient.Publisher(*topic)
+		defer publisher.Stop()
+
+		backfill.StorageObjectEventHandler = &storagev1sdk.StorageEventPubsubPublisher{Publisher: publisher}
+	}
+
+	var count atomic.Int64
+	// report the progress
+	backfill.Progress = func(attrs *storage.ObjectAttrs, err error) {
+		switch {
+		case err != nil:
+			fmt.Fprintf(os.Stderr, "%s: %v\n", attrs.Name, err)
+		case *dryRun:
+			fmt.Println(attrs.Name)
+		default:
+			if n := count.Add(1); n%1000 == 0 {
+				fmt.Fprintf(os.Stderr, "%d objects published, last %s\n", n, attrs.Name)
+			}
+		}
+	}
+
+	if err := backfill.Run(ctx); err != nil {
+		return err
+	}
+
+	if !*dryRun {
+		fmt.Fprintf(os.Stderr, "%d objects published\n", count.Load())
+	}
+
+	// done!
+	return nil
+}
+
+// parseTime parses an optional RFC 3339 time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
@@ -0,0 +1,121 @@
+package storagev1
+
+import (
+	"context"
+	"errors"
+)
+
+// The error occurs when an event has no handler for its kind.
+var ErrStorageEventUnhandled = errors.New("unhandled storage event")
+
+//counterfeiter:generate -o storagev1fake . StorageObjectEventKindHandler
+
+// StorageObjectEventKindHandler handles every kind of the storage object
+// events with a method of its own. A new kind of event adds a method, so that
+// the implementations that do not handle it fail to compile.
+type StorageObjectEventKindHandler interface {
+	// HandleStorageObjectFinalizedEvent handles an OBJECT_FINALIZE event.
+	HandleStorageObjectFinalizedEvent(ctx context.Context, event *StorageObjectFinalizedEvent) error
+	// HandleStorageObjectArchivedEvent handles an OBJECT_ARCHIVE event.
+	HandleStorageObjectArchivedEvent(ctx context.Context, event *StorageObjectArchivedEvent) error
+	// HandleStorageObjectDeletedEvent handles an OBJECT_DELETE event.
+	HandleStorageObjectDeletedEvent(ctx context.Context, event *StorageObjectDeletedEvent) error
+	// HandleStorageObjectMetadataUpdatedEvent handles an OBJECT_METADATA_UPDATE
+	// event.
+	HandleStorageObjectMetadataUpdatedEvent(ctx context.Context, event *StorageObjectMetadataUpdatedEvent) error
+}
+
+// DispatchStorageObjectEvent passes an event to the method of the handler for
+// its kind. It returns ErrStorageEventTypeUnknown for an event of an unknown
+// kind.
+func DispatchStorageObjectEvent(ctx context.Context, event StorageObjectEvent, handler StorageObjectEventKindHandler) error {
+	switch event := event.(type) {
+	case *StorageObjectFinalizedEvent:
+		return handler.HandleStorageObjectFinalizedEvent(ctx, event)
+	case *StorageObjectArchivedEvent:
+		return handler.HandleStorageObjectArchivedEvent(ctx, event)
+	case *StorageObjectDeletedEvent:
+		return handler.HandleStorageObjectDeletedEvent(ctx, event)
+	case *StorageObjectMetadataUpdatedEvent:
+		return handler.HandleStorageObjectMetadataUpdatedEvent(ctx, event)
+	default:
+		return ErrStorageEventTypeUnknown
+	}
+}
+
+var (
+	_ StorageObjectEventHandler     = &StorageObjectEventFuncs{}
+	_ StorageObjectEventKindHandler = &StorageObjectEventFuncs{}
+)
+
+// StorageObjectEventFuncs adapts a func for every kind of the storage object
+// events to a StorageObjectEventHandler, in place of a type switch over the
+// events. The funcs are optional: an event whose func is nil fails with
+// Unhandled, or it is ignored when Unhandled is nil.
+//
+// Example:
+//
+//	handler := &storagev1.StorageObjectEventFuncs{
+//		OnFinalized: func(ctx context.Context, event *storagev1.StorageObjectFinalizedEvent) error {
+//			return index(ctx, event.GetObject())
+//		},
+//		OnDeleted: func(ctx context.Context, event *storagev1.StorageObjectDeletedEvent) error {
+//			return unindex(ctx, event.GetObject())
+//		},
+//		Unhandled: storagev1.NewPermanentStorageEventError(storagev1.ErrStorageEventUnhandled),
+//	}
+type StorageObjectEventFuncs struct {
+	// OnFinalized handles the OBJECT_FINALIZE events.
+	OnFinalized func(ctx context.Context, event *StorageObjectFinalizedEvent) error
+	// OnArchived handles the OBJECT_ARCHIVE events.
+	OnArchived func(ctx context.Context, event *StorageObjectArchivedEvent) error
+	// OnDeleted handles the OBJECT_DELETE events.
+	OnDeleted func(ctx context.Context, event *StorageObjectDeletedEvent) error
+	// OnMetadataUpdated handles the OBJECT_METADATA_UPDATE events.
+	OnMetadataUpdated func(ctx context.Context, event *StorageObjectMetadataUpdatedEvent) error
+	// Unhandled is the error of the events whose func is nil, such as
+	// ErrStorageEventUnhandled. The events are ignored when it is nil.
+	Unhandled error
+}
+
+// HandleStorageObjectEvent implements StorageObjectEventHandler.
+func (x *StorageObjectEventFuncs) HandleStorageObjectEvent(ctx context.Context, event StorageObjectEvent) error {
+	return DispatchStorageObjectEvent(ctx, event, x)
+}
+
+// HandleStorageObjectFinalizedEvent implements StorageObjectEventKindHandler.
+func (x *StorageObjectEventFuncs) HandleStorageObjectFinalizedEvent(ctx context.Context, event *StorageObjectFinalizedEvent) error {
+	if x.OnFinalized == nil {
+		return x.Unhandled
+	}
+
+	return x.OnFinalized(ctx, event)
+}
+
+// HandleStorageObjectArchivedEvent implements StorageObjectEventKindHandler.
+func (x *StorageObjectEventFuncs) HandleStorageObjectArchivedEvent(ctx context.Context, event *StorageObjectArchivedEvent) error {
+	if x.OnArchived == nil {
+		return x.Unhandled
+	}
+
+	return x.OnArchived(ctx, event)
+}
+
+// HandleStorageObjectDeletedEvent implements StorageObjectEventKindHandler.
+func (x *StorageObjectEventFuncs) HandleStorageObjectDeletedEvent(ctx context.Context, event *StorageObjectDeletedEvent) error {
+	if x.OnDeleted == nil {
+		return x.Unhandled
+	}
+
+	return x.OnDeleted(ctx, event)
+}
+
+// HandleStorageObjectMetadataUpdatedEvent implements
+// StorageObjectEventKindHandler.
+func (x *StorageObjectEventFuncs) HandleStorageObjectMetadataUpdatedEvent(ctx context.Context, event *StorageObjectMetadataUpdatedEvent) error {
+	if x.OnMetadataUpdated == nil {
+		return x.Unhandled
+	}
+
+	return x.OnMetadataUpdated(ctx, event)
+}
@@ -0,0 +1,113 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fake"
+)
+
+// unknownEvent is a storage object event of a kind that no handler method
+// handles.
+type unknownEvent struct {
+	*storagev1.StorageObjectFinalizedEvent
+}
+
+func TestDispatchStorageObjectEvent(t *testing.T) {
+	ctx := context.Background()
+
+	finalized := &storagev1.StorageObjectFinalizedEvent{}
+	archived := &storagev1.StorageObjectArchivedEvent{}
+	deleted := &storagev1.StorageObjectDeletedEvent{}
+	updated := &storagev1.StorageObjectMetadataUpdatedEvent{}
+
+	handler := &storagev1fake.FakeStorageObjectEventKindHandler{}
+	handler.HandleStorageObjectDeletedEventReturns(errors.New("boom"))
+
+	for _, event := range []storagev1.StorageObjectEvent{finalized, archived, updated} {
+		if err := storagev1.DispatchStorageObjectEvent(ctx, event, handler); err != nil {
+			t.Errorf("DispatchStorageObjectEvent(%T) = %v, want nil", event, err)
+		}
+	}
+
+	if err := storagev1.DispatchStorageObjectEvent(ctx, deleted, handler); err == nil || err.Error() != "boom" {
+		t.Errorf("DispatchStorageObjectEvent(deleted) = %v, want boom", err)
+	}
+
+	if _, event := handler.HandleStorageObjectFinalizedEventArgsForCall(0); event != finalized {
+		t.Errorf("finalized event = %p, want %p", event, finalized)
+	}
+
+	if _, event := handler.HandleStorageObjectArchivedEventArgsForCall(0); event != archived {
+		t.Errorf("archived event = %p, want %p", event, archived)
+	}
+
+	if _, event := handler.HandleStorageObjectDeletedEventArgsForCall(0); event != deleted {
+		t.Errorf("deleted event = %p, want %p", event, deleted)
+	}
+
+	if _, event := handler.HandleStorageObjectMetadataUpdatedEventArgsForCall(0); event != updated {
+		t.Errorf("metadata updated event = %p, want %p", event, updated)
+	}
+
+	// an event of an unknown kind reaches no method
+	err := storagev1.DispatchStorageObjectEvent(ctx, unknownEvent{finalized}, handler)
+	if !errors.Is(err, storagev1.ErrStorageEventTypeUnknown) {
+		t.Errorf("DispatchStorageObjectEvent(unknown) = %v, want ErrStorageEventTypeUnknown", err)
+	}
+
+	if n := len(handler.Invocations()); n != 4 {
+		t.Errorf("invoked methods = %d, want 4", n)
+	}
+}
+
+func TestStorageObjectEventFuncs(t *testing.T) {
+	ctx := context.Background()
+
+	var called []string
+
+	handler := &storagev1.StorageObjectEventFuncs{
+		OnFinalized: func(ctx context.Context, event *storagev1.StorageObjectFinalizedEvent) error {
+			called = append(called, "finalized")
+			return nil
+		},
+		OnDeleted: func(ctx context.Context, event *storagev1.StorageObjectDeletedEvent) error {
+			called = append(called, "deleted")
+			return errors.New("boom")
+		},
+	}
+
+	if err := handler.HandleStorageObjectEvent(ctx, &storagev1.StorageObjectFinalizedEvent{}); err != nil {
+		t.Errorf("HandleStorageObjectEvent(finalized) = %v, want nil", err)
+	}
+
+	if err := handler.HandleStorageObjectEvent(ctx, &storagev1.StorageObjectDeletedEvent{}); err == nil || err.Error() != "boom" {
+		t.Errorf("HandleStorageObjectEvent(deleted) = %v, want boom", err)
+	}
+
+	// the events without a func are ignored without Unhandled
+	for _, event := range []storagev1.StorageObjectEvent{&storagev1.StorageObjectArchivedEvent{}, &storagev1.StorageObjectMetadataUpdatedEvent{}} {
+		if err := handler.HandleStorageObjectEvent(ctx, event); err != nil {
+			t.Errorf("HandleStorageObjectEvent(%T) = %v, want nil", event, err)
+		}
+	}
+
+	if want := []string{"finalized", "deleted"}; !slices.Equal(called, want) {
+		t.Errorf("called = %v, want %v", called, want)
+	}
+
+	// and they fail with Unhandled when it is set
+	handler.Unhandled = storagev1.NewPermanentStorageEventError(storagev1.ErrStorageEventUnhandled)
+
+	err := handler.HandleStorageObjectEvent(ctx, &storagev1.StorageObjectArchivedEvent{})
+	if !errors.Is(err, storagev1.ErrStorageEventUnhandled) || !storagev1.IsPermanentStorageEventError(err) {
+		t.Errorf("HandleStorageObjectEvent(archived) = %v, want a permanent ErrStorageEventUnhandled", err)
+	}
+
+	if err := handler.HandleStorageObjectEvent(ctx, unknownEvent{&storagev1.StorageObjectFinalizedEvent{}}); !errors.Is(err, storagev1.ErrStorageEventTypeUnknown) {
+		t.Errorf("HandleStorageObjectEvent(unknown) = %v, want ErrStorageEventTypeUnknown", err)
+	}
+}
@@ -0,0 +1,200 @@
+package storagev1
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StorageObjectFromAttrs converts the attributes of an object to a
+// StorageObject. It returns nil when attrs is nil.
+//
+// The conversion is lossless for every attribute that a StorageObject
+// carries, that is all of them except ACL, PredefinedACL, Prefix and
+// Contexts. The crc32c checksum is set when it is not zero or when the object
+// is stored, so that the zero checksum of an empty object is kept.
+func StorageObjectFromAttrs(attrs *storage.ObjectAttrs) *StorageObject {
+	if attrs == nil {
+		return nil
+	}
+
+	object := &StorageObject{
+		ContentEncoding:         attrs.ContentEncoding,
+		ContentDisposition:      attrs.ContentDisposition,
+		CacheControl:            attrs.CacheControl,
+		ContentLanguage:         attrs.ContentLanguage,
+		Metageneration:          attrs.Metageneration,
+		TimeDeleted:             toTimestamp(attrs.Deleted),
+		ContentType:             attrs.ContentType,
+		Size:                    attrs.Size,
+		TimeCreated:             toTimestamp(attrs.Created),
+		ComponentCount:          int32(attrs.ComponentCount),
+		Etag:                    attrs.Etag,
+		Updated:                 toTimestamp(attrs.Updated),
+		StorageClass:            attrs.StorageClass,
+		KmsKeyName:              attrs.KMSKeyName,
+		TemporaryHold:           attrs.TemporaryHold,
+		RetentionExpirationTime: toTimestamp(attrs.RetentionExpirationTime),
+		Metadata:                attrs.Metadata,
+		EventBasedHold:          attrs.EventBasedHold,
+		Name:                    attrs.Name,
+		Bucket:                  attrs.Bucket,
+		Generation:              attrs.Generation,
+		MediaLink:               attrs.MediaLink,
+		CustomTime:              toTimestamp(attrs.CustomTime),
+		TimeFinalized:           toTimestamp(attrs.Finalized),
+		SoftDeleteTime:          toTimestamp(attrs.SoftDeleteTime),
+		HardDeleteTime:          toTimestamp(attrs.HardDeleteTime),
+	}
+
+	if attrs.CRC32C != 0 || attrs.Generation != 0 {
+		object.Crc32C = EncodeCRC32C(attrs.CRC32C)
+	}
+
+	if len(attrs.MD5) > 0 {
+		object.Md5Hash = base64.StdEncoding.EncodeToString(attrs.MD5)
+	}
+
+	if attrs.CustomerKeySHA256 != "" {
+		object.CustomerEncryption = &StorageObject_CustomerEncryption{
+			EncryptionAlgorithm: "AES256",
+			KeySha256:           attrs.CustomerKeySHA256,
+		}
+	}
+
+	if attrs.Owner != "" {
+		object.Owner = &StorageObject_Owner{Entity: attrs.Owner}
+	}
+
+	if attrs.Retention != nil {
+		object.Retention = &StorageObject_Retention{
+			Mode:            attrs.Retention.Mode,
+			RetainUntilTime: toTimestamp(attrs.Retention.RetainUntil),
+		}
+	}
+
+	// the identity of a stored object is derived from its name
+	if attrs.Bucket != "" && attrs.Name != "" && attrs.Generation != 0 {
+		object.Id = attrs.Bucket + "/" + attrs.Name + "/" + strconv.FormatInt(attrs.Generation, 10)
+		object.SelfLink = "https://www.googleapis.com/storage/v1/b/" + url.PathEscape(attrs.Bucket) + "/o/" + url.PathEscape(attrs.Name)
+		object.Kind = "storage#object"
+	}
+
+	return object
+}
+
+// StorageObjectToAttrs converts a StorageObject to the attributes of an
+// object. It returns nil when object is nil, and an error when a checksum is
+// not encoded properly.
+//
+// The conversion is lossless for every field that the attributes carry, that
+// is all of them except time_storage_class_updated and the entity_id of the
+// owner. The id, self_link and kind fields are derived from the name of the
+// object.
+func StorageObjectToAttrs(object *StorageObject) (*storage.ObjectAttrs, error) {
+	if object == nil {
+		return nil, nil
+	}
+
+	attrs := &storage.ObjectAttrs{
+		Bucket:                  object.Bucket,
+		Name:                    object.Name,
+		ContentType:             object.ContentType,
+		ContentLanguage:         object.ContentLanguage,
+		CacheControl:            object.CacheControl,
+		EventBasedHold:          object.EventBasedHold,
+		TemporaryHold:           object.TemporaryHold,
+		RetentionExpirationTime: fromTimestamp(object.RetentionExpirationTime),
+		Owner:                   object.GetOwner().GetEntity(),
+		Size:                    object.Size,
+		ContentEncoding:         object.ContentEncoding,
+		ContentDisposition:      object.ContentDisposition,
+		MediaLink:               object.MediaLink,
+		Metadata:                object.Metadata,
+		Generation:              object.Generation,
+		Metageneration:          object.Metageneration,
+		StorageClass:            object.StorageClass,
+		Created:                 fromTimestamp(object.TimeCreated),
+		Finalized:               fromTimestamp(object.TimeFinalized),
+		Deleted:                 fromTimestamp(object.TimeDeleted),
+		Updated:                 fromTimestamp(object.Updated),
+		CustomerKeySHA256:       object.GetCustomerEncryption().GetKeySha256(),
+		KMSKeyName:              object.KmsKeyName,
+		Etag:                    object.Etag,
+		CustomTime:              fromTimestamp(object.CustomTime),
+		ComponentCount:          int64(object.ComponentCount),
+		SoftDeleteTime:          fromTimestamp(object.SoftDeleteTime),
+		HardDeleteTime:          fromTimestamp(object.HardDeleteTime),
+	}
+
+	if object.Crc32C != "" {
+		crc32c, err := DecodeCRC32C(object.Crc32C)
+		if err != nil {
+			return nil, err
+		}
+
+		attrs.CRC32C = crc32c
+	}
+
+	if object.Md5Hash != "" {
+		md5, err := base64.StdEncoding.DecodeString(object.Md5Hash)
+		if err != nil {
+			return nil, fmt.Errorf("storage: invalid md5 hash %q", object.Md5Hash)
+		}
+
+		attrs.MD5 = md5
+	}
+
+	if retention := object.Retention; retention != nil {
+		attrs.Retention = &storage.ObjectRetention{
+			Mode:        retention.Mode,
+			RetainUntil: fromTimestamp(retention.RetainUntilTime),
+		}
+	}
+
+	return attrs, nil
+}
+
+// EncodeCRC32C encodes a checksum in the base64 big-endian form of the
+// crc32c field.
+func EncodeCRC32C(v uint32) string {
+	data := make([]byte, 4)
+	binary.BigEndian.PutUint32(data, v)
+	// done!
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeCRC32C decodes a checksum from the base64 big-endian form of the
+// crc32c field.
+func DecodeCRC32C(v string) (uint32, error) {
+	data, err := base64.StdEncoding.DecodeString(v)
+	if err != nil || len(data) != 4 {
+		return 0, fmt.Errorf("storage: invalid crc32c %q", v)
+	}
+
+	return binary.BigEndian.Uint32(data), nil
+}
+
+// toTimestamp converts t to a timestamp, keeping the zero time unset.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// fromTimestamp converts ts to a time, keeping an unset timestamp zero.
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
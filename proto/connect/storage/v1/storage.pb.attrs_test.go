@@ -0,0 +1,146 @@
+package storagev1_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestStorageObjectAttrsRoundTrip(t *testing.T) {
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456789, time.UTC)
+
+	cases := map[string]*storage.ObjectAttrs{
+		"every field": {
+			Bucket:                  "bucket",
+			Name:                    "dir/object.txt",
+			ContentType:             "text/plain",
+			ContentLanguage:         "en",
+			CacheControl:            "no-cache",
+			EventBasedHold:          true,
+			TemporaryHold:           true,
+			RetentionExpirationTime: now.Add(time.Hour),
+			Owner:                   "user-owner@example.com",
+			Size:                    42,
+			ContentEncoding:         "gzip",
+			ContentDisposition:      "attachment",
+			MD5:                     []byte("0123456789abcdef"),
+			CRC32C:                  0xdeadbeef,
+			MediaLink:               "https://example.com/media",
+			Metadata:                map[string]string{"key": "value"},
+			Generation:              1714979289123456,
+			Metageneration:          3,
+			StorageClass:            "NEARLINE",
+			Created:                 now,
+			Finalized:               now,
+			Deleted:                 now.Add(2 * time.Hour),
+			Updated:                 now.Add(time.Minute),
+			CustomerKeySHA256:       "c2hh",
+			KMSKeyName:              "projects/p/locations/l/keyRings/r/cryptoKeys/k",
+			Etag:                    "CAE=",
+			CustomTime:              now.Add(-time.Hour),
+			ComponentCount:          7,
+			SoftDeleteTime:          now.Add(3 * time.Hour),
+			HardDeleteTime:          now.Add(4 * time.Hour),
+			Retention: &storage.ObjectRetention{
+				Mode:        "Unlocked",
+				RetainUntil: now.Add(24 * time.Hour),
+			},
+		},
+		"stored empty object": {
+			Bucket:     "bucket",
+			Name:       "empty",
+			Generation: 1,
+			Created:    now,
+		},
+		"request attributes": {
+			Name:        "new",
+			ContentType: "application/json",
+			Metadata:    map[string]string{"a": "b"},
+		},
+		"zero": {},
+	}
+
+	// every carried field is set, so that a new field of the attributes is
+	// noticed
+	value := reflect.ValueOf(cases["every field"]).Elem()
+	for index := range value.NumField() {
+		switch field := value.Type().Field(index); field.Name {
+		case "ACL", "PredefinedACL", "Prefix", "Contexts":
+		default:
+			if value.Field(index).IsZero() {
+				t.Errorf("every field: %s is not set", field.Name)
+			}
+		}
+	}
+
+	for name, attrs := range cases {
+		object := storagev1.StorageObjectFromAttrs(attrs)
+
+		got, err := storagev1.StorageObjectToAttrs(object)
+		if err != nil {
+			t.Fatalf("%s: StorageObjectToAttrs: %v", name, err)
+		}
+
+		if !reflect.DeepEqual(got, attrs) {
+			t.Errorf("%s: round-trip = %+v, want %+v", name, got, attrs)
+		}
+	}
+}
+
+func TestStorageObjectRoundTrip(t *testing.T) {
+	now := timestamppb.New(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC))
+
+	object := &storagev1.StorageObject{
+		Bucket:         "bucket",
+		Name:           "a/b",
+		Generation:     5,
+		Metageneration: 1,
+		Size:           3,
+		Crc32C:         storagev1.EncodeCRC32C(1),
+		Md5Hash:        "AAECAwQFBgcICQoLDA0ODw==",
+		ContentType:    "text/plain",
+		TimeCreated:    now,
+		Updated:        now,
+		Owner:          &storagev1.StorageObject_Owner{Entity: "user-owner"},
+		Id:             "bucket/a/b/5",
+		SelfLink:       "https://www.googleapis.com/storage/v1/b/bucket/o/a%2Fb",
+		Kind:           "storage#object",
+	}
+
+	attrs, err := storagev1.StorageObjectToAttrs(object)
+	if err != nil {
+		t.Fatalf("StorageObjectToAttrs: %v", err)
+	}
+
+	if got := storagev1.StorageObjectFromAttrs(attrs); !proto.Equal(got, object) {
+		t.Errorf("round-trip = %v, want %v", got, object)
+	}
+}
+
+func TestStorageObjectToAttrsError(t *testing.T) {
+	for _, object := range []*storagev1.StorageObject{
+		{Crc32C: "not base64"},
+		{Crc32C: "AAAA"},
+		{Md5Hash: "not base64"},
+	} {
+		if _, err := storagev1.StorageObjectToAttrs(object); err == nil {
+			t.Errorf("StorageObjectToAttrs(%v): want an error", object)
+		}
+	}
+}
+
+func TestStorageObjectAttrsNil(t *testing.T) {
+	if object := storagev1.StorageObjectFromAttrs(nil); object != nil {
+		t.Errorf("StorageObjectFromAttrs(nil) = %v, want nil", object)
+	}
+
+	if attrs, err := storagev1.StorageObjectToAttrs(nil); attrs != nil || err != nil {
+		t.Errorf("StorageObjectToAttrs(nil) = %v, %v, want nil", attrs, err)
+	}
+}
@@ -0,0 +1,329 @@
+package storagev1
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	rate "golang.org/x/time/rate"
+	iterator "google.golang.org/api/iterator"
+)
+
+// DefaultStorageObjectEventCheckpointInterval is the number of the events
+// between two checkpoints of a backfill.
+const DefaultStorageObjectEventCheckpointInterval = 100
+
+//counterfeiter:generate -o storagev1fake . StorageObjectEventCheckpoint
+
+// StorageObjectEventCheckpoint keeps the name of the last object that a
+// backfill has processed, so that it can resume.
+type StorageObjectEventCheckpoint interface {
+	// Load returns the name of the last processed object, or an empty name
+	// when the backfill has not started.
+	Load(ctx context.Context) (string, error)
+	// Save records the name of the last processed object.
+	Save(ctx context.Context, name string) error
+}
+
+// StorageObjectEventBackfill feeds a synthetic StorageObjectFinalizedEvent for
+// every existing object of a bucket to a handler, such as a new event-driven
+// processor that should catch up with the objects that exist already.
+//
+// The objects are listed in the order of their names. The checkpoint records
+// the last name up to which every event has been handled, so that a backfill
+// that fails resumes after it.
+//
+// Example:
+//
+//	backfill := &storagev1.StorageObjectEventBackfill{
+//		Bucket:                    client.Bucket("my-bucket"),
+//		StorageObjectEventHandler: handler,
+//		Prefix:                    "logs/",
+//		Concurrency:               8,
+//		Rate:                      100,
+//	}
+//
+//	if err := backfill.Run(ctx); err != nil {
+//		return err
+//	}
+type StorageObjectEventBackfill struct {
+	// Bucket is the bucket whose objects are backfilled.
+	Bucket StorageBucketHandle
+	// StorageObjectEventHandler is a storage object event handler.
+	StorageObjectEventHandler StorageObjectEventHandler
+	// Prefix is the prefix of the objects.
+	Prefix string
+	// Glob is the glob of the object names, in the syntax of
+	// StorageObjectGlob.
+	Glob string
+	// CreatedAfter skips the objects created before it, when it is set.
+	CreatedAfter time.Time
+	// CreatedBefore skips the objects created at it or after it, when it is
+	// set.
+	CreatedBefore time.Time
+	// StartAfter skips the objects whose name is not after it. It is ignored
+	// when the checkpoint has a name.
+	StartAfter string
+	// Concurrency is the number of the events that are handled at a time. The
+	// default is one.
+	Concurrency int
+	// Rate is the maximum number of the events per second. Zero does not
+	// limit the rate.
+	Rate float64
+	// Checkpoint keeps the name of the last processed object.
+	Checkpoint StorageObjectEventCheckpoint
+	// CheckpointInterval is the number of the events between two
+	// checkpoints. The default is DefaultStorageObjectEventCheckpointInterval.
+	CheckpointInterval int
+	// DryRun lists the objects without handling their events. A dry run
+	// starts after the checkpoint, but it does not save the checkpoint.
+	DryRun bool
+	// Progress is called for every object, with the error of its event.
+	Progress func(attrs *storage.ObjectAttrs, err error)
+}
+
+// Run backfills the objects until every object has been processed, the
+// handler fails or the context is done.
+func (x *StorageObjectEventBackfill) Run(ctx context.Context) error {
+	if x.Glob != "" {
+		if _, err := ParseStorageObjectGlob(x.Glob); err != nil {
+			return err
+		}
+	}
+
+	start := x.StartAfter
+	// resume after the checkpoint
+	if x.Checkpoint != nil {
+		name, err := x.Checkpoint.Load(ctx)
+		if err != nil {
+			return err
+		}
+
+		if name != "" {
+			start = name
+		}
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	query := &storage.Query{Prefix: x.Prefix, MatchGlob: x.Glob}
+	// the start offset is inclusive
+	if start != "" {
+		query.StartOffset = start + "\x00"
+	}
+
+	var limiter *rate.Limiter
+	if x.Rate > 0 {
+		limiter = rate.NewLimiter(rate.Limit(x.Rate), 1)
+	}
+
+	tracker := &watermark{
+		name:     start,
+		interval: x.CheckpointInterval,
+		done:     make(map[int]string),
+	}
+
+	// a dry run processes nothing
+	if x.Checkpoint != nil && !x.DryRun {
+		tracker.save = func(ctx context.Context, name string, _ []string) error {
+			return x.Checkpoint.Save(ctx, name)
+		}
+	}
+
+	if tracker.interval <= 0 {
+		tracker.interval = DefaultStorageObjectEventCheckpointInterval
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, max(x.Concurrency, 1))
+	)
+
+	iter := x.Bucket.Objects(ctx, query)
+	// dispatch the objects
+	for index := 0; ; index++ {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			cancel(err)
+			break
+		}
+
+		if !x.match(attrs) {
+			if err := tracker.add(ctx, index, attrs.Name); err != nil {
+				cancel(err)
+				break
+			}
+
+			continue
+		}
+
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				break
+			}
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(index int, attrs *storage.ObjectAttrs) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			err := x.handle(ctx, attrs)
+			if x.Progress != nil {
+				x.Progress(attrs, err)
+			}
+
+			if err != nil {
+				cancel(err)
+				return
+			}
+
+			if err := tracker.add(ctx, index, attrs.Name); err != nil {
+				cancel(err)
+			}
+		}(index, attrs)
+	}
+
+	wg.Wait()
+	// save the progress, also when the backfill fails
+	err := tracker.flush(context.WithoutCancel(ctx))
+	if cause := context.Cause(ctx); cause != nil {
+		return cause
+	}
+
+	// done!
+	return err
+}
+
+// match reports whether the object is in the time range of the backfill.
+func (x *StorageObjectEventBackfill) match(attrs *storage.ObjectAttrs) bool {
+	if !x.CreatedAfter.IsZero() && attrs.Created.Before(x.CreatedAfter) {
+		return false
+	}
+
+	if !x.CreatedBefore.IsZero() && !attrs.Created.Before(x.CreatedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// handle passes the synthetic event of an object to the handler.
+func (x *StorageObjectEventBackfill) handle(ctx context.Context, attrs *storage.ObjectAttrs) error {
+	if x.DryRun {
+		return nil
+	}
+
+	eventTime := attrs.Finalized
+	if eventTime.IsZero() {
+		eventTime = attrs.Created
+	}
+
+	event := &StorageObjectFinalizedEvent{
+		Object: StorageObjectFromAttrs(attrs),
+		Envelope: &StorageObjectEventEnvelope{
+			EventType:        StorageObjectEventType_OBJECT_FINALIZE,
+			BucketId:         attrs.Bucket,
+			ObjectId:         attrs.Name,
+			ObjectGeneration: attrs.Generation,
+			EventTime:        toTimestamp(eventTime),
+			PayloadFormat:    StoragePayloadFormatJSON,
+		},
+	}
+
+	// done!
+	return x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event)
+}
+
+// watermark tracks the last name up to which every object has been
+// processed, and saves it with the names of the objects after it that have
+// been processed.
+type watermark struct {
+	mu       sync.Mutex
+	save     func(ctx context.Context, name string, done []string) error
+	interval int
+	next     int
+	name     string
+	pending  int
+	done     map[int]string
+}
+
+// add marks the object at the index as processed.
+func (x *watermark) add(ctx context.Context, index int, name string) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.done[index] = name
+	x.pending++
+	// advance the watermark
+	for {
+		name, ok := x.done[x.next]
+		if !ok {
+			break
+		}
+
+		delete(x.done, x.next)
+		x.next++
+		x.name = name
+	}
+
+	if x.pending < x.interval {
+		return nil
+	}
+
+	return x.checkpoint(ctx)
+}
+
+// flush saves the watermark.
+func (x *watermark) flush(ctx context.Context) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.pending == 0 {
+		return nil
+	}
+
+	// done!
+	return x.checkpoint(ctx)
+}
+
+// checkpoint saves the watermark. The caller must hold the lock.
+func (x *watermark) checkpoint(ctx context.Context) error {
+	if x.save == nil {
+		return nil
+	}
+
+	indexes := slices.Sorted(maps.Keys(x.done))
+	// the names after the watermark, in the order of the listing
+	done := make([]string, len(indexes))
+	for k, index := range indexes {
+		done[k] = x.done[index]
+	}
+
+	if err := x.save(ctx, x.name, done); err != nil {
+		return err
+	}
+
+	x.pending = 0
+	// done!
+	return nil
+}
@@ -0,0 +1,252 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+// newObjects returns a memory bucket with the given objects, created a minute
+// apart from the start of 2026.
+func newObjects(t *testing.T, names ...string) storagev1.StorageBucketHandle {
+	t.Helper()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	client := storagev1mem.NewStorageServiceClient()
+	client.SetClock(func() time.Time { return now })
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	for _, name := range names {
+		writer := bucket.Object(name).NewWriter(context.Background())
+		io.WriteString(writer, name)
+
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+
+		now = now.Add(time.Minute)
+	}
+
+	return bucket
+}
+
+// collector is a storage object event handler that collects the names of the
+// objects of its events, and fails the events of the objects in fail.
+type collector struct {
+	mu     sync.Mutex
+	names  []string
+	events []storagev1.StorageObjectEvent
+	fail   map[string]bool
+}
+
+func (x *collector) HandleStorageObjectEvent(ctx context.Context, event storagev1.StorageObjectEvent) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	name := event.GetObject().GetName()
+	if x.fail[name] {
+		return errors.New("boom")
+	}
+
+	x.names = append(x.names, name)
+	x.events = append(x.events, event)
+	return nil
+}
+
+func (x *collector) sorted() []string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	return slices.Sorted(slices.Values(x.names))
+}
+
+func TestStorageObjectEventBackfill(t *testing.T) {
+	bucket := newObjects(t, "a", "logs/b.gz", "logs/c.txt", "logs/d/e.gz", "z")
+
+	cases := []struct {
+		name     string
+		backfill storagev1.StorageObjectEventBackfill
+		want     []string
+	}{
+		{
+			name: "Every",
+			want: []string{"a", "logs/b.gz", "logs/c.txt", "logs/d/e.gz", "z"},
+		},
+		{
+			name:     "Prefix",
+			backfill: storagev1.StorageObjectEventBackfill{Prefix: "logs/"},
+			want:     []string{"logs/b.gz", "logs/c.txt", "logs/d/e.gz"},
+		},
+		{
+			name:     "Glob",
+			backfill: storagev1.StorageObjectEventBackfill{Glob: "logs/**/*.gz"},
+			want:     []string{"logs/b.gz", "logs/d/e.gz"},
+		},
+		{
+			name: "Created",
+			backfill: storagev1.StorageObjectEventBackfill{
+				CreatedAfter:  time.Date(2026, 1, 1, 0, 1, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2026, 1, 1, 0, 3, 0, 0, time.UTC),
+			},
+			want: []string{"logs/b.gz", "logs/c.txt"},
+		},
+		{
+			name:     "StartAfter",
+			backfill: storagev1.StorageObjectEventBackfill{StartAfter: "logs/c.txt"},
+			want:     []string{"logs/d/e.gz", "z"},
+		},
+		{
+			name:     "Concurrency",
+			backfill: storagev1.StorageObjectEventBackfill{Concurrency: 4, Rate: 1000},
+			want:     []string{"a", "logs/b.gz", "logs/c.txt", "logs/d/e.gz", "z"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &collector{}
+
+			backfill := tc.backfill
+			backfill.Bucket = bucket
+			backfill.StorageObjectEventHandler = handler
+
+			if err := backfill.Run(context.Background()); err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+
+			if got := handler.sorted(); !slices.Equal(got, tc.want) {
+				t.Errorf("names = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStorageObjectEventBackfillEvent(t *testing.T) {
+	handler := &collector{}
+	backfill := &storagev1.StorageObjectEventBackfill{
+		Bucket:                    newObjects(t, "a"),
+		StorageObjectEventHandler: handler,
+	}
+
+	if err := backfill.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if len(handler.events) != 1 {
+		t.Fatalf("events = %d, want 1", len(handler.events))
+	}
+
+	event, ok := handler.events[0].(*storagev1.StorageObjectFinalizedEvent)
+	if !ok {
+		t.Fatalf("event = %T, want *storagev1.StorageObjectFinalizedEvent", handler.events[0])
+	}
+
+	if err := storagev1.ValidateStorageObjectEvent(event); err != nil {
+		t.Errorf("ValidateStorageObjectEvent: %v", err)
+	}
+
+	envelope := event.GetEnvelope()
+	if envelope.GetEventType() != storagev1.StorageObjectEventType_OBJECT_FINALIZE || envelope.GetBucketId() != "bucket" || envelope.GetObjectId() != "a" {
+		t.Errorf("envelope = %v, want the finalize event of bucket/a", envelope)
+	}
+
+	if envelope.GetObjectGeneration() != event.GetObject().GetGeneration() || envelope.GetObjectGeneration() == 0 {
+		t.Errorf("envelope.ObjectGeneration = %d, want %d", envelope.GetObjectGeneration(), event.GetObject().GetGeneration())
+	}
+
+	if want := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC); !envelope.GetEventTime().AsTime().Equal(want) {
+		t.Errorf("envelope.EventTime = %v, want %v", envelope.GetEventTime().AsTime(), want)
+	}
+}
+
+func TestStorageObjectEventBackfillResume(t *testing.T) {
+	bucket := newObjects(t, "a", "b", "c", "d", "e")
+	checkpoint := storagev1mem.NewStorageObjectEventCheckpoint()
+
+	handler := &collector{fail: map[string]bool{"c": true}}
+	backfill := &storagev1.StorageObjectEventBackfill{
+		Bucket:                    bucket,
+		StorageObjectEventHandler: handler,
+		Checkpoint:                checkpoint,
+		CheckpointInterval:        1,
+	}
+
+	if err := backfill.Run(context.Background()); err == nil {
+		t.Fatalf("Run() error = nil, want the error of c")
+	}
+
+	if name, _ := checkpoint.Load(context.Background()); name != "b" {
+		t.Errorf("checkpoint = %q, want %q", name, "b")
+	}
+
+	// the next run resumes after the checkpoint
+	handler.fail = nil
+	handler.names = nil
+
+	if err := backfill.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"c", "d", "e"}; !slices.Equal(handler.sorted(), want) {
+		t.Errorf("names = %v, want %v", handler.sorted(), want)
+	}
+
+	if name, _ := checkpoint.Load(context.Background()); name != "e" {
+		t.Errorf("checkpoint = %q, want %q", name, "e")
+	}
+}
+
+func TestStorageObjectEventBackfillDryRun(t *testing.T) {
+	checkpoint := storagev1mem.NewStorageObjectEventCheckpoint()
+	if err := checkpoint.Save(context.Background(), "a"); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	var listed []string
+
+	backfill := &storagev1.StorageObjectEventBackfill{
+		Bucket:             newObjects(t, "a", "b", "c"),
+		Checkpoint:         checkpoint,
+		CheckpointInterval: 1,
+		DryRun:             true,
+		Progress: func(attrs *storage.ObjectAttrs, err error) {
+			listed = append(listed, attrs.Name)
+		},
+	}
+
+	if err := backfill.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"b", "c"}; !slices.Equal(listed, want) {
+		t.Errorf("listed = %v, want %v", listed, want)
+	}
+
+	if name, _ := checkpoint.Load(context.Background()); name != "a" {
+		t.Errorf("checkpoint after a dry run = %q, want %q", name, "a")
+	}
+}
+
+func TestStorageObjectEventBackfillGlob(t *testing.T) {
+	backfill := &storagev1.StorageObjectEventBackfill{
+		Bucket: newObjects(t),
+		Glob:   "logs/[",
+	}
+
+	if err := backfill.Run(context.Background()); err == nil {
+		t.Errorf("Run() with an invalid glob error = nil, want an error")
+	}
+}
@@ -0,0 +1,225 @@
+package storagev1
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+)
+
+// StorageObjectConditions selects the objects of a transfer, as the
+// ObjectConditions of the Storage Transfer Service. An object is selected
+// when it satisfies every condition that is set. The last modification time
+// of an object is the time of its last update.
+//
+// The conditions on the names are pushed down into the listing by Query, so
+// that the listing returns as few objects as possible, and every object of
+// the listing is checked with Match. Validate compiles the glob, so that
+// Match does not parse it for every object; the conditions must not be
+// changed while they are matched.
+//
+// Example:
+//
+//	conditions := &storagev1.StorageObjectConditions{
+//		IncludePrefixes:                     []string{"logs/2024-", "logs/2025-"},
+//		ExcludePrefixes:                     []string{"logs/2024-01"},
+//		MinTimeElapsedSinceLastModification: time.Hour,
+//	}
+type StorageObjectConditions struct {
+	// IncludePrefixes selects the objects whose name has one of the prefixes.
+	IncludePrefixes []string
+	// ExcludePrefixes skips the objects whose name has one of the prefixes.
+	ExcludePrefixes []string
+	// MinTimeElapsedSinceLastModification selects the objects that have not
+	// been modified for at least the duration.
+	MinTimeElapsedSinceLastModification time.Duration
+	// MaxTimeElapsedSinceLastModification selects the objects that have been
+	// modified within the duration.
+	MaxTimeElapsedSinceLastModification time.Duration
+	// LastModifiedSince selects the objects modified at it or after it.
+	LastModifiedSince time.Time
+	// LastModifiedBefore selects the objects modified before it.
+	LastModifiedBefore time.Time
+	// Glob selects the objects whose name matches it, in the syntax of
+	// StorageObjectGlob.
+	Glob string
+
+	// pattern is the glob that glob is compiled from.
+	pattern string
+	glob    *StorageObjectGlob
+}
+
+// compile returns the compiled glob, or nil when there is none. The glob
+// compiled by Validate is used while Glob is unchanged.
+func (x *StorageObjectConditions) compile() (*StorageObjectGlob, error) {
+	switch {
+	case x.Glob == "":
+		return nil, nil
+	case x.glob != nil && x.pattern == x.Glob:
+		return x.glob, nil
+	}
+
+	return ParseStorageObjectGlob(x.Glob)
+}
+
+// Validate reports whether the conditions are consistent.
+func (x *StorageObjectConditions) Validate() error {
+	if x.MinTimeElapsedSinceLastModification < 0 || x.MaxTimeElapsedSinceLastModification < 0 {
+		return errors.New("storage object conditions: negative time elapsed since last modification")
+	}
+
+	if x.MaxTimeElapsedSinceLastModification > 0 && x.MinTimeElapsedSinceLastModification > x.MaxTimeElapsedSinceLastModification {
+		return errors.New("storage object conditions: minimum time elapsed since last modification exceeds the maximum")
+	}
+
+	if !x.LastModifiedSince.IsZero() && !x.LastModifiedBefore.IsZero() && !x.LastModifiedSince.Before(x.LastModifiedBefore) {
+		return errors.New("storage object conditions: last modified since is not before last modified before")
+	}
+
+	glob, err := x.compile()
+	if err != nil {
+		return err
+	}
+
+	x.pattern, x.glob = x.Glob, glob
+	// done!
+	return nil
+}
+
+// MatchName reports whether an object name satisfies the conditions on the
+// names.
+func (x *StorageObjectConditions) MatchName(name string) bool {
+	if len(x.IncludePrefixes) > 0 && !slices.ContainsFunc(x.IncludePrefixes, hasPrefix(name)) {
+		return false
+	}
+
+	if slices.ContainsFunc(x.ExcludePrefixes, hasPrefix(name)) {
+		return false
+	}
+
+	glob, err := x.compile()
+	if err != nil || glob != nil && !glob.Match(name) {
+		return false
+	}
+
+	return true
+}
+
+// Match reports whether an object satisfies the conditions at the given
+// time, which is usually the start of the transfer.
+func (x *StorageObjectConditions) Match(attrs *storage.ObjectAttrs, now time.Time) bool {
+	if !x.MatchName(attrs.Name) {
+		return false
+	}
+
+	modified := attrs.Updated
+	if modified.IsZero() {
+		modified = attrs.Created
+	}
+
+	if x.MinTimeElapsedSinceLastModification > 0 && now.Sub(modified) < x.MinTimeElapsedSinceLastModification {
+		return false
+	}
+
+	if x.MaxTimeElapsedSinceLastModification > 0 && now.Sub(modified) > x.MaxTimeElapsedSinceLastModification {
+		return false
+	}
+
+	if !x.LastModifiedSince.IsZero() && modified.Before(x.LastModifiedSince) {
+		return false
+	}
+
+	if !x.LastModifiedBefore.IsZero() && !modified.Before(x.LastModifiedBefore) {
+		return false
+	}
+
+	return true
+}
+
+// Query returns the query of the objects under the prefix that may satisfy
+// the conditions. The include prefixes and the literal prefix of the glob
+// narrow the prefix of the query, the range of the include prefixes sets its
+// start and end offsets, and the glob is matched by the listing.
+func (x *StorageObjectConditions) Query(prefix string) *storage.Query {
+	query := &storage.Query{Prefix: prefix, MatchGlob: x.Glob}
+
+	if glob, _ := x.compile(); glob != nil {
+		query.Prefix = narrowPrefix(query.Prefix, glob.Prefix())
+	}
+
+	var includes []string
+	// the include prefixes that overlap the prefix of the query
+	for _, item := range x.IncludePrefixes {
+		switch {
+		case strings.HasPrefix(item, query.Prefix):
+			includes = append(includes, item)
+		case strings.HasPrefix(query.Prefix, item):
+			// the include prefix selects every object of the query
+			return query
+		}
+	}
+
+	if len(includes) == 0 {
+		return query
+	}
+
+	slices.Sort(includes)
+	// the common prefix of the include prefixes
+	common := includes[0]
+	for _, item := range includes[1:] {
+		for !strings.HasPrefix(item, common) {
+			common = common[:len(common)-1]
+		}
+	}
+
+	query.Prefix = common
+	query.StartOffset = includes[0]
+	// an include prefix may have another one as its prefix
+	for _, item := range includes {
+		end := prefixSuccessor(item)
+		if end == "" {
+			query.EndOffset = ""
+			break
+		}
+
+		query.EndOffset = max(query.EndOffset, end)
+	}
+
+	// done!
+	return query
+}
+
+// hasPrefix returns a func that reports whether the name has a prefix.
+func hasPrefix(name string) func(string) bool {
+	return func(prefix string) bool {
+		return strings.HasPrefix(name, prefix)
+	}
+}
+
+// narrowPrefix returns the longer of two prefixes when one has the other as
+// its prefix, or the first prefix otherwise.
+func narrowPrefix(prefix, other string) string {
+	if strings.HasPrefix(other, prefix) {
+		return other
+	}
+
+	return prefix
+}
+
+// prefixSuccessor returns the first name after every name with the prefix,
+// or an empty name when there is none.
+func prefixSuccessor(prefix string) string {
+	value := []byte(prefix)
+	for len(value) > 0 {
+		if last := len(value) - 1; value[last] < 0xff {
+			value[last]++
+			return string(value)
+		}
+
+		value = value[:len(value)-1]
+	}
+
+	return ""
+}
@@ -0,0 +1,277 @@
+package storagev1_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestStorageObjectConditionsValidate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		conditions *storagev1.StorageObjectConditions
+		wantErr    bool
+	}{
+		{"Zero", &storagev1.StorageObjectConditions{}, false},
+		{"Elapsed", &storagev1.StorageObjectConditions{MinTimeElapsedSinceLastModification: time.Hour, MaxTimeElapsedSinceLastModification: 2 * time.Hour}, false},
+		{"NegativeElapsed", &storagev1.StorageObjectConditions{MinTimeElapsedSinceLastModification: -time.Hour}, true},
+		{"MinAfterMax", &storagev1.StorageObjectConditions{MinTimeElapsedSinceLastModification: 2 * time.Hour, MaxTimeElapsedSinceLastModification: time.Hour}, true},
+		{"SinceAfterBefore", &storagev1.StorageObjectConditions{LastModifiedSince: now, LastModifiedBefore: now}, true},
+		{"Glob", &storagev1.StorageObjectConditions{Glob: "logs/**/*.gz"}, false},
+		{"InvalidGlob", &storagev1.StorageObjectConditions{Glob: "logs/["}, true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.conditions.Validate(); tc.wantErr != (err != nil) {
+				t.Errorf("Validate() = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestStorageObjectConditionsMatch(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		conditions *storagev1.StorageObjectConditions
+		attrs      *storage.ObjectAttrs
+		want       bool
+	}{
+		{
+			name:       "Zero",
+			conditions: &storagev1.StorageObjectConditions{},
+			attrs:      &storage.ObjectAttrs{Name: "a"},
+			want:       true,
+		},
+		{
+			name:       "Included",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/", "tmp/"}},
+			attrs:      &storage.ObjectAttrs{Name: "logs/a"},
+			want:       true,
+		},
+		{
+			name:       "NotIncluded",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/", "tmp/"}},
+			attrs:      &storage.ObjectAttrs{Name: "other/a"},
+			want:       false,
+		},
+		{
+			name:       "Excluded",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/"}, ExcludePrefixes: []string{"logs/old/"}},
+			attrs:      &storage.ObjectAttrs{Name: "logs/old/a"},
+			want:       false,
+		},
+		{
+			name:       "Glob",
+			conditions: &storagev1.StorageObjectConditions{Glob: "logs/**/*.gz"},
+			attrs:      &storage.ObjectAttrs{Name: "logs/2026/a.gz"},
+			want:       true,
+		},
+		{
+			name:       "NotGlob",
+			conditions: &storagev1.StorageObjectConditions{Glob: "logs/**/*.gz"},
+			attrs:      &storage.ObjectAttrs{Name: "logs/2026/a.txt"},
+			want:       false,
+		},
+		{
+			name:       "InvalidGlob",
+			conditions: &storagev1.StorageObjectConditions{Glob: "logs/["},
+			attrs:      &storage.ObjectAttrs{Name: "logs/["},
+			want:       false,
+		},
+		{
+			name:       "MinElapsed",
+			conditions: &storagev1.StorageObjectConditions{MinTimeElapsedSinceLastModification: time.Hour},
+			attrs:      &storage.ObjectAttrs{Name: "a", Updated: now.Add(-2 * time.Hour)},
+			want:       true,
+		},
+		{
+			name:       "MinElapsedRecent",
+			conditions: &storagev1.StorageObjectConditions{MinTimeElapsedSinceLastModification: time.Hour},
+			attrs:      &storage.ObjectAttrs{Name: "a", Updated: now.Add(-time.Minute)},
+			want:       false,
+		},
+		{
+			name:       "MaxElapsedOld",
+			conditions: &storagev1.StorageObjectConditions{MaxTimeElapsedSinceLastModification: time.Hour},
+			attrs:      &storage.ObjectAttrs{Name: "a", Updated: now.Add(-2 * time.Hour)},
+			want:       false,
+		},
+		{
+			name:       "Created",
+			conditions: &storagev1.StorageObjectConditions{MaxTimeElapsedSinceLastModification: time.Hour},
+			attrs:      &storage.ObjectAttrs{Name: "a", Created: now.Add(-time.Minute)},
+			want:       true,
+		},
+		{
+			name:       "UpdatedOverCreated",
+			conditions: &storagev1.StorageObjectConditions{LastModifiedSince: now.Add(-time.Hour)},
+			attrs:      &storage.ObjectAttrs{Name: "a", Created: now.Add(-2 * time.Hour), Updated: now.Add(-time.Minute)},
+			want:       true,
+		},
+		{
+			name:       "SinceInclusive",
+			conditions: &storagev1.StorageObjectConditions{LastModifiedSince: now},
+			attrs:      &storage.ObjectAttrs{Name: "a", Updated: now},
+			want:       true,
+		},
+		{
+			name:       "BeforeExclusive",
+			conditions: &storagev1.StorageObjectConditions{LastModifiedBefore: now},
+			attrs:      &storage.ObjectAttrs{Name: "a", Updated: now},
+			want:       false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.conditions.Match(tc.attrs, now); got != tc.want {
+				t.Errorf("Match(%s) = %v, want %v", tc.attrs.Name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStorageObjectConditionsQuery(t *testing.T) {
+	cases := []struct {
+		name       string
+		conditions *storagev1.StorageObjectConditions
+		prefix     string
+		want       storage.Query
+	}{
+		{
+			name:       "Zero",
+			conditions: &storagev1.StorageObjectConditions{},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/"},
+		},
+		{
+			name:       "Include",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/2025-"}},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/2025-", StartOffset: "logs/2025-", EndOffset: "logs/2025."},
+		},
+		{
+			name:       "Includes",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/2025-", "logs/2024-"}},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/202", StartOffset: "logs/2024-", EndOffset: "logs/2025."},
+		},
+		{
+			name:       "NestedIncludes",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/a", "logs/ab"}},
+			prefix:     "",
+			want:       storage.Query{Prefix: "logs/a", StartOffset: "logs/a", EndOffset: "logs/b"},
+		},
+		{
+			name:       "IncludeOutside",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"other/", "logs/a"}},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/a", StartOffset: "logs/a", EndOffset: "logs/b"},
+		},
+		{
+			name:       "IncludeWider",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"logs/a", "lo"}},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/"},
+		},
+		{
+			name:       "IncludeLast",
+			conditions: &storagev1.StorageObjectConditions{IncludePrefixes: []string{"\xff"}},
+			prefix:     "",
+			want:       storage.Query{Prefix: "\xff", StartOffset: "\xff"},
+		},
+		{
+			name:       "Glob",
+			conditions: &storagev1.StorageObjectConditions{Glob: "logs/2025/**/*.gz"},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/2025/", MatchGlob: "logs/2025/**/*.gz"},
+		},
+		{
+			name:       "GlobOutside",
+			conditions: &storagev1.StorageObjectConditions{Glob: "other/*.gz"},
+			prefix:     "logs/",
+			want:       storage.Query{Prefix: "logs/", MatchGlob: "other/*.gz"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.conditions.Query(tc.prefix)
+			if got.Prefix != tc.want.Prefix || got.StartOffset != tc.want.StartOffset || got.EndOffset != tc.want.EndOffset || got.MatchGlob != tc.want.MatchGlob {
+				t.Errorf("Query(%q) = %+v, want %+v", tc.prefix, *got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStorageObjectConditionsGlobChanged(t *testing.T) {
+	conditions := &storagev1.StorageObjectConditions{Glob: "logs/**/*.gz"}
+	if err := conditions.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+
+	// the conditions are copied and the glob of the copy is changed
+	copied := *conditions
+	copied.Glob = "logs/**/*.txt"
+
+	if !conditions.MatchName("logs/a.gz") || conditions.MatchName("logs/a.txt") {
+		t.Errorf("MatchName() does not match the glob %q", conditions.Glob)
+	}
+
+	if !copied.MatchName("logs/a.txt") || copied.MatchName("logs/a.gz") {
+		t.Errorf("MatchName() does not match the changed glob %q", copied.Glob)
+	}
+
+	// the glob is changed to an invalid one
+	conditions.Glob = "logs/["
+	if conditions.MatchName("logs/a.gz") {
+		t.Error("MatchName() = true, want false for an invalid glob")
+	}
+
+	if err := conditions.Validate(); err == nil {
+		t.Error("Validate() = nil, want the error of the invalid glob")
+	}
+}
+
+func TestStorageObjectConditionsQueryMatch(t *testing.T) {
+	names := []string{
+		"a", "logs", "logs/", "logs/2024-01", "logs/2024-12/a.gz", "logs/2025-", "logs/2025-01/a.gz",
+		"logs/2025.", "logs/2026-01", "logs/a", "logs/ab", "logs/b", "other/a.gz",
+	}
+
+	conditions := []*storagev1.StorageObjectConditions{
+		{IncludePrefixes: []string{"logs/2025-", "logs/2024-"}},
+		{IncludePrefixes: []string{"logs/a", "logs/ab"}},
+		{IncludePrefixes: []string{"logs/2024-"}, ExcludePrefixes: []string{"logs/2024-12"}},
+		{Glob: "logs/**/*.gz"},
+		{IncludePrefixes: []string{"logs/2025-"}, Glob: "logs/**/*.gz"},
+	}
+
+	// the query lists every name that the conditions match
+	for _, condition := range conditions {
+		query := condition.Query("logs/")
+
+		for _, name := range names {
+			if !strings.HasPrefix(name, "logs/") || !condition.MatchName(name) {
+				continue
+			}
+
+			listed := strings.HasPrefix(name, query.Prefix) &&
+				name >= query.StartOffset &&
+				(query.EndOffset == "" || name < query.EndOffset)
+
+			if !listed {
+				t.Errorf("Query() = %+v does not list %q, which the conditions match", *query, name)
+			}
+		}
+	}
+}
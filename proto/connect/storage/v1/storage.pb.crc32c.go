@@ -0,0 +1,92 @@
+package storagev1
+
+import (
+	"fmt"
+	"hash/crc32"
+)
+
+// crc32cTable is the Castagnoli table used by Cloud Storage.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// StorageChecksumError is the error of an object whose CRC32C checksum does
+// not match the checksum of its data.
+type StorageChecksumError struct {
+	// Name is the name of the object.
+	Name string
+	// Want is the checksum of the data.
+	Want uint32
+	// Got is the checksum of the object.
+	Got uint32
+}
+
+// Error implements error.
+func (x *StorageChecksumError) Error() string {
+	return fmt.Sprintf("storage: object %q has CRC32C %d, want %d", x.Name, x.Got, x.Want)
+}
+
+// crc32cCombine returns the CRC32C checksum of the concatenation of two
+// blocks from their checksums and the length of the second block, as
+// crc32_combine of zlib does.
+func crc32cCombine(crc1, crc2 uint32, len2 int64) uint32 {
+	if len2 <= 0 {
+		return crc1
+	}
+
+	var even, odd [32]uint32
+	// the operator for one zero bit
+	odd[0] = 0x82f63b78
+	row := uint32(1)
+	for n := 1; n < 32; n++ {
+		odd[n] = row
+		row <<= 1
+	}
+
+	// the operators for two and four zero bits
+	gf2MatrixSquare(even[:], odd[:])
+	gf2MatrixSquare(odd[:], even[:])
+
+	// apply the zeros of the second block to the first checksum
+	for {
+		gf2MatrixSquare(even[:], odd[:])
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(even[:], crc1)
+		}
+
+		if len2 >>= 1; len2 == 0 {
+			break
+		}
+
+		gf2MatrixSquare(odd[:], even[:])
+		if len2&1 != 0 {
+			crc1 = gf2MatrixTimes(odd[:], crc1)
+		}
+
+		if len2 >>= 1; len2 == 0 {
+			break
+		}
+	}
+
+	return crc1 ^ crc2
+}
+
+// gf2MatrixTimes multiplies a vector by a matrix over GF(2).
+func gf2MatrixTimes(mat []uint32, vec uint32) uint32 {
+	var sum uint32
+
+	for index := 0; vec != 0; index++ {
+		if vec&1 != 0 {
+			sum ^= mat[index]
+		}
+
+		vec >>= 1
+	}
+
+	return sum
+}
+
+// gf2MatrixSquare sets square to the square of a matrix over GF(2).
+func gf2MatrixSquare(square, mat []uint32) {
+	for n := range 32 {
+		square[n] = gf2MatrixTimes(mat, mat[n])
+	}
+}
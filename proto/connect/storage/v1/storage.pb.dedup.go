@@ -0,0 +1,183 @@
+package storagev1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DefaultStorageObjectEventLease is the period for which a handler claims an
+// event before another delivery may handle it again.
+const DefaultStorageObjectEventLease = 10 * time.Minute
+
+// The error occurs when another delivery of the event is being handled.
+var ErrStorageEventPending = errors.New("storage event is being handled")
+
+// The error occurs when the lease of a claimed event has expired and another
+// delivery has claimed the event.
+var ErrStorageEventClaimLost = errors.New("storage event claim is lost")
+
+// StorageObjectEventState is the state of an event key in a
+// StorageObjectEventStore.
+type StorageObjectEventState int
+
+const (
+	// StorageObjectEventStateNew is the state of a key that the caller has
+	// just claimed.
+	StorageObjectEventStateNew StorageObjectEventState = iota
+	// StorageObjectEventStatePending is the state of a key that is claimed by
+	// another delivery and whose lease has not expired.
+	StorageObjectEventStatePending
+	// StorageObjectEventStateDone is the state of a key whose event has been
+	// handled.
+	StorageObjectEventStateDone
+)
+
+// String returns the string representation.
+func (x StorageObjectEventState) String() string {
+	switch x {
+	case StorageObjectEventStateNew:
+		return "new"
+	case StorageObjectEventStatePending:
+		return "pending"
+	case StorageObjectEventStateDone:
+		return "done"
+	default:
+		return fmt.Sprintf("StorageObjectEventState(%d)", int(x))
+	}
+}
+
+//counterfeiter:generate -o storagev1fake . StorageObjectEventStore
+
+// StorageObjectEventStore records the events that have been handled.
+type StorageObjectEventStore interface {
+	// Acquire claims the key for the lease when it is unknown or when its
+	// lease has expired, and returns StorageObjectEventStateNew with the token
+	// of the claim. Otherwise it returns the state of the key and an empty
+	// token.
+	Acquire(ctx context.Context, key string, lease time.Duration) (StorageObjectEventState, string, error)
+	// Complete marks a key claimed with the token as done. It fails with
+	// ErrStorageEventClaimLost when the claim of the token is no longer the
+	// claim of the key, because another delivery has claimed the key since.
+	Complete(ctx context.Context, key, token string) error
+	// Release drops the claim of a key claimed with the token, so that the
+	// next delivery handles the event again. The claim of another token is
+	// left in place.
+	Release(ctx context.Context, key, token string) error
+}
+
+// StorageObjectEventKey returns the key of an event that identifies the
+// change of the object: its bucket, its name, its generation and the event
+// type, and its metageneration for a metadata update. The redeliveries of a
+// notification and the notifications of the same change to several topics
+// share the key.
+//
+// The notifications without a payload do not carry the metageneration, so the
+// key of their metadata updates falls back to StorageObjectEventMessageKey.
+func StorageObjectEventKey(event StorageObjectEvent) string {
+	object := event.GetObject()
+	envelope := event.GetEnvelope()
+
+	bucket := object.GetBucket()
+	if bucket == "" {
+		bucket = envelope.GetBucketId()
+	}
+
+	name := object.GetName()
+	if name == "" {
+		name = envelope.GetObjectId()
+	}
+
+	generation := object.GetGeneration()
+	if generation == 0 {
+		generation = envelope.GetObjectGeneration()
+	}
+
+	kind := StorageObjectEventTypeOf(event)
+	// a generation is finalized, archived and deleted once, and bucket names
+	// cannot contain a slash
+	if kind != StorageObjectEventType_OBJECT_METADATA_UPDATE {
+		return fmt.Sprintf("%s/%s#%d@%s", bucket, name, generation, kind)
+	}
+
+	metageneration := object.GetMetageneration()
+	if metageneration == 0 {
+		return StorageObjectEventMessageKey(event)
+	}
+
+	return fmt.Sprintf("%s/%s#%d.%d@%s", bucket, name, generation, metageneration, kind)
+}
+
+// StorageObjectEventMessageKey returns the key of an event that identifies
+// the notification: the ID of its message, or an empty key when the event has
+// no message ID.
+func StorageObjectEventMessageKey(event StorageObjectEvent) string {
+	return event.GetEnvelope().GetMessageId()
+}
+
+var _ StorageObjectEventHandler = &StorageObjectEventDeduplicator{}
+
+// StorageObjectEventDeduplicator is a StorageObjectEventHandler that passes
+// every event to its handler once, however many times it is delivered.
+//
+// The event is claimed in the store before it is handled. It is marked as
+// done when the handler succeeds, and it is released when the handler fails,
+// so that a redelivery handles it again. A delivery of an event that is done
+// succeeds without calling the handler, and a delivery of an event that is
+// claimed by another delivery fails with ErrStorageEventPending, so that it is
+// redelivered later.
+type StorageObjectEventDeduplicator struct {
+	// StorageObjectEventHandler is a storage object event handler.
+	StorageObjectEventHandler StorageObjectEventHandler
+	// StorageObjectEventStore is the store of the handled events.
+	StorageObjectEventStore StorageObjectEventStore
+	// Key returns the key of an event. The events without a key are always
+	// handled. The default is StorageObjectEventKey.
+	Key func(StorageObjectEvent) string
+	// Lease is the period for which an event is claimed. It should exceed the
+	// time the handler takes. The default is DefaultStorageObjectEventLease.
+	Lease time.Duration
+}
+
+// HandleStorageObjectEvent implements StorageObjectEventHandler.
+func (x *StorageObjectEventDeduplicator) HandleStorageObjectEvent(ctx context.Context, event StorageObjectEvent) error {
+	key := x.key(event)
+	if key == "" {
+		return x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event)
+	}
+
+	lease := x.Lease
+	if lease <= 0 {
+		lease = DefaultStorageObjectEventLease
+	}
+
+	state, token, err := x.StorageObjectEventStore.Acquire(ctx, key, lease)
+	if err != nil {
+		return err
+	}
+
+	switch state {
+	case StorageObjectEventStateNew:
+	case StorageObjectEventStateDone:
+		return nil
+	default:
+		return ErrStorageEventPending
+	}
+
+	// handle the event
+	if err := x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event); err != nil {
+		return errors.Join(err, x.StorageObjectEventStore.Release(context.WithoutCancel(ctx), key, token))
+	}
+
+	// done!
+	return x.StorageObjectEventStore.Complete(context.WithoutCancel(ctx), key, token)
+}
+
+func (x *StorageObjectEventDeduplicator) key(event StorageObjectEvent) string {
+	if x.Key != nil {
+		return x.Key(event)
+	}
+
+	return StorageObjectEventKey(event)
+}
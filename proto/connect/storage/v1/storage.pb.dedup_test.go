@@ -0,0 +1,271 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+// counter is a storage object event handler that counts its calls and fails
+// with err.
+type counter struct {
+	calls int
+	err   error
+}
+
+func (x *counter) HandleStorageObjectEvent(context.Context, storagev1.StorageObjectEvent) error {
+	x.calls++
+	return x.err
+}
+
+func TestStorageObjectEventKey(t *testing.T) {
+	cases := []struct {
+		name  string
+		event storagev1.StorageObjectEvent
+		want  string
+	}{
+		{
+			name: "Object",
+			event: &storagev1.StorageObjectFinalizedEvent{
+				Object: &storagev1.StorageObject{Bucket: "bucket", Name: "a/b", Generation: 7, Metageneration: 1},
+			},
+			want: "bucket/a/b#7@OBJECT_FINALIZE",
+		},
+		{
+			name: "Envelope",
+			event: &storagev1.StorageObjectFinalizedEvent{
+				Object:   &storagev1.StorageObject{},
+				Envelope: &storagev1.StorageObjectEventEnvelope{BucketId: "bucket", ObjectId: "a/b", ObjectGeneration: 7},
+			},
+			want: "bucket/a/b#7@OBJECT_FINALIZE",
+		},
+		{
+			name: "MetadataUpdate",
+			event: &storagev1.StorageObjectMetadataUpdatedEvent{
+				Object:   &storagev1.StorageObject{Bucket: "bucket", Name: "a/b", Generation: 7, Metageneration: 2},
+				Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "42"},
+			},
+			want: "bucket/a/b#7.2@OBJECT_METADATA_UPDATE",
+		},
+		{
+			name: "MetadataUpdateWithoutPayload",
+			event: &storagev1.StorageObjectMetadataUpdatedEvent{
+				Object:   &storagev1.StorageObject{Bucket: "bucket", Name: "a/b", Generation: 7},
+				Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "42", PayloadFormat: storagev1.StoragePayloadFormatNone},
+			},
+			want: "42",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := storagev1.StorageObjectEventKey(tc.event); got != tc.want {
+				t.Errorf("StorageObjectEventKey() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+
+	// the notifications of a change with and without a payload share the key
+	attrs := map[string]string{"eventType": "OBJECT_FINALIZE", "bucketId": "bucket", "objectId": "a/b", "objectGeneration": "7"}
+
+	keys := make(map[string]bool)
+	for _, format := range []string{storagev1.StoragePayloadFormatJSON, storagev1.StoragePayloadFormatNone} {
+		attrs["payloadFormat"] = format
+
+		event, err := storagev1.NewStorageObjectPubsubEvent(attrs, []byte(`{"bucket":"bucket","name":"a/b","generation":"7","metageneration":"1"}`), "message-"+format, time.Now())
+		if err != nil {
+			t.Fatalf("NewStorageObjectPubsubEvent: %v", err)
+		}
+
+		keys[storagev1.StorageObjectEventKey(event)] = true
+	}
+
+	if len(keys) != 1 {
+		t.Errorf("StorageObjectEventKey() of the payload formats = %v, want a single key", keys)
+	}
+
+	event := &storagev1.StorageObjectFinalizedEvent{Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "42"}}
+	if got := storagev1.StorageObjectEventMessageKey(event); got != "42" {
+		t.Errorf("StorageObjectEventMessageKey() = %q, want %q", got, "42")
+	}
+}
+
+func TestStorageObjectEventDeduplicator(t *testing.T) {
+	handler := &counter{}
+	dedup := &storagev1.StorageObjectEventDeduplicator{
+		StorageObjectEventHandler: handler,
+		StorageObjectEventStore:   storagev1mem.NewStorageObjectEventStore(0, 0),
+	}
+
+	event := newFinalizedEvent("bucket", "a", "", nil)
+
+	for range 3 {
+		if err := dedup.HandleStorageObjectEvent(context.Background(), event); err != nil {
+			t.Fatalf("HandleStorageObjectEvent: %v", err)
+		}
+	}
+
+	if handler.calls != 1 {
+		t.Errorf("calls = %d, want 1", handler.calls)
+	}
+
+	if err := dedup.HandleStorageObjectEvent(context.Background(), newFinalizedEvent("bucket", "b", "", nil)); err != nil {
+		t.Fatalf("HandleStorageObjectEvent: %v", err)
+	}
+
+	if handler.calls != 2 {
+		t.Errorf("calls after another event = %d, want 2", handler.calls)
+	}
+}
+
+func TestStorageObjectEventDeduplicatorRelease(t *testing.T) {
+	failure := errors.New("boom")
+	handler := &counter{err: failure}
+	dedup := &storagev1.StorageObjectEventDeduplicator{
+		StorageObjectEventHandler: handler,
+		StorageObjectEventStore:   storagev1mem.NewStorageObjectEventStore(0, 0),
+	}
+
+	event := newFinalizedEvent("bucket", "a", "", nil)
+
+	if err := dedup.HandleStorageObjectEvent(context.Background(), event); !errors.Is(err, failure) {
+		t.Fatalf("HandleStorageObjectEvent() error = %v, want %v", err, failure)
+	}
+
+	// the failed event is handled again
+	handler.err = nil
+	if err := dedup.HandleStorageObjectEvent(context.Background(), event); err != nil {
+		t.Fatalf("HandleStorageObjectEvent: %v", err)
+	}
+
+	if handler.calls != 2 {
+		t.Errorf("calls = %d, want 2", handler.calls)
+	}
+}
+
+func TestStorageObjectEventDeduplicatorPending(t *testing.T) {
+	store := storagev1mem.NewStorageObjectEventStore(0, 0)
+	event := newFinalizedEvent("bucket", "a", "", nil)
+
+	// another delivery claims the event
+	if _, _, err := store.Acquire(context.Background(), storagev1.StorageObjectEventKey(event), time.Minute); err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+
+	handler := &counter{}
+	dedup := &storagev1.StorageObjectEventDeduplicator{
+		StorageObjectEventHandler: handler,
+		StorageObjectEventStore:   store,
+	}
+
+	if err := dedup.HandleStorageObjectEvent(context.Background(), event); !errors.Is(err, storagev1.ErrStorageEventPending) {
+		t.Errorf("HandleStorageObjectEvent() error = %v, want %v", err, storagev1.ErrStorageEventPending)
+	}
+
+	if handler.calls != 0 {
+		t.Errorf("calls = %d, want 0", handler.calls)
+	}
+}
+
+func TestStorageObjectEventDeduplicatorClaimLost(t *testing.T) {
+	failure := errors.New("boom")
+
+	for _, result := range []error{nil, failure} {
+		now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		store := storagev1mem.NewStorageObjectEventStore(0, 0)
+		store.SetClock(func() time.Time { return now })
+
+		var (
+			calls int
+			dedup *storagev1.StorageObjectEventDeduplicator
+		)
+
+		event := newFinalizedEvent("bucket", "a", "", nil)
+		// the first delivery outlives its lease, and a redelivery takes the
+		// event over and handles it
+		handler := storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, event storagev1.StorageObjectEvent) error {
+			if calls++; calls == 1 {
+				now = now.Add(2 * time.Minute)
+
+				if err := dedup.HandleStorageObjectEvent(ctx, event); err != nil {
+					t.Fatalf("HandleStorageObjectEvent() of the redelivery: %v", err)
+				}
+
+				return result
+			}
+
+			return nil
+		})
+
+		dedup = &storagev1.StorageObjectEventDeduplicator{
+			StorageObjectEventHandler: handler,
+			StorageObjectEventStore:   store,
+			Lease:                     time.Minute,
+		}
+
+		want := storagev1.ErrStorageEventClaimLost
+		if result != nil {
+			want = failure
+		}
+
+		if got := dedup.HandleStorageObjectEvent(context.Background(), event); !errors.Is(got, want) {
+			t.Errorf("HandleStorageObjectEvent() of the late delivery error = %v, want %v", got, want)
+		}
+
+		// the late delivery leaves the event done
+		if err := dedup.HandleStorageObjectEvent(context.Background(), event); err != nil {
+			t.Fatalf("HandleStorageObjectEvent: %v", err)
+		}
+
+		if calls != 2 {
+			t.Errorf("calls = %d, want 2", calls)
+		}
+	}
+}
+
+func TestStorageObjectEventDeduplicatorKey(t *testing.T) {
+	handler := &counter{}
+	dedup := &storagev1.StorageObjectEventDeduplicator{
+		StorageObjectEventHandler: handler,
+		StorageObjectEventStore:   storagev1mem.NewStorageObjectEventStore(0, 0),
+		Key:                       storagev1.StorageObjectEventMessageKey,
+	}
+
+	events := []storagev1.StorageObjectEvent{
+		&storagev1.StorageObjectFinalizedEvent{Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "1"}},
+		&storagev1.StorageObjectFinalizedEvent{Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "1"}},
+		// the events without a key are always handled
+		&storagev1.StorageObjectFinalizedEvent{},
+		&storagev1.StorageObjectFinalizedEvent{},
+	}
+
+	for _, event := range events {
+		if err := dedup.HandleStorageObjectEvent(context.Background(), event); err != nil {
+			t.Fatalf("HandleStorageObjectEvent: %v", err)
+		}
+	}
+
+	if handler.calls != 3 {
+		t.Errorf("calls = %d, want 3", handler.calls)
+	}
+}
+
+func TestStorageObjectEventState(t *testing.T) {
+	cases := map[storagev1.StorageObjectEventState]string{
+		storagev1.StorageObjectEventStateNew:     "new",
+		storagev1.StorageObjectEventStatePending: "pending",
+		storagev1.StorageObjectEventStateDone:    "done",
+		storagev1.StorageObjectEventState(9):     "StorageObjectEventState(9)",
+	}
+
+	for state, want := range cases {
+		if got := state.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
@@ -28,9 +28,96 @@ var (
 	_ *wrapperspb.BoolValue
 )
 
+func (x *StorageBucket) Default() {
+}
+
+func (x *GetBucketRequest) Default() {
+}
+
+func (x *GetBucketResponse) Default() {
+}
+
+func (x *ListBucketsRequest) Default() {
+}
+
+func (x *ListBucketsResponse) Default() {
+}
+
+func (x *CreateBucketRequest) Default() {
+}
+
+func (x *CreateBucketResponse) Default() {
+}
+
+func (x *UpdateBucketRequest) Default() {
+}
+
+func (x *UpdateBucketResponse) Default() {
+}
+
+func (x *DeleteBucketRequest) Default() {
+}
+
+func (x *DeleteBucketResponse) Default() {
+}
+
+func (x *GetObjectRequest) Default() {
+}
+
+func (x *GetObjectResponse) Default() {
+}
+
+func (x *ListObjectsRequest) Default() {
+}
+
+func (x *ListObjectsResponse) Default() {
+}
+
+func (x *ReadObjectRequest) Default() {
+}
+
+func (x *ReadObjectResponse) Default() {
+}
+
+func (x *WriteObjectSpec) Default() {
+}
+
+func (x *WriteObjectRequest) Default() {
+}
+
+func (x *WriteObjectResponse) Default() {
+}
+
+func (x *UpdateObjectRequest) Default() {
+}
+
+func (x *UpdateObjectResponse) Default() {
+}
+
+func (x *DeleteObjectRequest) Default() {
+}
+
+func (x *DeleteObjectResponse) Default() {
+}
+
+func (x *CopyObjectRequest) Default() {
+}
+
+func (x *CopyObjectResponse) Default() {
+}
+
+func (x *ComposeObjectRequest) Default() {
+}
+
+func (x *ComposeObjectResponse) Default() {
+}
+
 func (x *StorageObject) Default() {
 }
 
+func (x *StorageObjectEventEnvelope) Default() {
+}
+
 func (x *StorageObjectFinalizedEvent) Default() {
 }
 
@@ -43,5 +130,17 @@ func (x *StorageObjectDeletedEvent) Default() {
 func (x *StorageObjectMetadataUpdatedEvent) Default() {
 }
 
+func (x *StorageBucket_RetentionPolicy) Default() {
+}
+
+func (x *ComposeObjectRequest_SourceObject) Default() {
+}
+
 func (x *StorageObject_CustomerEncryption) Default() {
 }
+
+func (x *StorageObject_Owner) Default() {
+}
+
+func (x *StorageObject_Retention) Default() {
+}
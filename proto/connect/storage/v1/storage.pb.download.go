@@ -0,0 +1,231 @@
+package storagev1
+
+import (
+	"context"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+)
+
+const (
+	// DefaultStorageSlicedDownloadSlices is the number of the slices of a
+	// sliced download.
+	DefaultStorageSlicedDownloadSlices = 16
+	// DefaultStorageSlicedDownloadAttempts is the number of the attempts of
+	// every slice of a sliced download.
+	DefaultStorageSlicedDownloadAttempts = 3
+	// DefaultStorageSlicedDownloadBackoff is the backoff before the first
+	// retry of a slice.
+	DefaultStorageSlicedDownloadBackoff = 100 * time.Millisecond
+)
+
+// StorageSlicedDownloader downloads an object as a sliced download: it reads
+// byte ranges of the object concurrently with NewRangeReader and writes them
+// at their offsets. The ranges read the generation of the object at the start
+// of the download, so that they belong to the same data.
+//
+// A slice that fails is retried from the byte where it stopped. The CRC32C
+// checksum of the object is combined from the checksums of the slices and
+// checked against the checksum of the object. The stored bytes of the object
+// are downloaded, without decompressing the objects whose content encoding is
+// gzip.
+//
+// Example:
+//
+//	downloader := &storagev1.StorageSlicedDownloader{
+//		Slices:      16,
+//		Concurrency: 8,
+//	}
+//
+//	attrs, err := downloader.DownloadFile(ctx, client.Bucket("my-bucket").Object("backup.tar"), "/var/backup.tar")
+type StorageSlicedDownloader struct {
+	// Slices is the number of the slices. The default is
+	// DefaultStorageSlicedDownloadSlices.
+	Slices int
+	// Concurrency is the number of the slices that are read at a time. The
+	// default is the number of the slices.
+	Concurrency int
+	// MaxAttempts is the maximum number of the attempts of a slice, including
+	// the first one. The default is DefaultStorageSlicedDownloadAttempts.
+	MaxAttempts int
+	// Backoff is the backoff before the first retry of a slice, which doubles
+	// after each retry. The default is DefaultStorageSlicedDownloadBackoff.
+	Backoff time.Duration
+	// ShouldRetry reports whether the error of a slice is transient. The
+	// default is IsRetryableStorageError.
+	ShouldRetry func(err error) bool
+}
+
+// DownloadFile downloads an object into a local file, which is created or
+// truncated. The file is removed when the download fails.
+func (x *StorageSlicedDownloader) DownloadFile(ctx context.Context, handle StorageObjectHandle, name string) (_ *storage.ObjectAttrs, err error) {
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+
+		if err != nil {
+			os.Remove(name)
+		}
+	}()
+
+	// done!
+	return x.Download(ctx, handle, file)
+}
+
+// Download downloads an object into the writer, and returns the attributes of
+// the downloaded generation.
+func (x *StorageSlicedDownloader) Download(ctx context.Context, handle StorageObjectHandle, w io.WriterAt) (*storage.ObjectAttrs, error) {
+	attrs, err := handle.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// pin the generation
+	handle = handle.Generation(attrs.Generation).ReadCompressed(true)
+
+	count := int64(x.Slices)
+	if count <= 0 {
+		count = DefaultStorageSlicedDownloadSlices
+	}
+
+	size := attrs.Size
+	// every slice has at least one byte
+	count = max(min(count, size), 1)
+	length := max((size+count-1)/count, 1)
+	count = max((size+length-1)/length, 1)
+
+	var (
+		crcs = make([]uint32, count)
+		jobs = make([]func(context.Context) error, count)
+	)
+
+	for index := range count {
+		offset := index * length
+		slice := &storageSlice{
+			handle: handle,
+			writer: io.NewOffsetWriter(w, offset),
+			offset: offset,
+			length: min(length, size-offset),
+			crc:    crc32.New(crc32cTable),
+		}
+
+		jobs[index] = func(ctx context.Context) error {
+			if err := x.retry(ctx, slice.read); err != nil {
+				return err
+			}
+
+			crcs[index] = slice.crc.Sum32()
+			return nil
+		}
+	}
+
+	// read the slices
+	if err := runConcurrently(ctx, x.Concurrency, jobs); err != nil {
+		return nil, err
+	}
+
+	var crc uint32
+	// the checksum of the object
+	for index, value := range crcs {
+		offset := int64(index) * length
+		crc = crc32cCombine(crc, value, min(length, size-offset))
+	}
+
+	if crc != attrs.CRC32C {
+		return nil, &StorageChecksumError{Name: attrs.Name, Want: attrs.CRC32C, Got: crc}
+	}
+
+	// done!
+	return attrs, nil
+}
+
+// retry calls fn until it succeeds, fails with a permanent error or runs out
+// of attempts.
+func (x *StorageSlicedDownloader) retry(ctx context.Context, fn func(context.Context) error) error {
+	attempts := x.MaxAttempts
+	if attempts <= 0 {
+		attempts = DefaultStorageSlicedDownloadAttempts
+	}
+
+	backoff := x.Backoff
+	if backoff <= 0 {
+		backoff = DefaultStorageSlicedDownloadBackoff
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || attempt >= attempts || !x.retryable(err) {
+			return err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+	}
+}
+
+// retryable reports whether the error of a slice is transient.
+func (x *StorageSlicedDownloader) retryable(err error) bool {
+	if x.ShouldRetry != nil {
+		return x.ShouldRetry(err)
+	}
+
+	// done!
+	return IsRetryableStorageError(err)
+}
+
+// storageSlice is a byte range of a sliced download, and the progress of its
+// reads.
+type storageSlice struct {
+	handle StorageObjectHandle
+	writer io.Writer
+	offset int64
+	length int64
+	crc    hash.Hash32
+}
+
+// read reads the rest of the slice, from the byte where the previous read
+// stopped.
+func (x *storageSlice) read(ctx context.Context) error {
+	if x.length == 0 {
+		return nil
+	}
+
+	reader, err := x.handle.NewRangeReader(ctx, x.offset, x.length)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	n, err := io.Copy(io.MultiWriter(x.writer, x.crc), reader)
+	// resume after the bytes that have been written
+	x.offset += n
+	x.length -= n
+
+	if err != nil {
+		return err
+	}
+
+	if x.length > 0 {
+		return io.ErrUnexpectedEOF
+	}
+
+	// done!
+	return nil
+}
@@ -0,0 +1,227 @@
+package storagev1_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1chaos"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+// buffer is an io.WriterAt in memory.
+type buffer struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+func (x *buffer) WriteAt(p []byte, offset int64) (int, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if end := int(offset) + len(p); end > len(x.data) {
+		x.data = append(x.data, make([]byte, end-len(x.data))...)
+	}
+
+	// done!
+	return copy(x.data[offset:], p), nil
+}
+
+// corruptHandle is a storage object handle whose attributes have another
+// checksum than its data.
+type corruptHandle struct {
+	storagev1.StorageObjectHandle
+}
+
+func (x corruptHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	attrs, err := x.StorageObjectHandle.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs.CRC32C++
+	return attrs, nil
+}
+
+// newDownloadObject returns a chaos client on top of a memory backend with an
+// object of the given data.
+func newDownloadObject(t *testing.T, data []byte) *storagev1chaos.StorageServiceClient {
+	t.Helper()
+
+	backend := storagev1mem.NewStorageServiceClient()
+
+	writer := newStorageBucket(t, backend, "bucket").Object("object").NewWriter(context.Background())
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	return storagev1chaos.NewStorageServiceClient(backend)
+}
+
+func TestStorageSlicedDownloader(t *testing.T) {
+	for _, size := range []int{0, 1, 7, 1000, 100003} {
+		for _, slices := range []int{0, 1, 3, 40} {
+			t.Run(fmt.Sprintf("Size%dSlices%d", size, slices), func(t *testing.T) {
+				data := newData(size)
+				client := newDownloadObject(t, data)
+
+				downloader := &storagev1.StorageSlicedDownloader{Slices: slices, Concurrency: 4}
+
+				w := &buffer{}
+				attrs, err := downloader.Download(context.Background(), client.Bucket("bucket").Object("object"), w)
+				if err != nil {
+					t.Fatalf("Download: %v", err)
+				}
+
+				if !bytes.Equal(w.data, data) {
+					t.Errorf("Download() wrote %d bytes, want %d bytes", len(w.data), len(data))
+				}
+
+				if attrs.Size != int64(size) {
+					t.Errorf("Size = %d, want %d", attrs.Size, size)
+				}
+			})
+		}
+	}
+}
+
+func TestStorageSlicedDownloaderResume(t *testing.T) {
+	data := newData(1200)
+	client := newDownloadObject(t, data)
+
+	// every read stops after 100 bytes, so that a slice of 300 bytes needs
+	// three reads that resume where the previous one stopped
+	client.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+		Schedule: storagev1chaos.Always(),
+		Fault:    storagev1chaos.Fault{Offset: 100},
+	})
+
+	downloader := &storagev1.StorageSlicedDownloader{
+		Slices:      4,
+		MaxAttempts: 3,
+		Backoff:     time.Millisecond,
+	}
+
+	w := &buffer{}
+	if _, err := downloader.Download(context.Background(), client.Bucket("bucket").Object("object"), w); err != nil {
+		t.Fatalf("Download: %v", err)
+	}
+
+	if !bytes.Equal(w.data, data) {
+		t.Errorf("Download() wrote %d bytes, want %d bytes", len(w.data), len(data))
+	}
+
+	// a slice that needs more reads than its attempts fails
+	downloader.MaxAttempts = 2
+
+	if _, err := downloader.Download(context.Background(), client.Bucket("bucket").Object("object"), &buffer{}); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Download() with 2 attempts = %v, want io.ErrUnexpectedEOF", err)
+	}
+}
+
+func TestStorageSlicedDownloaderRetry(t *testing.T) {
+	cases := []struct {
+		name        string
+		code        int
+		shouldRetry func(error) bool
+		wantErr     bool
+	}{
+		{name: "Unavailable", code: http.StatusServiceUnavailable},
+		{name: "TooManyRequests", code: http.StatusTooManyRequests},
+		{name: "PreconditionFailed", code: http.StatusPreconditionFailed, wantErr: true},
+		{name: "Forbidden", code: http.StatusForbidden, wantErr: true},
+		{name: "ShouldRetry", code: http.StatusForbidden, shouldRetry: func(error) bool { return true }},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := newDownloadObject(t, newData(1000))
+			// the first open of a slice fails
+			client.AddRule(storagev1chaos.Rule{
+				Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectOpen},
+				Schedule: storagev1chaos.Calls(1),
+				Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(tc.code)},
+			})
+
+			downloader := &storagev1.StorageSlicedDownloader{
+				Slices:      2,
+				Backoff:     time.Millisecond,
+				ShouldRetry: tc.shouldRetry,
+			}
+
+			_, err := downloader.Download(context.Background(), client.Bucket("bucket").Object("object"), &buffer{})
+			if tc.wantErr != (err != nil) {
+				t.Errorf("Download() = %v, want error %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestStorageSlicedDownloaderChecksum(t *testing.T) {
+	client := newDownloadObject(t, newData(1000))
+	handle := corruptHandle{client.Bucket("bucket").Object("object")}
+
+	downloader := &storagev1.StorageSlicedDownloader{Slices: 3}
+
+	var cerr *storagev1.StorageChecksumError
+	if _, err := downloader.Download(context.Background(), handle, &buffer{}); !errors.As(err, &cerr) {
+		t.Fatalf("Download() = %v, want a StorageChecksumError", err)
+	}
+
+	if cerr.Name != "object" || cerr.Want != cerr.Got+1 {
+		t.Errorf("StorageChecksumError = %+v, want the checksum of the attributes", cerr)
+	}
+}
+
+func TestStorageSlicedDownloaderDownloadFile(t *testing.T) {
+	data := newData(100003)
+	client := newDownloadObject(t, data)
+	downloader := &storagev1.StorageSlicedDownloader{Slices: 5}
+
+	name := filepath.Join(t.TempDir(), "object")
+	if _, err := downloader.DownloadFile(context.Background(), client.Bucket("bucket").Object("object"), name); err != nil {
+		t.Fatalf("DownloadFile: %v", err)
+	}
+
+	if got, err := os.ReadFile(name); err != nil || !bytes.Equal(got, data) {
+		t.Errorf("ReadFile() = %d bytes, %v, want %d bytes", len(got), err, len(data))
+	}
+
+	cases := []struct {
+		name   string
+		handle storagev1.StorageObjectHandle
+	}{
+		{"Checksum", corruptHandle{client.Bucket("bucket").Object("object")}},
+		{"Missing", client.Bucket("bucket").Object("missing")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			name := filepath.Join(t.TempDir(), "object")
+			if _, err := downloader.DownloadFile(context.Background(), tc.handle, name); err == nil {
+				t.Fatal("DownloadFile() = nil, want an error")
+			}
+
+			// the file of a failed download is removed
+			if _, err := os.Stat(name); !os.IsNotExist(err) {
+				t.Errorf("Stat() = %v, want a missing file", err)
+			}
+		})
+	}
+}
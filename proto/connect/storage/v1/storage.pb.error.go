@@ -0,0 +1,110 @@
+package storagev1
+
+import (
+	"errors"
+)
+
+// StorageEventError is an error of a storage event handler that tells whether
+// a redelivery of the event can succeed.
+type StorageEventError struct {
+	// Err is the error of the handler.
+	Err error
+	// Permanent reports whether every delivery of the event fails the same
+	// way, so that the event should not be redelivered.
+	Permanent bool
+}
+
+// NewPermanentStorageEventError returns an error that tells that a
+// redelivery of the event cannot succeed, such as an event that is malformed
+// or that refers to a resource the handler does not accept.
+func NewPermanentStorageEventError(err error) error {
+	return &StorageEventError{Err: err, Permanent: true}
+}
+
+// NewRetryableStorageEventError returns an error that tells that a redelivery
+// of the event can succeed, such as a timeout of a dependency.
+func NewRetryableStorageEventError(err error) error {
+	return &StorageEventError{Err: err}
+}
+
+// Error implements error.
+func (x *StorageEventError) Error() string {
+	if x.Err == nil {
+		return "storage event error"
+	}
+
+	return x.Err.Error()
+}
+
+// Unwrap returns the error of the handler.
+func (x *StorageEventError) Unwrap() error {
+	return x.Err
+}
+
+// IsPermanentStorageEventError reports whether a redelivery of the event
+// cannot succeed: the error is a permanent StorageEventError, the event type
+// is unknown or the event failed validation.
+func IsPermanentStorageEventError(err error) bool {
+	var serr *StorageEventError
+	if errors.As(err, &serr) {
+		return serr.Permanent
+	}
+
+	return errors.Is(err, ErrStorageEventTypeUnknown) || errors.As(err, new(StorageObjectEventValidationError))
+}
+
+// IsRetryableStorageEventError reports whether the error is a retryable
+// StorageEventError. The other errors are neither permanent nor retryable.
+func IsRetryableStorageEventError(err error) bool {
+	var serr *StorageEventError
+	if errors.As(err, &serr) {
+		return !serr.Permanent
+	}
+
+	return false
+}
+
+// StorageObjectEventValidationError is the error of an event that failed
+// validation.
+type StorageObjectEventValidationError struct {
+	err error
+}
+
+// ValidateStorageObjectEvent validates an event with the rules of its message,
+// and requires the bucket and the name of its object. It reports every
+// violation in a StorageObjectEventValidationError.
+func ValidateStorageObjectEvent(event StorageObjectEvent) error {
+	var errs []error
+
+	if msg, ok := event.(interface{ ValidateAll() error }); ok {
+		if err := msg.ValidateAll(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if object := event.GetObject(); object != nil {
+		if object.GetBucket() == "" {
+			errs = append(errs, errors.New("invalid StorageObject.Bucket: value is required"))
+		}
+
+		if object.GetName() == "" {
+			errs = append(errs, errors.New("invalid StorageObject.Name: value is required"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return StorageObjectEventValidationError{err: errors.Join(errs...)}
+	}
+
+	return nil
+}
+
+// Error implements error.
+func (x StorageObjectEventValidationError) Error() string {
+	return x.err.Error()
+}
+
+// Unwrap returns the violations.
+func (x StorageObjectEventValidationError) Unwrap() error {
+	return x.err
+}
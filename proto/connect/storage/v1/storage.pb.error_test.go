@@ -0,0 +1,107 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestStorageEventError(t *testing.T) {
+	cause := errors.New("boom")
+
+	cases := []struct {
+		name      string
+		err       error
+		permanent bool
+		retryable bool
+	}{
+		{"Permanent", storagev1.NewPermanentStorageEventError(cause), true, false},
+		{"Retryable", storagev1.NewRetryableStorageEventError(cause), false, true},
+		{"WrappedPermanent", fmt.Errorf("handle: %w", storagev1.NewPermanentStorageEventError(cause)), true, false},
+		{"UnknownType", storagev1.ErrStorageEventTypeUnknown, true, false},
+		{"Validation", storagev1.ValidateStorageObjectEvent(&storagev1.StorageObjectFinalizedEvent{}), true, false},
+		{"Other", cause, false, false},
+		{"Context", context.DeadlineExceeded, false, false},
+		{"Nil", nil, false, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := storagev1.IsPermanentStorageEventError(tc.err); got != tc.permanent {
+				t.Errorf("IsPermanentStorageEventError(%v) = %v, want %v", tc.err, got, tc.permanent)
+			}
+
+			if got := storagev1.IsRetryableStorageEventError(tc.err); got != tc.retryable {
+				t.Errorf("IsRetryableStorageEventError(%v) = %v, want %v", tc.err, got, tc.retryable)
+			}
+		})
+	}
+
+	err := storagev1.NewRetryableStorageEventError(cause)
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(%v, %v) = false, want true", err, cause)
+	}
+
+	if got := err.Error(); got != "boom" {
+		t.Errorf("Error() = %q, want %q", got, "boom")
+	}
+
+	if got := (&storagev1.StorageEventError{}).Error(); got != "storage event error" {
+		t.Errorf("Error() without a cause = %q, want %q", got, "storage event error")
+	}
+}
+
+func TestValidateStorageObjectEvent(t *testing.T) {
+	cases := []struct {
+		name  string
+		event storagev1.StorageObjectEvent
+		want  []string
+	}{
+		{
+			name:  "Valid",
+			event: newFinalizedEvent("bucket", "a", "", nil),
+		},
+		{
+			name:  "MissingObject",
+			event: &storagev1.StorageObjectDeletedEvent{},
+			want:  []string{"Object"},
+		},
+		{
+			name:  "MissingBucket",
+			event: newFinalizedEvent("", "a", "", nil),
+			want:  []string{"StorageObject.Bucket"},
+		},
+		{
+			name:  "MissingBucketAndName",
+			event: &storagev1.StorageObjectArchivedEvent{Object: &storagev1.StorageObject{}},
+			want:  []string{"StorageObject.Bucket", "StorageObject.Name"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := storagev1.ValidateStorageObjectEvent(tc.event)
+			if len(tc.want) == 0 {
+				if err != nil {
+					t.Errorf("ValidateStorageObjectEvent() error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if !errors.As(err, new(storagev1.StorageObjectEventValidationError)) {
+				t.Fatalf("ValidateStorageObjectEvent() error = %v, want a StorageObjectEventValidationError", err)
+			}
+
+			for _, field := range tc.want {
+				if !strings.Contains(err.Error(), field) {
+					t.Errorf("ValidateStorageObjectEvent() error = %v, want a violation of %s", err, field)
+				}
+			}
+		})
+	}
+}
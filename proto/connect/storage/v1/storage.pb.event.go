@@ -2,18 +2,174 @@ package storagev1
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // The error occurs when the event is unknown.
 var ErrStorageEventTypeUnknown = errors.New("unknown storage event type")
 
+const (
+	// StorageObjectFinalizedCloudEventType is the CloudEvent type of the
+	// StorageObjectFinalizedEvent.
+	StorageObjectFinalizedCloudEventType = "google.cloud.storage.object.v1.finalized"
+	// StorageObjectArchivedCloudEventType is the CloudEvent type of the
+	// StorageObjectArchivedEvent.
+	StorageObjectArchivedCloudEventType = "google.cloud.storage.object.v1.archived"
+	// StorageObjectDeletedCloudEventType is the CloudEvent type of the
+	// StorageObjectDeletedEvent.
+	StorageObjectDeletedCloudEventType = "google.cloud.storage.object.v1.deleted"
+	// StorageObjectMetadataUpdatedCloudEventType is the CloudEvent type of the
+	// StorageObjectMetadataUpdatedEvent.
+	StorageObjectMetadataUpdatedCloudEventType = "google.cloud.storage.object.v1.metadataUpdated"
+)
+
+const (
+	// StoragePayloadFormatJSON is the payload format of the notifications that
+	// carry the object in the JSON API representation.
+	StoragePayloadFormatJSON = "JSON_API_V1"
+	// StoragePayloadFormatNone is the payload format of the notifications that
+	// carry no payload.
+	StoragePayloadFormatNone = "NONE"
+)
+
+// NewStorageObjectPubsubEvent returns the event of a Cloud Storage Pub/Sub
+// notification, built from the attributes, the data, the ID and the publish
+// time of the message. The object of a notification without a payload is
+// built from its attributes.
+func NewStorageObjectPubsubEvent(attrs map[string]string, data []byte, messageID string, publishTime time.Time) (StorageObjectEvent, error) {
+	kind, ok := StorageObjectEventType_value[attrs["eventType"]]
+	if !ok {
+		return nil, ErrStorageEventTypeUnknown
+	}
+
+	envelope := &StorageObjectEventEnvelope{
+		MessageId:          messageID,
+		PublishTime:        toTimestamp(publishTime),
+		EventType:          StorageObjectEventType(kind),
+		BucketId:           attrs["bucketId"],
+		ObjectId:           attrs["objectId"],
+		NotificationConfig: attrs["notificationConfig"],
+		PayloadFormat:      attrs["payloadFormat"],
+	}
+
+	var err error
+	// parse the attributes
+	if envelope.ObjectGeneration, err = parseGeneration(attrs, "objectGeneration"); err != nil {
+		return nil, err
+	}
+
+	if envelope.OverwroteGeneration, err = parseGeneration(attrs, "overwroteGeneration"); err != nil {
+		return nil, err
+	}
+
+	if envelope.OverwrittenByGeneration, err = parseGeneration(attrs, "overwrittenByGeneration"); err != nil {
+		return nil, err
+	}
+
+	if v := attrs["eventTime"]; v != "" {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid eventTime attribute %q: %w", v, err)
+		}
+
+		envelope.EventTime = timestamppb.New(t)
+	}
+
+	object := &StorageObject{}
+	// unmarshal the object
+	if envelope.PayloadFormat == StoragePayloadFormatNone || len(data) == 0 {
+		object.Bucket = envelope.BucketId
+		object.Name = envelope.ObjectId
+		object.Generation = envelope.ObjectGeneration
+	} else if err := json.Unmarshal(data, object); err != nil {
+		return nil, err
+	}
+
+	var event StorageObjectEvent
+	// prepare the event
+	switch envelope.EventType {
+	case StorageObjectEventType_OBJECT_FINALIZE:
+		event = &StorageObjectFinalizedEvent{}
+	case StorageObjectEventType_OBJECT_ARCHIVE:
+		event = &StorageObjectArchivedEvent{}
+	case StorageObjectEventType_OBJECT_DELETE:
+		event = &StorageObjectDeletedEvent{}
+	case StorageObjectEventType_OBJECT_METADATA_UPDATE:
+		event = &StorageObjectMetadataUpdatedEvent{}
+	default:
+		return nil, ErrStorageEventTypeUnknown
+	}
+
+	event.SetObject(object)
+	event.SetEnvelope(envelope)
+	// done!
+	return event, nil
+}
+
+// parseGeneration parses a generation attribute, which is zero when it is not
+// set.
+func parseGeneration(attrs map[string]string, key string) (int64, error) {
+	v := attrs[key]
+	if v == "" {
+		return 0, nil
+	}
+
+	gen, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s attribute %q: %w", key, v, err)
+	}
+
+	return gen, nil
+}
+
+// NewStorageObjectCloudEvent returns an empty event of the given CloudEvent
+// type, or ErrStorageEventTypeUnknown.
+func NewStorageObjectCloudEvent(kind string) (StorageObjectEvent, error) {
+	switch kind {
+	case StorageObjectFinalizedCloudEventType:
+		return &StorageObjectFinalizedEvent{}, nil
+	case StorageObjectArchivedCloudEventType:
+		return &StorageObjectArchivedEvent{}, nil
+	case StorageObjectDeletedCloudEventType:
+		return &StorageObjectDeletedEvent{}, nil
+	case StorageObjectMetadataUpdatedCloudEventType:
+		return &StorageObjectMetadataUpdatedEvent{}, nil
+	default:
+		return nil, ErrStorageEventTypeUnknown
+	}
+}
+
+// StorageObjectEventTypeOf returns the type of an event, which follows from
+// its concrete type.
+func StorageObjectEventTypeOf(event StorageObjectEvent) StorageObjectEventType {
+	switch event.(type) {
+	case *StorageObjectFinalizedEvent:
+		return StorageObjectEventType_OBJECT_FINALIZE
+	case *StorageObjectArchivedEvent:
+		return StorageObjectEventType_OBJECT_ARCHIVE
+	case *StorageObjectDeletedEvent:
+		return StorageObjectEventType_OBJECT_DELETE
+	case *StorageObjectMetadataUpdatedEvent:
+		return StorageObjectEventType_OBJECT_METADATA_UPDATE
+	default:
+		return StorageObjectEventType_OBJECT_UNSPECIFIED
+	}
+}
+
 //counterfeiter:generate -o storagev1fake . StorageObjectEvent
 
 // StorageObjectEvent is the interface for events that contain a StorageObject.
 type StorageObjectEvent interface {
 	GetObject() *StorageObject
 	SetObject(*StorageObject)
+	GetEnvelope() *StorageObjectEventEnvelope
+	SetEnvelope(*StorageObjectEventEnvelope)
 }
 
 var _ StorageObjectEvent = &StorageObjectDeletedEvent{}
@@ -23,6 +179,11 @@ func (x *StorageObjectDeletedEvent) SetObject(obj *StorageObject) {
 	x.Object = obj
 }
 
+// SetEnvelope sets the StorageObjectEventEnvelope.
+func (x *StorageObjectDeletedEvent) SetEnvelope(envelope *StorageObjectEventEnvelope) {
+	x.Envelope = envelope
+}
+
 var _ StorageObjectEvent = &StorageObjectArchivedEvent{}
 
 // SetStorageObject sets the StorageObject.
@@ -30,6 +191,11 @@ func (x *StorageObjectArchivedEvent) SetObject(obj *StorageObject) {
 	x.Object = obj
 }
 
+// SetEnvelope sets the StorageObjectEventEnvelope.
+func (x *StorageObjectArchivedEvent) SetEnvelope(envelope *StorageObjectEventEnvelope) {
+	x.Envelope = envelope
+}
+
 var _ StorageObjectEvent = &StorageObjectFinalizedEvent{}
 
 // SetStorageObject sets the StorageObject.
@@ -37,6 +203,11 @@ func (x *StorageObjectFinalizedEvent) SetObject(obj *StorageObject) {
 	x.Object = obj
 }
 
+// SetEnvelope sets the StorageObjectEventEnvelope.
+func (x *StorageObjectFinalizedEvent) SetEnvelope(envelope *StorageObjectEventEnvelope) {
+	x.Envelope = envelope
+}
+
 var _ StorageObjectEvent = &StorageObjectMetadataUpdatedEvent{}
 
 // SetStorageObject sets the StorageObject.
@@ -44,6 +215,11 @@ func (x *StorageObjectMetadataUpdatedEvent) SetObject(obj *StorageObject) {
 	x.Object = obj
 }
 
+// SetEnvelope sets the StorageObjectEventEnvelope.
+func (x *StorageObjectMetadataUpdatedEvent) SetEnvelope(envelope *StorageObjectEventEnvelope) {
+	x.Envelope = envelope
+}
+
 //counterfeiter:generate -o storagev1fake . StorageObjectEventHandler
 
 // StorageEventHandler handles connect.storage.v1.StorageObjectEvent event.
@@ -0,0 +1,200 @@
+package storagev1_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestNewStorageObjectPubsubEvent(t *testing.T) {
+	attrs := map[string]string{
+		"eventType":               "OBJECT_FINALIZE",
+		"bucketId":                "bucket",
+		"objectId":                "a/b.txt",
+		"objectGeneration":        "7",
+		"overwroteGeneration":     "6",
+		"notificationConfig":      "projects/_/buckets/bucket/notificationConfigs/1",
+		"payloadFormat":           storagev1.StoragePayloadFormatJSON,
+		"eventTime":               "2026-01-02T03:04:05.123456Z",
+		"overwrittenByGeneration": "",
+	}
+
+	publishTime := time.Date(2026, 1, 2, 3, 4, 6, 0, time.UTC)
+
+	event, err := storagev1.NewStorageObjectPubsubEvent(attrs, []byte(`{"bucket":"bucket","name":"a/b.txt","generation":"7","size":"5"}`), "42", publishTime)
+	if err != nil {
+		t.Fatalf("NewStorageObjectPubsubEvent: %v", err)
+	}
+
+	if _, ok := event.(*storagev1.StorageObjectFinalizedEvent); !ok {
+		t.Fatalf("NewStorageObjectPubsubEvent() = %T, want *storagev1.StorageObjectFinalizedEvent", event)
+	}
+
+	if got := event.GetObject().GetSize(); got != 5 {
+		t.Errorf("event.Object.Size = %d, want 5", got)
+	}
+
+	envelope := event.GetEnvelope()
+	if envelope.GetMessageId() != "42" {
+		t.Errorf("envelope.MessageId = %q, want %q", envelope.GetMessageId(), "42")
+	}
+
+	if !envelope.GetPublishTime().AsTime().Equal(publishTime) {
+		t.Errorf("envelope.PublishTime = %v, want %v", envelope.GetPublishTime().AsTime(), publishTime)
+	}
+
+	if want := time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC); !envelope.GetEventTime().AsTime().Equal(want) {
+		t.Errorf("envelope.EventTime = %v, want %v", envelope.GetEventTime().AsTime(), want)
+	}
+
+	if envelope.GetEventType() != storagev1.StorageObjectEventType_OBJECT_FINALIZE {
+		t.Errorf("envelope.EventType = %v, want %v", envelope.GetEventType(), storagev1.StorageObjectEventType_OBJECT_FINALIZE)
+	}
+
+	if envelope.GetBucketId() != "bucket" || envelope.GetObjectId() != "a/b.txt" || envelope.GetObjectGeneration() != 7 {
+		t.Errorf("envelope object = %s/%s#%d, want bucket/a/b.txt#7", envelope.GetBucketId(), envelope.GetObjectId(), envelope.GetObjectGeneration())
+	}
+
+	if envelope.GetOverwroteGeneration() != 6 || envelope.GetOverwrittenByGeneration() != 0 {
+		t.Errorf("envelope generations = %d, %d, want 6, 0", envelope.GetOverwroteGeneration(), envelope.GetOverwrittenByGeneration())
+	}
+
+	if envelope.GetNotificationConfig() != attrs["notificationConfig"] {
+		t.Errorf("envelope.NotificationConfig = %q, want %q", envelope.GetNotificationConfig(), attrs["notificationConfig"])
+	}
+}
+
+func TestNewStorageObjectPubsubEventTypes(t *testing.T) {
+	cases := []struct {
+		kind string
+		want storagev1.StorageObjectEventType
+	}{
+		{"OBJECT_FINALIZE", storagev1.StorageObjectEventType_OBJECT_FINALIZE},
+		{"OBJECT_ARCHIVE", storagev1.StorageObjectEventType_OBJECT_ARCHIVE},
+		{"OBJECT_DELETE", storagev1.StorageObjectEventType_OBJECT_DELETE},
+		{"OBJECT_METADATA_UPDATE", storagev1.StorageObjectEventType_OBJECT_METADATA_UPDATE},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.kind, func(t *testing.T) {
+			attrs := map[string]string{"eventType": tc.kind, "bucketId": "bucket", "objectId": "a"}
+
+			event, err := storagev1.NewStorageObjectPubsubEvent(attrs, []byte(`{"bucket":"bucket","name":"a"}`), "", time.Time{})
+			if err != nil {
+				t.Fatalf("NewStorageObjectPubsubEvent: %v", err)
+			}
+
+			if got := storagev1.StorageObjectEventTypeOf(event); got != tc.want {
+				t.Errorf("StorageObjectEventTypeOf() = %v, want %v", got, tc.want)
+			}
+
+			if event.GetEnvelope().GetPublishTime() != nil {
+				t.Errorf("envelope.PublishTime = %v, want nil", event.GetEnvelope().GetPublishTime())
+			}
+		})
+	}
+}
+
+func TestNewStorageObjectPubsubEventWithoutPayload(t *testing.T) {
+	attrs := map[string]string{
+		"eventType":        "OBJECT_DELETE",
+		"bucketId":         "bucket",
+		"objectId":         "a/b.txt",
+		"objectGeneration": "7",
+		"payloadFormat":    storagev1.StoragePayloadFormatNone,
+	}
+
+	event, err := storagev1.NewStorageObjectPubsubEvent(attrs, nil, "42", time.Time{})
+	if err != nil {
+		t.Fatalf("NewStorageObjectPubsubEvent: %v", err)
+	}
+
+	object := event.GetObject()
+	if object.GetBucket() != "bucket" || object.GetName() != "a/b.txt" || object.GetGeneration() != 7 {
+		t.Errorf("event.Object = %s/%s#%d, want bucket/a/b.txt#7", object.GetBucket(), object.GetName(), object.GetGeneration())
+	}
+}
+
+func TestNewStorageObjectPubsubEventErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs map[string]string
+		data  string
+		want  error
+	}{
+		{
+			name:  "MissingType",
+			attrs: map[string]string{},
+			want:  storagev1.ErrStorageEventTypeUnknown,
+		},
+		{
+			name:  "UnknownType",
+			attrs: map[string]string{"eventType": "BUCKET_CREATE"},
+			want:  storagev1.ErrStorageEventTypeUnknown,
+		},
+		{
+			name:  "UnspecifiedType",
+			attrs: map[string]string{"eventType": "OBJECT_UNSPECIFIED"},
+			want:  storagev1.ErrStorageEventTypeUnknown,
+		},
+		{
+			name:  "Generation",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE", "objectGeneration": "seven"},
+		},
+		{
+			name:  "OverwroteGeneration",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE", "overwroteGeneration": "-"},
+		},
+		{
+			name:  "EventTime",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE", "eventTime": "yesterday"},
+		},
+		{
+			name:  "Payload",
+			attrs: map[string]string{"eventType": "OBJECT_FINALIZE"},
+			data:  `{"bucket":`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := storagev1.NewStorageObjectPubsubEvent(tc.attrs, []byte(tc.data), "", time.Time{})
+			if err == nil {
+				t.Fatalf("NewStorageObjectPubsubEvent() error = nil, want an error")
+			}
+
+			if tc.want != nil && !errors.Is(err, tc.want) {
+				t.Errorf("NewStorageObjectPubsubEvent() error = %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestNewStorageObjectCloudEvent(t *testing.T) {
+	cases := []struct {
+		kind string
+		want storagev1.StorageObjectEventType
+	}{
+		{storagev1.StorageObjectFinalizedCloudEventType, storagev1.StorageObjectEventType_OBJECT_FINALIZE},
+		{storagev1.StorageObjectArchivedCloudEventType, storagev1.StorageObjectEventType_OBJECT_ARCHIVE},
+		{storagev1.StorageObjectDeletedCloudEventType, storagev1.StorageObjectEventType_OBJECT_DELETE},
+		{storagev1.StorageObjectMetadataUpdatedCloudEventType, storagev1.StorageObjectEventType_OBJECT_METADATA_UPDATE},
+	}
+
+	for _, tc := range cases {
+		event, err := storagev1.NewStorageObjectCloudEvent(tc.kind)
+		if err != nil {
+			t.Fatalf("NewStorageObjectCloudEvent(%q): %v", tc.kind, err)
+		}
+
+		if got := storagev1.StorageObjectEventTypeOf(event); got != tc.want {
+			t.Errorf("StorageObjectEventTypeOf(NewStorageObjectCloudEvent(%q)) = %v, want %v", tc.kind, got, tc.want)
+		}
+	}
+
+	if _, err := storagev1.NewStorageObjectCloudEvent("google.cloud.storage.bucket.v1.created"); !errors.Is(err, storagev1.ErrStorageEventTypeUnknown) {
+		t.Errorf("NewStorageObjectCloudEvent(unknown) error = %v, want %v", err, storagev1.ErrStorageEventTypeUnknown)
+	}
+}
@@ -0,0 +1,137 @@
+package storagev1
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// StorageObjectGlob is a compiled object name glob.
+//
+// The syntax follows the matchGlob parameter of the Cloud Storage list API:
+// '*' matches any sequence of characters except '/', '**' matches any
+// sequence of characters including '/', '**/' at the start of a segment
+// matches zero or more whole segments, '?' matches a single character
+// except '/', '[...]' matches a character class ('[!...]' negates it) and
+// '{a,b}' matches any of the comma separated alternatives.
+type StorageObjectGlob struct {
+	pattern string
+	prefix  string
+	regexp  *regexp.Regexp
+}
+
+// ParseStorageObjectGlob compiles the given glob pattern.
+func ParseStorageObjectGlob(pattern string) (*StorageObjectGlob, error) {
+	expr, err := compileGlob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("parse glob %q: %w", pattern, err)
+	}
+
+	re, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return nil, fmt.Errorf("parse glob %q: %w", pattern, err)
+	}
+
+	glob := &StorageObjectGlob{
+		pattern: pattern,
+		prefix:  pattern[:strings.IndexAny(pattern+"*", "*?[{\\")],
+		regexp:  re,
+	}
+
+	return glob, nil
+}
+
+// MustParseStorageObjectGlob is like ParseStorageObjectGlob but panics if the pattern is invalid.
+func MustParseStorageObjectGlob(pattern string) *StorageObjectGlob {
+	glob, err := ParseStorageObjectGlob(pattern)
+	if err != nil {
+		panic(err)
+	}
+
+	return glob
+}
+
+// Match reports whether the object name matches the glob.
+func (x *StorageObjectGlob) Match(name string) bool {
+	return x.regexp.MatchString(name)
+}
+
+// Prefix returns the literal prefix shared by every name that matches the glob.
+func (x *StorageObjectGlob) Prefix() string {
+	return x.prefix
+}
+
+// String returns the string representation.
+func (x *StorageObjectGlob) String() string {
+	return x.pattern
+}
+
+func compileGlob(pattern string) (string, error) {
+	var (
+		out   strings.Builder
+		depth int
+	)
+
+	for index := 0; index < len(pattern); index++ {
+		switch ch := pattern[index]; ch {
+		case '*':
+			// '**/' matches whole segments only at the start of a segment
+			if strings.HasPrefix(pattern[index:], "**/") && (index == 0 || pattern[index-1] == '/') {
+				out.WriteString("(?:.*/)?")
+				index += 2
+			} else if strings.HasPrefix(pattern[index:], "**") {
+				out.WriteString(".*")
+				index++
+			} else {
+				out.WriteString("[^/]*")
+			}
+		case '?':
+			out.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[index+1:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated character class at offset %d", index)
+			}
+
+			class := pattern[index+1 : index+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+
+			out.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			index += end + 1
+		case '{':
+			depth++
+			out.WriteString("(?:")
+		case ',':
+			if depth > 0 {
+				out.WriteString("|")
+			} else {
+				out.WriteString(",")
+			}
+		case '}':
+			if depth == 0 {
+				return "", fmt.Errorf("unexpected '}' at offset %d", index)
+			}
+
+			depth--
+			out.WriteString(")")
+		case '\\':
+			if index+1 == len(pattern) {
+				return "", fmt.Errorf("trailing escape at offset %d", index)
+			}
+
+			index++
+			out.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		default:
+			out.WriteString(regexp.QuoteMeta(pattern[index : index+1]))
+		}
+	}
+
+	if depth > 0 {
+		return "", errors.New("unterminated alternation")
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,79 @@
+package storagev1_test
+
+import (
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestStorageObjectGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		name    string
+		match   bool
+	}{
+		{pattern: "*.txt", name: "a.txt", match: true},
+		{pattern: "*.txt", name: "dir/a.txt", match: false},
+		{pattern: "**.txt", name: "dir/a.txt", match: true},
+		{pattern: "**", name: "a/b/c", match: true},
+		{pattern: "**/b", name: "b", match: true},
+		{pattern: "**/b", name: "a/b", match: true},
+		{pattern: "**/b", name: "a/c/b", match: true},
+		{pattern: "**/b", name: "ab", match: false},
+		{pattern: "a/**/b", name: "a/b", match: true},
+		{pattern: "a/**/b", name: "a/x/y/b", match: true},
+		{pattern: "a/**/b", name: "ab", match: false},
+		{pattern: "a/**/b", name: "a/xb", match: false},
+		{pattern: "a**/b", name: "a/b", match: true},
+		{pattern: "a**/b", name: "ax/b", match: true},
+		{pattern: "a**/b", name: "ax/y/b", match: true},
+		{pattern: "a**/b", name: "ab", match: false},
+		{pattern: "?", name: "a", match: true},
+		{pattern: "?", name: "/", match: false},
+		{pattern: "?", name: "ab", match: false},
+		{pattern: "[ab]c", name: "bc", match: true},
+		{pattern: "[!a]c", name: "ac", match: false},
+		{pattern: "[!a]c", name: "bc", match: true},
+		{pattern: "{a,b}c", name: "ac", match: true},
+		{pattern: "{a,b}c", name: "bc", match: true},
+		{pattern: "{a,b}c", name: "cc", match: false},
+		{pattern: `\*`, name: "*", match: true},
+		{pattern: `\*`, name: "a", match: false},
+		{pattern: "a.b", name: "axb", match: false},
+	}
+
+	for _, tc := range cases {
+		glob, err := storagev1.ParseStorageObjectGlob(tc.pattern)
+		if err != nil {
+			t.Fatalf("ParseStorageObjectGlob(%q): %v", tc.pattern, err)
+		}
+
+		if got := glob.Match(tc.name); got != tc.match {
+			t.Errorf("ParseStorageObjectGlob(%q).Match(%q) = %v, want %v", tc.pattern, tc.name, got, tc.match)
+		}
+	}
+}
+
+func TestStorageObjectGlobPrefix(t *testing.T) {
+	cases := map[string]string{
+		"logs/**/x.txt": "logs/",
+		"logs/2024-*":   "logs/2024-",
+		"plain":         "plain",
+		"*":             "",
+		`a\*`:           "a",
+	}
+
+	for pattern, want := range cases {
+		if got := storagev1.MustParseStorageObjectGlob(pattern).Prefix(); got != want {
+			t.Errorf("MustParseStorageObjectGlob(%q).Prefix() = %q, want %q", pattern, got, want)
+		}
+	}
+}
+
+func TestParseStorageObjectGlobError(t *testing.T) {
+	for _, pattern := range []string{"[a", "a}", "{a,b", `a\`} {
+		if _, err := storagev1.ParseStorageObjectGlob(pattern); err == nil {
+			t.Errorf("ParseStorageObjectGlob(%q): want an error", pattern)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package storagev1
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	storage "cloud.google.com/go/storage"
+)
+
+// The error occurs when the labels of a bucket update cannot be read, because
+// the storage package no longer keeps them in the fields that
+// StorageBucketLabelsToUpdate reads.
+var ErrStorageBucketLabelsUnreadable = errors.New("storage: bucket update labels are unreadable")
+
+// StorageBucketLabelsToUpdate returns the labels that a bucket update sets and
+// the labels that it deletes, in the order of their keys.
+//
+// The storage package keeps the labels of SetLabel and DeleteLabel in the
+// unexported fields setLabels and deleteLabels, which are only reachable
+// through reflection. The fields are checked against the version of the
+// storage package in go.mod, and ErrStorageBucketLabelsUnreadable is returned
+// when they change, so that an update never drops its labels silently.
+func StorageBucketLabelsToUpdate(attrs *storage.BucketAttrsToUpdate) (map[string]string, []string, error) {
+	var (
+		value  = reflect.ValueOf(attrs).Elem()
+		labels = value.FieldByName("setLabels")
+		keys   = value.FieldByName("deleteLabels")
+	)
+
+	if !labels.IsValid() || !keys.IsValid() || labels.Type() != reflect.TypeFor[map[string]string]() || keys.Type() != reflect.TypeFor[map[string]bool]() {
+		return nil, nil, ErrStorageBucketLabelsUnreadable
+	}
+
+	set := make(map[string]string, labels.Len())
+	for iter := labels.MapRange(); iter.Next(); {
+		set[iter.Key().String()] = iter.Value().String()
+	}
+
+	unset := make([]string, 0, keys.Len())
+	for iter := keys.MapRange(); iter.Next(); {
+		unset = append(unset, iter.Key().String())
+	}
+
+	slices.Sort(unset)
+	// done!
+	return set, unset, nil
+}
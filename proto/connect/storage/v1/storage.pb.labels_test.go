@@ -0,0 +1,45 @@
+package storagev1_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// The test fails when the storage package changes the fields that keep the
+// labels of a bucket update.
+func TestStorageBucketLabelsToUpdate(t *testing.T) {
+	attrs := &storage.BucketAttrsToUpdate{}
+	attrs.SetLabel("env", "prod")
+	attrs.SetLabel("team", "storage")
+	attrs.DeleteLabel("old")
+	attrs.DeleteLabel("legacy")
+
+	set, unset, err := storagev1.StorageBucketLabelsToUpdate(attrs)
+	if err != nil {
+		t.Fatalf("StorageBucketLabelsToUpdate: %v", err)
+	}
+
+	if want := map[string]string{"env": "prod", "team": "storage"}; !maps.Equal(set, want) {
+		t.Errorf("set = %v, want %v", set, want)
+	}
+
+	if want := []string{"legacy", "old"}; !slices.Equal(unset, want) {
+		t.Errorf("unset = %v, want %v", unset, want)
+	}
+}
+
+func TestStorageBucketLabelsToUpdateEmpty(t *testing.T) {
+	set, unset, err := storagev1.StorageBucketLabelsToUpdate(&storage.BucketAttrsToUpdate{})
+	if err != nil {
+		t.Fatalf("StorageBucketLabelsToUpdate: %v", err)
+	}
+
+	if len(set) != 0 || len(unset) != 0 {
+		t.Errorf("set = %v, unset = %v, want none", set, unset)
+	}
+}
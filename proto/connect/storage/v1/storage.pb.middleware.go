@@ -0,0 +1,158 @@
+package storagev1
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+var _ StorageObjectEventHandler = StorageObjectEventHandlerFunc(nil)
+
+// StorageObjectEventHandlerFunc is a function that implements
+// StorageObjectEventHandler.
+type StorageObjectEventHandlerFunc func(context.Context, StorageObjectEvent) error
+
+// HandleStorageObjectEvent implements StorageObjectEventHandler.
+func (fn StorageObjectEventHandlerFunc) HandleStorageObjectEvent(ctx context.Context, event StorageObjectEvent) error {
+	return fn(ctx, event)
+}
+
+// StorageObjectEventMiddleware wraps a StorageObjectEventHandler.
+type StorageObjectEventMiddleware func(StorageObjectEventHandler) StorageObjectEventHandler
+
+// ChainStorageObjectEventMiddleware wraps a handler with the given
+// middlewares. The first middleware is the outermost one, so that it sees the
+// event first.
+//
+// Example:
+//
+//	handler = storagev1.ChainStorageObjectEventMiddleware(handler,
+//		storagev1.StorageObjectEventRecovery(),
+//		storagev1.StorageObjectEventLogging(slog.Default()),
+//		storagev1.StorageObjectEventTimeout(time.Minute),
+//		storagev1.StorageObjectEventConcurrency(8),
+//	)
+func ChainStorageObjectEventMiddleware(handler StorageObjectEventHandler, middlewares ...StorageObjectEventMiddleware) StorageObjectEventHandler {
+	for index := len(middlewares) - 1; index >= 0; index-- {
+		handler = middlewares[index](handler)
+	}
+
+	return handler
+}
+
+// StorageEventPanicError is the error of a handler that panicked.
+type StorageEventPanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panic.
+	Stack []byte
+}
+
+// Error implements error.
+func (x *StorageEventPanicError) Error() string {
+	return fmt.Sprintf("storage event handler panic: %v", x.Value)
+}
+
+// StorageObjectEventRecovery returns a middleware that recovers the panics of
+// the handler and returns them as a StorageEventPanicError.
+func StorageObjectEventRecovery() StorageObjectEventMiddleware {
+	return func(next StorageObjectEventHandler) StorageObjectEventHandler {
+		return StorageObjectEventHandlerFunc(func(ctx context.Context, event StorageObjectEvent) (err error) {
+			defer func() {
+				if v := recover(); v != nil {
+					err = &StorageEventPanicError{Value: v, Stack: debug.Stack()}
+				}
+			}()
+
+			return next.HandleStorageObjectEvent(ctx, event)
+		})
+	}
+}
+
+// StorageObjectEventLogging returns a middleware that logs every event with
+// its type, its bucket, its object, its generation, its message ID, the
+// duration of the handler and its error. The events that fail are logged at
+// the error level, the others at the info level.
+func StorageObjectEventLogging(logger *slog.Logger) StorageObjectEventMiddleware {
+	return func(next StorageObjectEventHandler) StorageObjectEventHandler {
+		return StorageObjectEventHandlerFunc(func(ctx context.Context, event StorageObjectEvent) error {
+			start := time.Now()
+			// handle the event
+			err := next.HandleStorageObjectEvent(ctx, event)
+
+			object := event.GetObject()
+			attrs := []slog.Attr{
+				slog.String("event_type", StorageObjectEventTypeOf(event).String()),
+				slog.String("bucket", object.GetBucket()),
+				slog.String("object", object.GetName()),
+				slog.Int64("generation", object.GetGeneration()),
+				slog.Duration("duration", time.Since(start)),
+			}
+
+			if id := event.GetEnvelope().GetMessageId(); id != "" {
+				attrs = append(attrs, slog.String("message_id", id))
+			}
+
+			if err != nil {
+				attrs = append(attrs, slog.Any("error", err))
+				logger.LogAttrs(ctx, slog.LevelError, "storage event failed", attrs...)
+				return err
+			}
+
+			logger.LogAttrs(ctx, slog.LevelInfo, "storage event handled", attrs...)
+			// done!
+			return nil
+		})
+	}
+}
+
+// StorageObjectEventTimeout returns a middleware that cancels the context of
+// the handler after the timeout.
+func StorageObjectEventTimeout(timeout time.Duration) StorageObjectEventMiddleware {
+	return func(next StorageObjectEventHandler) StorageObjectEventHandler {
+		return StorageObjectEventHandlerFunc(func(ctx context.Context, event StorageObjectEvent) error {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			// done!
+			return next.HandleStorageObjectEvent(ctx, event)
+		})
+	}
+}
+
+// StorageObjectEventConcurrency returns a middleware that runs at most limit
+// handlers at a time. The other events wait for a handler to finish, and fail
+// with the error of their context when it is done first.
+func StorageObjectEventConcurrency(limit int) StorageObjectEventMiddleware {
+	return func(next StorageObjectEventHandler) StorageObjectEventHandler {
+		sem := make(chan struct{}, max(limit, 1))
+
+		return StorageObjectEventHandlerFunc(func(ctx context.Context, event StorageObjectEvent) error {
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			defer func() { <-sem }()
+			// done!
+			return next.HandleStorageObjectEvent(ctx, event)
+		})
+	}
+}
+
+type storageObjectEventAttributesKey struct{}
+
+// WithStorageObjectEventAttributes returns a context that carries the
+// attributes of the message of an event, such as its trace context.
+func WithStorageObjectEventAttributes(ctx context.Context, attrs map[string]string) context.Context {
+	return context.WithValue(ctx, storageObjectEventAttributesKey{}, attrs)
+}
+
+// StorageObjectEventAttributesFromContext returns the attributes of the
+// message of an event carried by the context, or nil.
+func StorageObjectEventAttributesFromContext(ctx context.Context) map[string]string {
+	attrs, _ := ctx.Value(storageObjectEventAttributesKey{}).(map[string]string)
+	return attrs
+}
@@ -0,0 +1,207 @@
+package storagev1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestChainStorageObjectEventMiddleware(t *testing.T) {
+	var calls []string
+
+	trace := func(name string) storagev1.StorageObjectEventMiddleware {
+		return func(next storagev1.StorageObjectEventHandler) storagev1.StorageObjectEventHandler {
+			return storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, event storagev1.StorageObjectEvent) error {
+				calls = append(calls, name)
+				return next.HandleStorageObjectEvent(ctx, event)
+			})
+		}
+	}
+
+	handler := storagev1.ChainStorageObjectEventMiddleware(handlerName{"handler", &calls}, trace("first"), trace("second"))
+	if err := handler.HandleStorageObjectEvent(context.Background(), newFinalizedEvent("bucket", "a", "", nil)); err != nil {
+		t.Fatalf("HandleStorageObjectEvent: %v", err)
+	}
+
+	if want := []string{"first", "second", "handler"}; !slices.Equal(calls, want) {
+		t.Errorf("calls = %v, want %v", calls, want)
+	}
+}
+
+func TestStorageObjectEventRecovery(t *testing.T) {
+	handler := storagev1.ChainStorageObjectEventMiddleware(
+		storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+			panic("boom")
+		}),
+		storagev1.StorageObjectEventRecovery(),
+	)
+
+	err := handler.HandleStorageObjectEvent(context.Background(), newFinalizedEvent("bucket", "a", "", nil))
+
+	var perr *storagev1.StorageEventPanicError
+	if !errors.As(err, &perr) {
+		t.Fatalf("HandleStorageObjectEvent() error = %v, want a StorageEventPanicError", err)
+	}
+
+	if perr.Value != "boom" || len(perr.Stack) == 0 {
+		t.Errorf("StorageEventPanicError = %v with a stack of %d bytes, want boom with a stack", perr.Value, len(perr.Stack))
+	}
+
+	if got, want := err.Error(), "storage event handler panic: boom"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestStorageObjectEventLogging(t *testing.T) {
+	failure := errors.New("boom")
+
+	cases := []struct {
+		name  string
+		err   error
+		level string
+		msg   string
+	}{
+		{"Success", nil, "INFO", "storage event handled"},
+		{"Failure", failure, "ERROR", "storage event failed"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buffer bytes.Buffer
+
+			handler := storagev1.ChainStorageObjectEventMiddleware(
+				&counter{err: tc.err},
+				storagev1.StorageObjectEventLogging(slog.New(slog.NewJSONHandler(&buffer, nil))),
+			)
+
+			event := &storagev1.StorageObjectFinalizedEvent{
+				Object:   &storagev1.StorageObject{Bucket: "bucket", Name: "a", Generation: 7},
+				Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "42"},
+			}
+
+			if err := handler.HandleStorageObjectEvent(context.Background(), event); !errors.Is(err, tc.err) {
+				t.Fatalf("HandleStorageObjectEvent() error = %v, want %v", err, tc.err)
+			}
+
+			record := make(map[string]any)
+			if err := json.Unmarshal(buffer.Bytes(), &record); err != nil {
+				t.Fatalf("Unmarshal(%q): %v", buffer.String(), err)
+			}
+
+			want := map[string]any{
+				"level":      tc.level,
+				"msg":        tc.msg,
+				"event_type": "OBJECT_FINALIZE",
+				"bucket":     "bucket",
+				"object":     "a",
+				"generation": float64(7),
+				"message_id": "42",
+			}
+
+			for key, value := range want {
+				if record[key] != value {
+					t.Errorf("record[%q] = %v, want %v", key, record[key], value)
+				}
+			}
+
+			if _, ok := record["duration"]; !ok {
+				t.Errorf("record has no duration: %v", record)
+			}
+
+			if tc.err != nil && record["error"] != "boom" {
+				t.Errorf("record[error] = %v, want boom", record["error"])
+			}
+		})
+	}
+}
+
+func TestStorageObjectEventTimeout(t *testing.T) {
+	handler := storagev1.ChainStorageObjectEventMiddleware(
+		storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, _ storagev1.StorageObjectEvent) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}),
+		storagev1.StorageObjectEventTimeout(10*time.Millisecond),
+	)
+
+	if err := handler.HandleStorageObjectEvent(context.Background(), newFinalizedEvent("bucket", "a", "", nil)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("HandleStorageObjectEvent() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestStorageObjectEventConcurrency(t *testing.T) {
+	var (
+		running atomic.Int32
+		peak    atomic.Int32
+		wg      sync.WaitGroup
+	)
+
+	release := make(chan struct{})
+
+	handler := storagev1.ChainStorageObjectEventMiddleware(
+		storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+			n := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				if p := peak.Load(); n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+
+			<-release
+			return nil
+		}),
+		storagev1.StorageObjectEventConcurrency(2),
+	)
+
+	for range 6 {
+		wg.Go(func() {
+			if err := handler.HandleStorageObjectEvent(context.Background(), newFinalizedEvent("bucket", "a", "", nil)); err != nil {
+				t.Errorf("HandleStorageObjectEvent: %v", err)
+			}
+		})
+	}
+
+	// wait for the handlers to fill the limit
+	for running.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// an event fails when its context is done before a handler finishes
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := handler.HandleStorageObjectEvent(ctx, newFinalizedEvent("bucket", "a", "", nil)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("HandleStorageObjectEvent() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(release)
+	wg.Wait()
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("peak concurrency = %d, want 2", got)
+	}
+}
+
+func TestStorageObjectEventAttributes(t *testing.T) {
+	if got := storagev1.StorageObjectEventAttributesFromContext(context.Background()); got != nil {
+		t.Errorf("StorageObjectEventAttributesFromContext() = %v, want nil", got)
+	}
+
+	attrs := map[string]string{"traceparent": "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01"}
+
+	ctx := storagev1.WithStorageObjectEventAttributes(context.Background(), attrs)
+	if got := storagev1.StorageObjectEventAttributesFromContext(ctx); got["traceparent"] != attrs["traceparent"] {
+		t.Errorf("StorageObjectEventAttributesFromContext() = %v, want %v", got, attrs)
+	}
+}
@@ -0,0 +1,242 @@
+package storagev1
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+)
+
+// StorageObjectChange describes a change of an object that the notification
+// configs of its bucket publish. The local backends use it to emit the events
+// that Cloud Storage publishes.
+type StorageObjectChange struct {
+	// Type is the type of the change.
+	Type StorageObjectEventType
+	// Object is the object after the change, or the removed object.
+	Object *storage.ObjectAttrs
+	// Time is the time of the change.
+	Time time.Time
+	// OverwroteGeneration is the generation of the object that a new object
+	// replaced.
+	OverwroteGeneration int64
+	// OverwrittenByGeneration is the generation of the object that replaced
+	// an archived or a deleted object.
+	OverwrittenByGeneration int64
+}
+
+// Match reports whether a notification config publishes the change: the
+// config has the type of the change, or no types, and the object has the
+// prefix of the config.
+func (x *StorageObjectChange) Match(config *storage.Notification) bool {
+	if len(config.EventTypes) > 0 && !slices.Contains(config.EventTypes, x.Type.String()) {
+		return false
+	}
+
+	return strings.HasPrefix(x.Object.Name, config.ObjectNamePrefix)
+}
+
+// Attributes returns the attributes of the message that a notification config
+// publishes for the change.
+func (x *StorageObjectChange) Attributes(config *storage.Notification) map[string]string {
+	attrs := make(map[string]string, len(config.CustomAttributes)+9)
+	// the custom attributes do not override the attributes of the service
+	for k, v := range config.CustomAttributes {
+		attrs[k] = v
+	}
+
+	payload := config.PayloadFormat
+	if payload == "" {
+		payload = storage.JSONPayload
+	}
+
+	attrs["notificationConfig"] = fmt.Sprintf("projects/_/buckets/%s/notificationConfigs/%s", x.Object.Bucket, config.ID)
+	attrs["eventType"] = x.Type.String()
+	attrs["payloadFormat"] = payload
+	attrs["bucketId"] = x.Object.Bucket
+	attrs["objectId"] = x.Object.Name
+	attrs["objectGeneration"] = strconv.FormatInt(x.Object.Generation, 10)
+	attrs["eventTime"] = x.Time.UTC().Format(time.RFC3339Nano)
+
+	if x.OverwroteGeneration != 0 {
+		attrs["overwroteGeneration"] = strconv.FormatInt(x.OverwroteGeneration, 10)
+	}
+
+	if x.OverwrittenByGeneration != 0 {
+		attrs["overwrittenByGeneration"] = strconv.FormatInt(x.OverwrittenByGeneration, 10)
+	}
+
+	return attrs
+}
+
+// Data returns the payload of the message that a notification config
+// publishes for the change: the object in the JSON API representation, or
+// nothing when the config has no payload.
+func (x *StorageObjectChange) Data(config *storage.Notification) ([]byte, error) {
+	if config.PayloadFormat == storage.NoPayload {
+		return nil, nil
+	}
+
+	return StorageObjectFromAttrs(x.Object).MarshalJSON()
+}
+
+// RetiredStorageObjectEventType returns the type of the change that retires
+// the live version of an object in a bucket: the object is archived when the
+// bucket keeps its versions, and deleted otherwise.
+func RetiredStorageObjectEventType(attrs *storage.BucketAttrs) StorageObjectEventType {
+	if attrs.VersioningEnabled {
+		return StorageObjectEventType_OBJECT_ARCHIVE
+	}
+
+	return StorageObjectEventType_OBJECT_DELETE
+}
+
+// StorageObjectNotificationError is the error of a notification that a
+// StorageObjectNotifier could not deliver.
+type StorageObjectNotificationError struct {
+	// Config is the ID of the notification config.
+	Config string
+	// Change is the change that the notification publishes.
+	Change *StorageObjectChange
+	// Event is the event of the notification, or nil when it could not be
+	// built.
+	Event StorageObjectEvent
+	// Err is the error of the event or of the handler.
+	Err error
+}
+
+// Error implements error.
+func (x *StorageObjectNotificationError) Error() string {
+	return fmt.Sprintf("storage notification %s of %s/%s: %v", x.Config, x.Change.Object.Bucket, x.Change.Object.Name, x.Err)
+}
+
+// Unwrap returns the error of the event or of the handler.
+func (x *StorageObjectNotificationError) Unwrap() error {
+	return x.Err
+}
+
+// StorageObjectNotifier delivers the events that the notification configs of
+// a bucket publish for the changes of its objects. The local backends queue
+// the events of a change while they hold their lock, and deliver them once the
+// change is done, so that the handler can use the client.
+//
+// A notification is never redelivered. The notifications that cannot be built
+// and the errors of the handler are passed to the error handler as a
+// StorageObjectNotificationError, and they are logged with the default logger
+// when there is no error handler.
+type StorageObjectNotifier struct {
+	mu        sync.Mutex
+	handler   StorageObjectEventHandler
+	onError   func(context.Context, error)
+	pending   []*storageObjectNotification
+	messageID int
+}
+
+// storageObjectNotification is a notification that waits for its delivery.
+type storageObjectNotification struct {
+	attrs map[string]string
+	err   *StorageObjectNotificationError
+}
+
+// SetHandler sets the handler of the events. No events are queued without a
+// handler.
+func (x *StorageObjectNotifier) SetHandler(handler StorageObjectEventHandler) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the handler
+	x.handler = handler
+}
+
+// SetErrorHandler sets the handler of the notifications that cannot be
+// delivered.
+func (x *StorageObjectNotifier) SetErrorHandler(fn func(context.Context, error)) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the error handler
+	x.onError = fn
+}
+
+// Notify queues the events that the notification configs, keyed by their ID,
+// publish for a change, in the order of their IDs. The events are decoded from
+// their message as a subscriber does, and their publish time is the time of
+// the change.
+func (x *StorageObjectNotifier) Notify(configs map[string]*storage.Notification, change *StorageObjectChange) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.handler == nil {
+		return
+	}
+
+	ids := slices.Collect(maps.Keys(configs))
+	// publish in the order of the configs
+	slices.SortFunc(ids, func(a, b string) int {
+		m, _ := strconv.Atoi(a)
+		n, _ := strconv.Atoi(b)
+		return cmp.Or(cmp.Compare(m, n), strings.Compare(a, b))
+	})
+
+	for _, id := range ids {
+		config := configs[id]
+		if !change.Match(config) {
+			continue
+		}
+
+		n := &storageObjectNotification{
+			attrs: change.Attributes(config),
+			err:   &StorageObjectNotificationError{Config: id, Change: change},
+		}
+
+		x.pending = append(x.pending, n)
+
+		data, err := change.Data(config)
+		if err != nil {
+			n.err.Err = err
+			continue
+		}
+
+		x.messageID++
+		// decode the message as a subscriber does
+		n.err.Event, n.err.Err = NewStorageObjectPubsubEvent(n.attrs, data, strconv.Itoa(x.messageID), change.Time.UTC())
+	}
+}
+
+// Flush delivers the queued events to the handler, and reports the
+// notifications that cannot be delivered. The caller must not hold the lock
+// of its backend, so that the handlers can use the client. The queued events
+// are dropped when the handler has been removed since they were queued.
+func (x *StorageObjectNotifier) Flush(ctx context.Context) {
+	x.mu.Lock()
+	pending, handler, onError := x.pending, x.handler, x.onError
+	x.pending = nil
+	x.mu.Unlock()
+
+	if handler == nil {
+		return
+	}
+
+	ctx = context.WithoutCancel(ctx)
+
+	for _, n := range pending {
+		if n.err.Err == nil {
+			// the events are not redelivered
+			n.err.Err = handler.HandleStorageObjectEvent(WithStorageObjectEventAttributes(ctx, n.attrs), n.err.Event)
+		}
+
+		switch {
+		case n.err.Err == nil:
+		case onError != nil:
+			onError(ctx, n.err)
+		default:
+			slog.ErrorContext(ctx, "storage notification dropped", slog.Any("error", n.err))
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package storagev1_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func newChange(kind storagev1.StorageObjectEventType, name string) *storagev1.StorageObjectChange {
+	return &storagev1.StorageObjectChange{
+		Type:   kind,
+		Object: &storage.ObjectAttrs{Bucket: "bucket", Name: name, Generation: 7, Size: 5},
+		Time:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func TestStorageObjectChange(t *testing.T) {
+	change := newChange(storagev1.StorageObjectEventType_OBJECT_FINALIZE, "logs/a")
+	change.OverwroteGeneration = 6
+
+	cases := []struct {
+		name   string
+		config *storage.Notification
+		want   bool
+	}{
+		{"Every", &storage.Notification{}, true},
+		{"Type", &storage.Notification{EventTypes: []string{storage.ObjectFinalizeEvent}}, true},
+		{"OtherType", &storage.Notification{EventTypes: []string{storage.ObjectDeleteEvent}}, false},
+		{"Prefix", &storage.Notification{ObjectNamePrefix: "logs/"}, true},
+		{"OtherPrefix", &storage.Notification{ObjectNamePrefix: "images/"}, false},
+	}
+
+	for _, tc := range cases {
+		if got := change.Match(tc.config); got != tc.want {
+			t.Errorf("Match(%s) = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	config := &storage.Notification{ID: "3", CustomAttributes: map[string]string{"team": "core", "eventType": "custom"}}
+
+	attrs := change.Attributes(config)
+	want := map[string]string{
+		"team":                "core",
+		"eventType":           "OBJECT_FINALIZE",
+		"notificationConfig":  "projects/_/buckets/bucket/notificationConfigs/3",
+		"payloadFormat":       storage.JSONPayload,
+		"bucketId":            "bucket",
+		"objectId":            "logs/a",
+		"objectGeneration":    "7",
+		"eventTime":           "2026-01-02T03:04:05Z",
+		"overwroteGeneration": "6",
+	}
+
+	for key, value := range want {
+		if attrs[key] != value {
+			t.Errorf("Attributes()[%q] = %q, want %q", key, attrs[key], value)
+		}
+	}
+
+	if _, ok := attrs["overwrittenByGeneration"]; ok {
+		t.Errorf("Attributes() has overwrittenByGeneration, want none")
+	}
+
+	data, err := change.Data(config)
+	if err != nil || !bytes.Contains(data, []byte(`"name":"logs/a"`)) {
+		t.Errorf("Data() = %s, %v, want the object", data, err)
+	}
+
+	if data, err := change.Data(&storage.Notification{PayloadFormat: storage.NoPayload}); data != nil || err != nil {
+		t.Errorf("Data() without a payload = %s, %v, want nil", data, err)
+	}
+}
+
+func TestRetiredStorageObjectEventType(t *testing.T) {
+	if got := storagev1.RetiredStorageObjectEventType(&storage.BucketAttrs{}); got != storagev1.StorageObjectEventType_OBJECT_DELETE {
+		t.Errorf("RetiredStorageObjectEventType() = %v, want %v", got, storagev1.StorageObjectEventType_OBJECT_DELETE)
+	}
+
+	if got := storagev1.RetiredStorageObjectEventType(&storage.BucketAttrs{VersioningEnabled: true}); got != storagev1.StorageObjectEventType_OBJECT_ARCHIVE {
+		t.Errorf("RetiredStorageObjectEventType() of a versioned bucket = %v, want %v", got, storagev1.StorageObjectEventType_OBJECT_ARCHIVE)
+	}
+}
+
+func TestStorageObjectNotifier(t *testing.T) {
+	var (
+		configs  []string
+		messages []string
+	)
+
+	notifier := &storagev1.StorageObjectNotifier{}
+	// no events are queued without a handler
+	notifier.Notify(map[string]*storage.Notification{"1": {ID: "1"}}, newChange(storagev1.StorageObjectEventType_OBJECT_FINALIZE, "a"))
+
+	notifier.SetHandler(storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, event storagev1.StorageObjectEvent) error {
+		attrs := storagev1.StorageObjectEventAttributesFromContext(ctx)
+		configs = append(configs, attrs["notificationConfig"])
+		messages = append(messages, event.GetEnvelope().GetMessageId())
+		return nil
+	}))
+
+	notifier.Notify(map[string]*storage.Notification{
+		"10": {ID: "10"},
+		"2":  {ID: "2", PayloadFormat: storage.NoPayload},
+		"1":  {ID: "1"},
+		"3":  {ID: "3", ObjectNamePrefix: "logs/"},
+	}, newChange(storagev1.StorageObjectEventType_OBJECT_FINALIZE, "a"))
+
+	if len(configs) != 0 {
+		t.Fatalf("events before Flush = %d, want 0", len(configs))
+	}
+
+	notifier.Flush(context.Background())
+
+	want := []string{
+		"projects/_/buckets/bucket/notificationConfigs/1",
+		"projects/_/buckets/bucket/notificationConfigs/2",
+		"projects/_/buckets/bucket/notificationConfigs/10",
+	}
+
+	if !slices.Equal(configs, want) {
+		t.Errorf("configs = %v, want %v", configs, want)
+	}
+
+	if want := []string{"1", "2", "3"}; !slices.Equal(messages, want) {
+		t.Errorf("message IDs = %v, want %v", messages, want)
+	}
+
+	// the queue is empty once flushed
+	notifier.Flush(context.Background())
+
+	if len(configs) != 3 {
+		t.Errorf("events after a second Flush = %d, want 3", len(configs))
+	}
+}
+
+func TestStorageObjectNotifierWithoutHandler(t *testing.T) {
+	notifier := &storagev1.StorageObjectNotifier{}
+	// a flush without a handler delivers nothing
+	notifier.Flush(context.Background())
+
+	var calls int
+	notifier.SetHandler(storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+		calls++
+		return nil
+	}))
+
+	notifier.Notify(map[string]*storage.Notification{"1": {ID: "1"}}, newChange(storagev1.StorageObjectEventType_OBJECT_FINALIZE, "a"))
+	// the handler is removed before the events are flushed
+	notifier.SetHandler(nil)
+	notifier.Flush(context.Background())
+
+	notifier.SetHandler(storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+		calls++
+		return nil
+	}))
+
+	// the dropped events are not delivered to a later handler
+	notifier.Flush(context.Background())
+
+	if calls != 0 {
+		t.Errorf("calls = %d, want 0", calls)
+	}
+}
+
+func TestStorageObjectNotifierErrors(t *testing.T) {
+	failure := errors.New("boom")
+
+	notifier := &storagev1.StorageObjectNotifier{}
+	notifier.SetHandler(storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+		return failure
+	}))
+
+	var errs []error
+	notifier.SetErrorHandler(func(_ context.Context, err error) {
+		errs = append(errs, err)
+	})
+
+	configs := map[string]*storage.Notification{"1": {ID: "1"}}
+
+	notifier.Notify(configs, newChange(storagev1.StorageObjectEventType_OBJECT_FINALIZE, "a"))
+	// an unknown type cannot be decoded into an event
+	notifier.Notify(configs, newChange(storagev1.StorageObjectEventType_OBJECT_UNSPECIFIED, "b"))
+	notifier.Flush(context.Background())
+
+	if len(errs) != 2 {
+		t.Fatalf("errors = %v, want 2", errs)
+	}
+
+	var nerr *storagev1.StorageObjectNotificationError
+	if !errors.As(errs[0], &nerr) || !errors.Is(errs[0], failure) {
+		t.Fatalf("error = %v, want a StorageObjectNotificationError of %v", errs[0], failure)
+	}
+
+	if nerr.Config != "1" || nerr.Event == nil || nerr.Change.Object.Name != "a" {
+		t.Errorf("error = %+v, want the config, the event and the change of a", nerr)
+	}
+
+	if want := "storage notification 1 of bucket/a: boom"; nerr.Error() != want {
+		t.Errorf("Error() = %q, want %q", nerr.Error(), want)
+	}
+
+	if !errors.As(errs[1], &nerr) || !errors.Is(errs[1], storagev1.ErrStorageEventTypeUnknown) || nerr.Event != nil {
+		t.Errorf("error = %v, want a StorageObjectNotificationError of %v without an event", errs[1], storagev1.ErrStorageEventTypeUnknown)
+	}
+}
+
+func TestStorageObjectNotifierLogging(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buffer, nil)))
+	defer slog.SetDefault(logger)
+
+	notifier := &storagev1.StorageObjectNotifier{}
+	notifier.SetHandler(storagev1.StorageObjectEventHandlerFunc(func(context.Context, storagev1.StorageObjectEvent) error {
+		return errors.New("boom")
+	}))
+
+	notifier.Notify(map[string]*storage.Notification{"1": {ID: "1"}}, newChange(storagev1.StorageObjectEventType_OBJECT_DELETE, "a"))
+	notifier.Flush(context.Background())
+
+	if !strings.Contains(buffer.String(), "storage notification dropped") || !strings.Contains(buffer.String(), "boom") {
+		t.Errorf("log = %q, want the dropped notification", buffer.String())
+	}
+}
@@ -0,0 +1,20 @@
+package storagev1
+
+import (
+	storage "cloud.google.com/go/storage"
+)
+
+// StorageObjectReaderGeneration returns the generation of the object that a
+// reader reads, or zero when the reader does not report it. The readers of
+// the storage package report it in their attributes, and the other readers
+// report it with a Generation method.
+func StorageObjectReaderGeneration(reader StorageObjectReader) int64 {
+	switch r := reader.(type) {
+	case *storage.Reader:
+		return r.Attrs.Generation
+	case interface{ Generation() int64 }:
+		return r.Generation()
+	default:
+		return 0
+	}
+}
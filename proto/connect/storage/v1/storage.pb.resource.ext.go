@@ -1,5 +1,25 @@
 package storagev1
 
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const (
+	// schemeGS is the scheme of the gs:// URIs.
+	schemeGS = "gs://"
+	// hostStorage serves the public and signed URLs of the objects.
+	hostStorage = "storage.googleapis.com"
+	// hostStorageCloud serves the browser URLs that authenticate the user.
+	hostStorageCloud = "storage.cloud.google.com"
+	// prefixAsset is the service prefix of the names used by Cloud Asset and
+	// the audit logs.
+	prefixAsset = "//storage.googleapis.com/"
+	// projectAny is the project of the names that do not carry one.
+	projectAny = "_"
+)
+
 // Key returns the key representation.
 func (x ParsedProjectName) Key() string {
 	return x.ProjectID
@@ -25,6 +45,29 @@ func (x ParsedBucketName) String() string {
 	return x.Name()
 }
 
+// URI returns the gs:// URI representation.
+func (x ParsedBucketName) URI() string {
+	return schemeGS + x.BucketID
+}
+
+// URL returns the https://storage.googleapis.com URL representation.
+func (x ParsedBucketName) URL() string {
+	return "https://" + hostStorage + "/" + url.PathEscape(x.BucketID)
+}
+
+// AssetName returns the //storage.googleapis.com name representation used by
+// Cloud Asset and the audit logs.
+func (x ParsedBucketName) AssetName() string {
+	x.ProjectID = projectOrAny(x.ProjectID)
+	// done!
+	return prefixAsset + x.Name()
+}
+
+// Handle returns the handle of the bucket.
+func (x ParsedBucketName) Handle(client StorageServiceClient) StorageBucketHandle {
+	return client.Bucket(x.BucketID)
+}
+
 // Key returns the key representation.
 func (x ParsedObjectName) Key() string {
 	return x.ObjectID
@@ -42,3 +85,167 @@ func (x ParsedObjectName) Parent() ParsedBucketName {
 func (x ParsedObjectName) String() string {
 	return x.Name()
 }
+
+// URI returns the gs:// URI representation.
+func (x ParsedObjectName) URI() string {
+	return schemeGS + x.BucketID + "/" + x.ObjectID
+}
+
+// URL returns the https://storage.googleapis.com URL representation.
+func (x ParsedObjectName) URL() string {
+	return x.Parent().URL() + "/" + escapeObjectID(x.ObjectID)
+}
+
+// AuthenticatedURL returns the https://storage.cloud.google.com URL
+// representation, which authenticates the user in a browser.
+func (x ParsedObjectName) AuthenticatedURL() string {
+	return "https://" + hostStorageCloud + "/" + url.PathEscape(x.BucketID) + "/" + escapeObjectID(x.ObjectID)
+}
+
+// AssetName returns the //storage.googleapis.com name representation used by
+// Cloud Asset and the audit logs.
+func (x ParsedObjectName) AssetName() string {
+	// the asset names carry the raw object ID
+	return x.Parent().AssetName() + "/objects/" + x.ObjectID
+}
+
+// Handle returns the handle of the object.
+func (x ParsedObjectName) Handle(client StorageServiceClient) StorageObjectHandle {
+	return client.Bucket(x.BucketID).Object(x.ObjectID)
+}
+
+// ParseBucketURL parses a bucket from a gs://bucket URI, an
+// https://storage.googleapis.com/bucket or
+// https://storage.cloud.google.com/bucket URL, or a
+// //storage.googleapis.com/projects/_/buckets/bucket name. The project is "_"
+// unless the name carries one.
+func ParseBucketURL(s string) (ParsedBucketName, error) {
+	object, err := parseURL(s)
+	if err != nil {
+		return ParsedBucketName{}, err
+	}
+
+	if object.ObjectID != "" {
+		return ParsedBucketName{}, fmt.Errorf("parse %q: unexpected object %q", s, object.ObjectID)
+	}
+
+	return object.Parent(), nil
+}
+
+// ParseObjectURL parses an object from a gs://bucket/object URI, an
+// https://storage.googleapis.com/bucket/object or
+// https://storage.cloud.google.com/bucket/object URL, or a
+// //storage.googleapis.com/projects/_/buckets/bucket/objects/object name. The
+// project is "_" unless the name carries one.
+func ParseObjectURL(s string) (ParsedObjectName, error) {
+	object, err := parseURL(s)
+	if err != nil {
+		return ParsedObjectName{}, err
+	}
+
+	if object.ObjectID == "" {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: missing object", s)
+	}
+
+	return object, nil
+}
+
+// parseURL parses a bucket or an object URL. The object ID is empty for a
+// bucket URL.
+func parseURL(s string) (ParsedObjectName, error) {
+	out := ParsedObjectName{ProjectID: projectAny}
+
+	switch {
+	case strings.HasPrefix(s, schemeGS):
+		// gs:// URIs are not escaped
+		out.BucketID, out.ObjectID, _ = strings.Cut(strings.TrimPrefix(s, schemeGS), "/")
+	case strings.HasPrefix(s, prefixAsset):
+		name := strings.TrimPrefix(s, prefixAsset)
+		// Cloud Asset names a bucket without its project
+		if !strings.HasPrefix(name, "projects/") {
+			out.BucketID = name
+			break
+		}
+
+		// an object name has segments past the bucket and carries the raw
+		// object ID
+		if strings.Count(name, "/") > 3 {
+			object, err := splitObjectName(name)
+			if err != nil {
+				return ParsedObjectName{}, fmt.Errorf("parse %q: %w", s, err)
+			}
+
+			out = object
+			break
+		}
+
+		bucket, err := ParseBucketName(name)
+		if err != nil {
+			return ParsedObjectName{}, fmt.Errorf("parse %q: %w", s, err)
+		}
+
+		out.ProjectID = bucket.ProjectID
+		out.BucketID = bucket.BucketID
+	case strings.HasPrefix(s, "https://"):
+		u, err := url.Parse(s)
+		if err != nil {
+			return ParsedObjectName{}, fmt.Errorf("parse %q: %w", s, err)
+		}
+
+		if u.Host != hostStorage && u.Host != hostStorageCloud {
+			return ParsedObjectName{}, fmt.Errorf("parse %q: invalid host, want: %q or %q, got: %q", s, hostStorage, hostStorageCloud, u.Host)
+		}
+
+		if u.RawQuery != "" || u.Fragment != "" {
+			return ParsedObjectName{}, fmt.Errorf("parse %q: unexpected query or fragment", s)
+		}
+
+		out.BucketID, out.ObjectID, _ = strings.Cut(strings.TrimPrefix(u.Path, "/"), "/")
+	default:
+		return ParsedObjectName{}, fmt.Errorf("parse %q: invalid scheme, want: %q, %q or %q", s, schemeGS, "https://", prefixAsset)
+	}
+
+	if out.BucketID == "" || strings.Contains(out.BucketID, "/") {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: invalid bucket %q", s, out.BucketID)
+	}
+
+	return out, nil
+}
+
+// splitObjectName splits a projects/project/buckets/bucket/objects/object name
+// into its segments. The object ID is everything after the objects segment
+// and is not unescaped.
+func splitObjectName(s string) (ParsedObjectName, error) {
+	p := strings.SplitN(s, "/", 6)
+	if len(p) != 6 {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: bad number of segments, want: 6, got: %d", s, len(p))
+	}
+
+	if p[0] != "projects" {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: bad segment 0, want: %q, got: %q", s, "projects", p[0])
+	}
+
+	if p[2] != "buckets" {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: bad segment 2, want: %q, got: %q", s, "buckets", p[2])
+	}
+
+	if p[4] != "objects" {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: bad segment 4, want: %q, got: %q", s, "objects", p[4])
+	}
+
+	return ParsedObjectName{ProjectID: p[1], BucketID: p[3], ObjectID: p[5]}, nil
+}
+
+// escapeObjectID escapes an object ID as a URL path, keeping its slashes, as
+// the object names do.
+func escapeObjectID(v string) string {
+	return strings.ReplaceAll(url.PathEscape(v), "%2F", "/")
+}
+
+func projectOrAny(v string) string {
+	if v == "" {
+		return projectAny
+	}
+
+	return v
+}
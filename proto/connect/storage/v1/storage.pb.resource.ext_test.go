@@ -0,0 +1,219 @@
+package storagev1_test
+
+import (
+	"strings"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestParseObjectName(t *testing.T) {
+	cases := []struct {
+		name string
+		want storagev1.ParsedObjectName
+	}{
+		{
+			name: "projects/p/buckets/b/objects/c.txt",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "c.txt"},
+		},
+		{
+			name: "projects/p/buckets/b/objects/a/b/c.txt",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "a/b/c.txt"},
+		},
+		{
+			name: "projects/p/buckets/b/objects/dir/100%25%20done%3F.txt",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "dir/100% done?.txt"},
+		},
+		{
+			name: "projects/p/buckets/b/objects/a%252Fb",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "a%2Fb"},
+		},
+		{
+			name: "projects/p/buckets/b/objects/dir/a%252Fb/c%2525.txt",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "dir/a%2Fb/c%25.txt"},
+		},
+		{
+			name: "projects/p/buckets/b/objects//leading//double/",
+			want: storagev1.ParsedObjectName{ProjectID: "p", BucketID: "b", ObjectID: "/leading//double/"},
+		},
+		{
+			name: "projects/objects/buckets/b/objects/x/objects/y",
+			want: storagev1.ParsedObjectName{ProjectID: "objects", BucketID: "b", ObjectID: "x/objects/y"},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := storagev1.ParseObjectName(tc.name)
+		if err != nil {
+			t.Fatalf("ParseObjectName(%q): %v", tc.name, err)
+		}
+
+		if got != tc.want {
+			t.Errorf("ParseObjectName(%q) = %+v, want %+v", tc.name, got, tc.want)
+		}
+
+		if name := got.Name(); name != tc.name {
+			t.Errorf("Name() = %q, want %q", name, tc.name)
+		}
+	}
+}
+
+func TestParseObjectNameError(t *testing.T) {
+	for _, name := range []string{
+		"projects/p/buckets/b",
+		"projects/p/buckets/b/files/c",
+		"folders/p/buckets/b/objects/c",
+		"projects/p/bucket/b/objects/c",
+		"projects/p/buckets/b/objects/100%",
+	} {
+		if _, err := storagev1.ParseObjectName(name); err == nil {
+			t.Errorf("ParseObjectName(%q): want an error", name)
+		}
+	}
+}
+
+func FuzzParsedObjectNameEscapedRoundTrip(f *testing.F) {
+	f.Add("p", "b", "c.txt")
+	f.Add("p", "b", "a/b/c.txt")
+	f.Add("p", "b", "100%.txt")
+	f.Add("p", "b", "a%2Fb")
+	f.Add("_", "b", "/leading//double/")
+	f.Add("p", "b", "?#[] ünïcode")
+
+	f.Fuzz(func(t *testing.T, project, bucket, object string) {
+		if project == "" || bucket == "" || strings.Contains(project, "/") || strings.Contains(bucket, "/") {
+			t.Skip()
+		}
+
+		want := storagev1.ParsedObjectName{ProjectID: project, BucketID: bucket, ObjectID: object}
+
+		got, err := storagev1.ParseObjectName(want.Name())
+		if err != nil {
+			t.Fatalf("ParseObjectName(%q): %v", want.Name(), err)
+		}
+
+		if got != want {
+			t.Fatalf("ParseObjectName(%q) = %+v, want %+v", want.Name(), got, want)
+		}
+
+		got, err = storagev1.ParseFullObjectName(want.FullName())
+		if err != nil {
+			t.Fatalf("ParseFullObjectName(%q): %v", want.FullName(), err)
+		}
+
+		if got != want {
+			t.Fatalf("ParseFullObjectName(%q) = %+v, want %+v", want.FullName(), got, want)
+		}
+	})
+}
+
+func TestParseObjectURL(t *testing.T) {
+	want := storagev1.ParsedObjectName{ProjectID: "_", BucketID: "b", ObjectID: "dir/100% done?.txt"}
+
+	for _, name := range []string{
+		"gs://b/dir/100% done?.txt",
+		"https://storage.googleapis.com/b/dir/100%25%20done%3F.txt",
+		"https://storage.cloud.google.com/b/dir/100%25%20done%3F.txt",
+		"//storage.googleapis.com/projects/_/buckets/b/objects/dir/100% done?.txt",
+	} {
+		got, err := storagev1.ParseObjectURL(name)
+		if err != nil {
+			t.Fatalf("ParseObjectURL(%q): %v", name, err)
+		}
+
+		if got != want {
+			t.Errorf("ParseObjectURL(%q) = %+v, want %+v", name, got, want)
+		}
+	}
+
+	if got := want.URL(); got != "https://storage.googleapis.com/b/dir/100%25%20done%3F.txt" {
+		t.Errorf("URL() = %q", got)
+	}
+
+	cases := []struct {
+		name string
+		want storagev1.ParsedObjectName
+	}{
+		{
+			name: "//storage.googleapis.com/projects/_/buckets/b/objects/100%",
+			want: storagev1.ParsedObjectName{ProjectID: "_", BucketID: "b", ObjectID: "100%"},
+		},
+		{
+			name: "//storage.googleapis.com/projects/_/buckets/b/objects/a%2520b",
+			want: storagev1.ParsedObjectName{ProjectID: "_", BucketID: "b", ObjectID: "a%2520b"},
+		},
+	}
+
+	for _, tc := range cases {
+		got, err := storagev1.ParseObjectURL(tc.name)
+		if err != nil {
+			t.Fatalf("ParseObjectURL(%q): %v", tc.name, err)
+		}
+
+		if got != tc.want {
+			t.Errorf("ParseObjectURL(%q) = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+
+	name := "//storage.googleapis.com/projects/_/buckets/b/files/c"
+	if _, err := storagev1.ParseObjectURL(name); err == nil || !strings.Contains(err.Error(), "bad segment 4") {
+		t.Errorf("ParseObjectURL(%q): want the object parse error, got: %v", name, err)
+	}
+}
+
+func TestParseBucketURL(t *testing.T) {
+	for _, name := range []string{
+		"gs://b",
+		"https://storage.googleapis.com/b",
+		"//storage.googleapis.com/b",
+		"//storage.googleapis.com/projects/_/buckets/b",
+	} {
+		got, err := storagev1.ParseBucketURL(name)
+		if err != nil {
+			t.Fatalf("ParseBucketURL(%q): %v", name, err)
+		}
+
+		if want := (storagev1.ParsedBucketName{ProjectID: "_", BucketID: "b"}); got != want {
+			t.Errorf("ParseBucketURL(%q) = %+v, want %+v", name, got, want)
+		}
+	}
+
+	for _, name := range []string{
+		"s3://b",
+		"gs://",
+		"gs://b/o",
+		"https://example.com/b",
+		"https://storage.googleapis.com/b?x=1",
+	} {
+		if _, err := storagev1.ParseBucketURL(name); err == nil {
+			t.Errorf("ParseBucketURL(%q): want an error", name)
+		}
+	}
+}
+
+func FuzzParsedObjectNameURLRoundTrip(f *testing.F) {
+	f.Add("b", "c.txt")
+	f.Add("b", "a/b/c.txt")
+	f.Add("b", "100%.txt")
+	f.Add("b", "a%2Fb")
+	f.Add("b", "?#[] ünïcode")
+
+	f.Fuzz(func(t *testing.T, bucket, object string) {
+		if bucket == "" || object == "" || strings.Contains(bucket, "/") {
+			t.Skip()
+		}
+
+		want := storagev1.ParsedObjectName{ProjectID: "_", BucketID: bucket, ObjectID: object}
+
+		for _, name := range []string{want.URI(), want.URL(), want.AuthenticatedURL(), want.AssetName()} {
+			got, err := storagev1.ParseObjectURL(name)
+			if err != nil {
+				t.Fatalf("ParseObjectURL(%q): %v", name, err)
+			}
+
+			if got != want {
+				t.Fatalf("ParseObjectURL(%q) = %+v, want %+v", name, got, want)
+			}
+		}
+	})
+}
@@ -3,6 +3,7 @@ package storagev1
 
 import (
 	"fmt"
+	"net/url"
 	"strings"
 )
 
@@ -121,7 +122,7 @@ type ParsedObjectName struct {
 }
 
 func ParseObjectName(s string) (ParsedObjectName, error) {
-	p := strings.Split(s, "/")
+	p := strings.SplitN(s, "/", 6)
 	if len(p) != 6 {
 		return ParsedObjectName{}, fmt.Errorf("parse %q: bad number of segments, want: 6, got: %d", s, len(p))
 	}
@@ -144,7 +145,12 @@ func ParseObjectName(s string) (ParsedObjectName, error) {
 		return ParsedObjectName{}, fmt.Errorf("parse %q: bad segment 4, want: %q, got: %q", s, "objects", p[4])
 	}
 
-	out.ObjectID = p[5]
+	objectID, err := url.PathUnescape(p[5])
+	if err != nil {
+		return ParsedObjectName{}, fmt.Errorf("parse %q: bad segment 5: %w", s, err)
+	}
+
+	out.ObjectID = objectID
 
 	return out, nil
 }
@@ -180,7 +186,7 @@ func (n ParsedObjectName) Name() string {
 
 	out += "/"
 
-	out += n.ObjectID
+	out += strings.ReplaceAll(url.PathEscape(n.ObjectID), "%2F", "/")
 
 	return out
 }
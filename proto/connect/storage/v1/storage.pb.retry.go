@@ -0,0 +1,64 @@
+package storagev1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	googleapi "google.golang.org/api/googleapi"
+	iterator "google.golang.org/api/iterator"
+)
+
+// IsRetryableStorageError reports whether the error of a storage operation is
+// transient: a googleapi.Error with status 408, 429 or 5xx, a connect error
+// with code Unavailable, ResourceExhausted, Aborted or Internal, an unexpected
+// EOF in the middle of a read, or a reset, refused or timed out connection.
+// The context errors and iterator.Done are never transient.
+func IsRetryableStorageError(err error) bool {
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, iterator.Done):
+		return false
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return false
+	case errors.Is(err, storage.ErrBucketNotExist), errors.Is(err, storage.ErrObjectNotExist):
+		return false
+	case errors.Is(err, io.ErrUnexpectedEOF):
+		return true
+	case errors.Is(err, syscall.ECONNRESET), errors.Is(err, syscall.ECONNREFUSED):
+		return true
+	}
+
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		switch gerr.Code {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests:
+			return true
+		default:
+			return gerr.Code >= http.StatusInternalServerError
+		}
+	}
+
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		switch cerr.Code() {
+		case connect.CodeUnavailable, connect.CodeResourceExhausted, connect.CodeAborted, connect.CodeInternal:
+			return true
+		default:
+			return false
+		}
+	}
+
+	var nerr net.Error
+	if errors.As(err, &nerr) {
+		return nerr.Timeout()
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	googleapi "google.golang.org/api/googleapi"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func TestIsRetryableStorageError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{iterator.Done, false},
+		{context.Canceled, false},
+		{context.DeadlineExceeded, false},
+		{storage.ErrBucketNotExist, false},
+		{storage.ErrObjectNotExist, false},
+		{io.ErrUnexpectedEOF, true},
+		{fmt.Errorf("read: %w", io.ErrUnexpectedEOF), true},
+		{syscall.ECONNRESET, true},
+		{syscall.ECONNREFUSED, true},
+		{&googleapi.Error{Code: http.StatusRequestTimeout}, true},
+		{&googleapi.Error{Code: http.StatusTooManyRequests}, true},
+		{&googleapi.Error{Code: http.StatusInternalServerError}, true},
+		{&googleapi.Error{Code: http.StatusServiceUnavailable}, true},
+		{&googleapi.Error{Code: http.StatusForbidden}, false},
+		{&googleapi.Error{Code: http.StatusNotFound}, false},
+		{&googleapi.Error{Code: http.StatusPreconditionFailed}, false},
+		{connect.NewError(connect.CodeUnavailable, errors.New("unavailable")), true},
+		{connect.NewError(connect.CodeResourceExhausted, errors.New("exhausted")), true},
+		{connect.NewError(connect.CodeAborted, errors.New("aborted")), true},
+		{connect.NewError(connect.CodeInternal, errors.New("internal")), true},
+		{connect.NewError(connect.CodeFailedPrecondition, errors.New("precondition")), false},
+		{connect.NewError(connect.CodePermissionDenied, errors.New("denied")), false},
+		{&net.OpError{Op: "dial", Err: os.ErrDeadlineExceeded}, true},
+		{errors.New("other"), false},
+	}
+
+	for _, tc := range cases {
+		if got := storagev1.IsRetryableStorageError(tc.err); got != tc.want {
+			t.Errorf("IsRetryableStorageError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
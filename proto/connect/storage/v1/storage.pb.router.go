@@ -0,0 +1,159 @@
+package storagev1
+
+import (
+	"context"
+	"maps"
+	"mime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// StorageObjectEventRoute selects the events that a handler of a
+// StorageObjectEventRouter receives. An event matches a route when it matches
+// every field that is set.
+type StorageObjectEventRoute struct {
+	// EventTypes are the event types that the route matches. No event types
+	// match every event type.
+	EventTypes []StorageObjectEventType
+	// Bucket is the bucket that the route matches. An empty bucket matches
+	// every bucket.
+	Bucket string
+	// Prefix is the object name prefix that the route matches.
+	Prefix string
+	// Glob is the object name glob that the route matches.
+	Glob *StorageObjectGlob
+	// ContentType is the media type of the objects that the route matches,
+	// without its parameters. A type followed by "/*", such as "image/*",
+	// matches every subtype.
+	ContentType string
+	// Metadata are the metadata entries that the objects must have. An entry
+	// with an empty value requires the key only.
+	Metadata map[string]string
+}
+
+// Match reports whether the event matches the route.
+func (x *StorageObjectEventRoute) Match(event StorageObjectEvent) bool {
+	if len(x.EventTypes) > 0 && !slices.Contains(x.EventTypes, StorageObjectEventTypeOf(event)) {
+		return false
+	}
+
+	object := event.GetObject()
+
+	if x.Bucket != "" && x.Bucket != object.GetBucket() {
+		return false
+	}
+
+	if x.Prefix != "" && !strings.HasPrefix(object.GetName(), x.Prefix) {
+		return false
+	}
+
+	if x.Glob != nil && !x.Glob.Match(object.GetName()) {
+		return false
+	}
+
+	if x.ContentType != "" && !matchContentType(x.ContentType, object.GetContentType()) {
+		return false
+	}
+
+	metadata := object.GetMetadata()
+	// match the metadata
+	for key, value := range x.Metadata {
+		v, ok := metadata[key]
+		if !ok || (value != "" && value != v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchContentType reports whether a content type matches a media type
+// pattern.
+func matchContentType(pattern, value string) bool {
+	kind, _, err := mime.ParseMediaType(value)
+	if err != nil {
+		return false
+	}
+
+	pattern = strings.ToLower(pattern)
+	// match every subtype
+	if prefix, ok := strings.CutSuffix(pattern, "/*"); ok {
+		return strings.HasPrefix(kind, prefix+"/")
+	}
+
+	return kind == pattern
+}
+
+var _ StorageObjectEventHandler = &StorageObjectEventRouter{}
+
+// StorageObjectEventRouter is a StorageObjectEventHandler that dispatches an
+// event to the handler of the first route that matches it, in the order of
+// registration. An event that matches no route goes to the fallback handler,
+// and it is ignored when there is no fallback handler.
+//
+// Example:
+//
+//	router := &storagev1.StorageObjectEventRouter{}
+//	router.Handle(storagev1.StorageObjectEventRoute{
+//		EventTypes: []storagev1.StorageObjectEventType{storagev1.StorageObjectEventType_OBJECT_FINALIZE},
+//		Bucket:     "my-bucket",
+//		Glob:       storagev1.MustParseStorageObjectGlob("logs/**/*.gz"),
+//	}, handler)
+//	router.HandleFallback(fallback)
+type StorageObjectEventRouter struct {
+	mu       sync.RWMutex
+	routes   []*storageObjectEventRoute
+	fallback StorageObjectEventHandler
+}
+
+type storageObjectEventRoute struct {
+	route   StorageObjectEventRoute
+	handler StorageObjectEventHandler
+}
+
+// Handle registers the handler of a route.
+func (x *StorageObjectEventRouter) Handle(route StorageObjectEventRoute, handler StorageObjectEventHandler) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// copy the route, so that the caller cannot change it
+	route.EventTypes = slices.Clone(route.EventTypes)
+	route.Metadata = maps.Clone(route.Metadata)
+	// register the route
+	x.routes = append(x.routes, &storageObjectEventRoute{
+		route:   route,
+		handler: handler,
+	})
+}
+
+// HandleFallback registers the handler of the events that match no route.
+func (x *StorageObjectEventRouter) HandleFallback(handler StorageObjectEventHandler) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the fallback
+	x.fallback = handler
+}
+
+// HandleStorageObjectEvent implements StorageObjectEventHandler.
+func (x *StorageObjectEventRouter) HandleStorageObjectEvent(ctx context.Context, event StorageObjectEvent) error {
+	if handler := x.route(event); handler != nil {
+		return handler.HandleStorageObjectEvent(ctx, event)
+	}
+
+	// done!
+	return nil
+}
+
+// route returns the handler of an event, or nil.
+func (x *StorageObjectEventRouter) route(event StorageObjectEvent) StorageObjectEventHandler {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	for _, r := range x.routes {
+		if r.route.Match(event) {
+			return r.handler
+		}
+	}
+
+	return x.fallback
+}
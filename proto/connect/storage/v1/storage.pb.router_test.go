@@ -0,0 +1,121 @@
+package storagev1_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+func newFinalizedEvent(bucket, name, contentType string, metadata map[string]string) storagev1.StorageObjectEvent {
+	return &storagev1.StorageObjectFinalizedEvent{
+		Object: &storagev1.StorageObject{Bucket: bucket, Name: name, ContentType: contentType, Metadata: metadata},
+	}
+}
+
+func TestStorageObjectEventRouteMatch(t *testing.T) {
+	event := newFinalizedEvent("bucket", "logs/2026/01/app.log.gz", "application/gzip; charset=binary", map[string]string{"team": "core", "tier": "gold"})
+
+	cases := []struct {
+		name  string
+		route storagev1.StorageObjectEventRoute
+		want  bool
+	}{
+		{"Empty", storagev1.StorageObjectEventRoute{}, true},
+		{"EventType", storagev1.StorageObjectEventRoute{EventTypes: []storagev1.StorageObjectEventType{storagev1.StorageObjectEventType_OBJECT_FINALIZE}}, true},
+		{"OtherEventType", storagev1.StorageObjectEventRoute{EventTypes: []storagev1.StorageObjectEventType{storagev1.StorageObjectEventType_OBJECT_DELETE}}, false},
+		{"Bucket", storagev1.StorageObjectEventRoute{Bucket: "bucket"}, true},
+		{"OtherBucket", storagev1.StorageObjectEventRoute{Bucket: "other"}, false},
+		{"Prefix", storagev1.StorageObjectEventRoute{Prefix: "logs/"}, true},
+		{"OtherPrefix", storagev1.StorageObjectEventRoute{Prefix: "images/"}, false},
+		{"Glob", storagev1.StorageObjectEventRoute{Glob: storagev1.MustParseStorageObjectGlob("logs/**/*.gz")}, true},
+		{"OtherGlob", storagev1.StorageObjectEventRoute{Glob: storagev1.MustParseStorageObjectGlob("logs/*.gz")}, false},
+		{"ContentType", storagev1.StorageObjectEventRoute{ContentType: "application/gzip"}, true},
+		{"ContentTypeCase", storagev1.StorageObjectEventRoute{ContentType: "Application/GZIP"}, true},
+		{"ContentTypeWildcard", storagev1.StorageObjectEventRoute{ContentType: "application/*"}, true},
+		{"OtherContentType", storagev1.StorageObjectEventRoute{ContentType: "image/*"}, false},
+		{"Metadata", storagev1.StorageObjectEventRoute{Metadata: map[string]string{"team": "core"}}, true},
+		{"MetadataKey", storagev1.StorageObjectEventRoute{Metadata: map[string]string{"tier": ""}}, true},
+		{"OtherMetadata", storagev1.StorageObjectEventRoute{Metadata: map[string]string{"team": "edge"}}, false},
+		{"MissingMetadata", storagev1.StorageObjectEventRoute{Metadata: map[string]string{"owner": ""}}, false},
+		{
+			name: "Every",
+			route: storagev1.StorageObjectEventRoute{
+				EventTypes:  []storagev1.StorageObjectEventType{storagev1.StorageObjectEventType_OBJECT_FINALIZE},
+				Bucket:      "bucket",
+				Prefix:      "logs/",
+				Glob:        storagev1.MustParseStorageObjectGlob("**/*.gz"),
+				ContentType: "application/gzip",
+				Metadata:    map[string]string{"team": "core"},
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.route.Match(event); got != tc.want {
+				t.Errorf("Match() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	// a content type that cannot be parsed matches no content type route
+	invalid := newFinalizedEvent("bucket", "a", ";", nil)
+	if route := (storagev1.StorageObjectEventRoute{ContentType: "*/*"}); route.Match(invalid) {
+		t.Errorf("Match() of an invalid content type = true, want false")
+	}
+}
+
+// handlerName is a storage object event handler that records its name.
+type handlerName struct {
+	name  string
+	calls *[]string
+}
+
+func (x handlerName) HandleStorageObjectEvent(context.Context, storagev1.StorageObjectEvent) error {
+	*x.calls = append(*x.calls, x.name)
+	return nil
+}
+
+func TestStorageObjectEventRouter(t *testing.T) {
+	var calls []string
+
+	router := &storagev1.StorageObjectEventRouter{}
+
+	route := storagev1.StorageObjectEventRoute{Prefix: "logs/", Metadata: map[string]string{"team": "core"}}
+	router.Handle(route, handlerName{"logs", &calls})
+	router.Handle(storagev1.StorageObjectEventRoute{Bucket: "bucket"}, handlerName{"bucket", &calls})
+	// the router keeps its own copy of the route
+	route.Metadata["team"] = "edge"
+
+	events := []storagev1.StorageObjectEvent{
+		newFinalizedEvent("bucket", "logs/a", "", map[string]string{"team": "core"}),
+		newFinalizedEvent("bucket", "logs/a", "", nil),
+		newFinalizedEvent("other", "images/a", "", nil),
+	}
+
+	for _, event := range events {
+		if err := router.HandleStorageObjectEvent(context.Background(), event); err != nil {
+			t.Fatalf("HandleStorageObjectEvent: %v", err)
+		}
+	}
+
+	if want := []string{"logs", "bucket"}; !slices.Equal(calls, want) {
+		t.Errorf("calls without a fallback = %v, want %v", calls, want)
+	}
+
+	calls = nil
+	router.HandleFallback(handlerName{"fallback", &calls})
+
+	for _, event := range events {
+		if err := router.HandleStorageObjectEvent(context.Background(), event); err != nil {
+			t.Fatalf("HandleStorageObjectEvent: %v", err)
+		}
+	}
+
+	if want := []string{"logs", "bucket", "fallback"}; !slices.Equal(calls, want) {
+		t.Errorf("calls with a fallback = %v, want %v", calls, want)
+	}
+}
@@ -0,0 +1,388 @@
+package storagev1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+)
+
+// StorageObjectModTimeKey is the metadata key of the modification time of
+// the source file of an object, as gsutil and gcloud storage rsync keep it.
+const StorageObjectModTimeKey = "goog-reserved-file-mtime"
+
+// StorageSyncAction is the action of a sync on an object.
+type StorageSyncAction int
+
+const (
+	// StorageSyncActionSkip is the action on an object that is the same in
+	// the source and in the destination.
+	StorageSyncActionSkip StorageSyncAction = iota
+	// StorageSyncActionCopy is the action on an object that is missing in the
+	// destination or that differs from the source.
+	StorageSyncActionCopy
+	// StorageSyncActionDelete is the action on an object that exists only in
+	// the destination.
+	StorageSyncActionDelete
+)
+
+// String returns the string representation.
+func (x StorageSyncAction) String() string {
+	switch x {
+	case StorageSyncActionSkip:
+		return "skip"
+	case StorageSyncActionCopy:
+		return "copy"
+	case StorageSyncActionDelete:
+		return "delete"
+	default:
+		return fmt.Sprintf("StorageSyncAction(%d)", int(x))
+	}
+}
+
+// StorageSyncEntry is the action of a sync on an object.
+type StorageSyncEntry struct {
+	// Action is the action on the object.
+	Action StorageSyncAction
+	// Name is the name of the object relative to the prefixes of the sync.
+	Name string
+	// Source is the source object, or nil when it exists only in the
+	// destination.
+	Source *storage.ObjectAttrs
+	// Destination is the destination object, or nil when it does not exist.
+	Destination *storage.ObjectAttrs
+	// Err is the error of the action, once it has been applied.
+	Err error
+}
+
+// Size returns the number of the bytes of the action: the size of the source
+// object, or the size of the destination object of a deletion.
+func (x *StorageSyncEntry) Size() int64 {
+	if x.Source != nil {
+		return x.Source.Size
+	}
+
+	return x.Destination.Size
+}
+
+// StorageSyncPlan is the list of the actions of a sync, in the order of the
+// names of the objects.
+type StorageSyncPlan struct {
+	// Entries are the actions on the objects.
+	Entries []*StorageSyncEntry
+}
+
+// StorageSyncReport counts the objects and the bytes of a sync by outcome.
+type StorageSyncReport struct {
+	// Copied is the number of the objects that have been copied.
+	Copied int64
+	// CopiedBytes is the size of the objects that have been copied.
+	CopiedBytes int64
+	// Skipped is the number of the objects that are the same.
+	Skipped int64
+	// SkippedBytes is the size of the objects that are the same.
+	SkippedBytes int64
+	// Deleted is the number of the objects that have been deleted.
+	Deleted int64
+	// DeletedBytes is the size of the objects that have been deleted.
+	DeletedBytes int64
+	// Failed is the number of the objects whose action has failed.
+	Failed int64
+	// FailedBytes is the size of the objects whose action has failed.
+	FailedBytes int64
+}
+
+// add counts an entry that has been applied.
+func (x *StorageSyncReport) add(entry *StorageSyncEntry) {
+	size := entry.Size()
+
+	switch {
+	case entry.Err != nil:
+		x.Failed++
+		x.FailedBytes += size
+	case entry.Action == StorageSyncActionCopy:
+		x.Copied++
+		x.CopiedBytes += size
+	case entry.Action == StorageSyncActionDelete:
+		x.Deleted++
+		x.DeletedBytes += size
+	default:
+		x.Skipped++
+		x.SkippedBytes += size
+	}
+}
+
+// StorageSyncJob makes the objects under a prefix of a destination bucket
+// the same as the objects under a prefix of a source bucket, like rsync. It
+// lists both buckets, compares the objects with the same name by their size
+// and their CRC32C and MD5 checksums, and copies only the objects that are
+// missing or differ. The objects that exist only in the destination are
+// deleted when DeleteObjectsUniqueInSink is set, as the option of the Storage
+// Transfer Service. The objects are copied as by a StorageTransferJob, with
+// CopierFrom only when both buckets belong to the same backend under their
+// decorators.
+//
+// Example:
+//
+//	job := &storagev1.StorageSyncJob{
+//		Source:                    fsClient.Bucket("exports"),
+//		Destination:               gcsClient.Bucket("mirror"),
+//		DeleteObjectsUniqueInSink: true,
+//		Concurrency:               16,
+//	}
+//
+//	plan, err := job.Plan(ctx)
+//	if err != nil {
+//		return err
+//	}
+//
+//	report, err := job.Apply(ctx, plan)
+type StorageSyncJob struct {
+	// Source is the bucket the objects are copied from.
+	Source StorageBucketHandle
+	// SourcePrefix is the prefix of the source objects.
+	SourcePrefix string
+	// Destination is the bucket the objects are copied to.
+	Destination StorageBucketHandle
+	// DestinationPrefix is the prefix of the destination objects.
+	DestinationPrefix string
+	// Conditions selects the source objects that are synced. A destination
+	// object is deleted only when the name of its source satisfies them. The
+	// time conditions are evaluated at the start of the plan.
+	Conditions *StorageObjectConditions
+	// CompareModTime also compares the modification times that the objects
+	// keep in their StorageObjectModTimeKey metadata, when the source object
+	// has one.
+	CompareModTime bool
+	// DeleteObjectsUniqueInSink deletes the destination objects that have no
+	// source object.
+	DeleteObjectsUniqueInSink bool
+	// Concurrency is the number of the actions that are applied at a time.
+	// The default is one.
+	Concurrency int
+	// Progress is called for every entry once it has been applied.
+	Progress func(entry *StorageSyncEntry)
+}
+
+// Run plans the sync and applies it.
+func (x *StorageSyncJob) Run(ctx context.Context) (*StorageSyncReport, error) {
+	plan, err := x.Plan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// done!
+	return x.Apply(ctx, plan)
+}
+
+// Plan lists the source and the destination objects and returns the actions
+// that make the destination the same as the source, without applying them.
+func (x *StorageSyncJob) Plan(ctx context.Context) (*StorageSyncPlan, error) {
+	query := &storage.Query{Prefix: x.SourcePrefix}
+	if x.Conditions != nil {
+		if err := x.Conditions.Validate(); err != nil {
+			return nil, err
+		}
+
+		query = x.Conditions.Query(x.SourcePrefix)
+	}
+
+	now := time.Now()
+
+	src := x.Source.Objects(ctx, query)
+	dst := x.Destination.Objects(ctx, &storage.Query{Prefix: x.DestinationPrefix})
+
+	left, err := nextObject(src)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := nextObject(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &StorageSyncPlan{}
+	// merge the listings, which are in the order of the names
+	for left != nil || right != nil {
+		entry := &StorageSyncEntry{}
+
+		switch {
+		case right == nil || left != nil && x.sourceName(left) < x.destinationName(right):
+			entry.Action = StorageSyncActionCopy
+			entry.Name = x.sourceName(left)
+			entry.Source = left
+		case left == nil || x.destinationName(right) < x.sourceName(left):
+			entry.Action = StorageSyncActionDelete
+			entry.Name = x.destinationName(right)
+			entry.Destination = right
+		default:
+			entry.Action = StorageSyncActionSkip
+			entry.Name = x.sourceName(left)
+			entry.Source = left
+			entry.Destination = right
+
+			if !x.equal(left, right) {
+				entry.Action = StorageSyncActionCopy
+			}
+		}
+
+		if entry.Source != nil {
+			if left, err = nextObject(src); err != nil {
+				return nil, err
+			}
+		}
+
+		if entry.Destination != nil {
+			if right, err = nextObject(dst); err != nil {
+				return nil, err
+			}
+		}
+
+		if !x.selected(entry, now) {
+			continue
+		}
+
+		plan.Entries = append(plan.Entries, entry)
+	}
+
+	// done!
+	return plan, nil
+}
+
+// Apply applies the actions of a plan. It applies every action, also when
+// some fail, and it returns the errors of the failed actions with the report.
+func (x *StorageSyncJob) Apply(ctx context.Context, plan *StorageSyncPlan) (*StorageSyncReport, error) {
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		errs   []error
+		sem    = make(chan struct{}, max(x.Concurrency, 1))
+		report = &StorageSyncReport{}
+	)
+
+	for _, entry := range plan.Entries {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(entry *StorageSyncEntry) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			entry.Err = x.apply(ctx, entry)
+			if x.Progress != nil {
+				x.Progress(entry)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			report.add(entry)
+			// collect the error
+			if entry.Err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", entry.Action, entry.Name, entry.Err))
+			}
+		}(entry)
+	}
+
+	wg.Wait()
+	// the actions that have not been applied
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	// done!
+	return report, errors.Join(errs...)
+}
+
+// apply applies the action on an object.
+func (x *StorageSyncJob) apply(ctx context.Context, entry *StorageSyncEntry) error {
+	dst := x.Destination.Object(x.DestinationPrefix + entry.Name)
+
+	switch entry.Action {
+	case StorageSyncActionCopy:
+		src := x.Source.Object(entry.Source.Name)
+		// done!
+		_, err := copyObject(ctx, src, dst, entry.Source)
+		return err
+	case StorageSyncActionDelete:
+		// do not delete an object that has been replaced since the plan
+		dst = dst.If(storage.Conditions{GenerationMatch: entry.Destination.Generation})
+		// done!
+		return dst.Delete(ctx)
+	default:
+		return nil
+	}
+}
+
+// selected reports whether an entry belongs to the plan.
+func (x *StorageSyncJob) selected(entry *StorageSyncEntry, now time.Time) bool {
+	if entry.Action == StorageSyncActionDelete && !x.DeleteObjectsUniqueInSink {
+		return false
+	}
+
+	if x.Conditions == nil {
+		return true
+	}
+
+	// keep the destination of a source that is not selected
+	if entry.Source != nil {
+		return x.Conditions.Match(entry.Source, now)
+	}
+
+	return x.Conditions.MatchName(x.SourcePrefix + entry.Name)
+}
+
+// equal reports whether a destination object is the same as a source object.
+func (x *StorageSyncJob) equal(src, dst *storage.ObjectAttrs) bool {
+	if src.Size != dst.Size || src.CRC32C != dst.CRC32C {
+		return false
+	}
+
+	if len(src.MD5) > 0 && len(dst.MD5) > 0 && !bytes.Equal(src.MD5, dst.MD5) {
+		return false
+	}
+
+	if x.CompareModTime {
+		if mtime, ok := src.Metadata[StorageObjectModTimeKey]; ok && mtime != dst.Metadata[StorageObjectModTimeKey] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sourceName returns the name of a source object relative to its prefix.
+func (x *StorageSyncJob) sourceName(attrs *storage.ObjectAttrs) string {
+	return strings.TrimPrefix(attrs.Name, x.SourcePrefix)
+}
+
+// destinationName returns the name of a destination object relative to its
+// prefix.
+func (x *StorageSyncJob) destinationName(attrs *storage.ObjectAttrs) string {
+	return strings.TrimPrefix(attrs.Name, x.DestinationPrefix)
+}
+
+// nextObject returns the next object of an iterator, or nil at its end.
+func nextObject(iter StorageObjectIterator) (*storage.ObjectAttrs, error) {
+	attrs, err := iter.Next()
+	if errors.Is(err, iterator.Done) {
+		return nil, nil
+	}
+
+	return attrs, err
+}
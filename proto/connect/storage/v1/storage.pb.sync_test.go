@@ -0,0 +1,267 @@
+package storagev1_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1otel"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1retry"
+)
+
+// newSyncBuckets returns a source bucket with the objects a, b and c under
+// src/, and a destination bucket with the same a, another b and d under dst/.
+func newSyncBuckets(t *testing.T, client storagev1.StorageServiceClient) (storagev1.StorageBucketHandle, storagev1.StorageBucketHandle) {
+	t.Helper()
+
+	source := newStorageBucket(t, client, "source")
+	putObject(t, source.Object("src/a"), "same", map[string]string{storagev1.StorageObjectModTimeKey: "1"})
+	putObject(t, source.Object("src/b"), "new b", nil)
+	putObject(t, source.Object("src/c"), "new c", nil)
+
+	destination := newStorageBucket(t, client, "destination")
+	putObject(t, destination.Object("dst/a"), "same", map[string]string{storagev1.StorageObjectModTimeKey: "2"})
+	putObject(t, destination.Object("dst/b"), "old b", nil)
+	putObject(t, destination.Object("dst/d"), "unique", nil)
+
+	return source, destination
+}
+
+// planActions returns the actions of a plan as "action name" strings.
+func planActions(plan *storagev1.StorageSyncPlan) []string {
+	var actions []string
+	for _, entry := range plan.Entries {
+		actions = append(actions, entry.Action.String()+" "+entry.Name)
+	}
+
+	return actions
+}
+
+func TestStorageSyncJobPlan(t *testing.T) {
+	cases := []struct {
+		name string
+		job  storagev1.StorageSyncJob
+		want []string
+	}{
+		{
+			name: "Default",
+			want: []string{"skip a", "copy b", "copy c"},
+		},
+		{
+			name: "DeleteObjectsUniqueInSink",
+			job:  storagev1.StorageSyncJob{DeleteObjectsUniqueInSink: true},
+			want: []string{"skip a", "copy b", "copy c", "delete d"},
+		},
+		{
+			name: "CompareModTime",
+			job:  storagev1.StorageSyncJob{CompareModTime: true},
+			want: []string{"copy a", "copy b", "copy c"},
+		},
+		{
+			name: "Conditions",
+			job: storagev1.StorageSyncJob{
+				DeleteObjectsUniqueInSink: true,
+				Conditions:                &storagev1.StorageObjectConditions{ExcludePrefixes: []string{"src/b", "src/d"}},
+			},
+			want: []string{"skip a", "copy c"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			source, destination := newSyncBuckets(t, storagev1mem.NewStorageServiceClient())
+
+			job := tc.job
+			job.Source = source
+			job.SourcePrefix = "src/"
+			job.Destination = destination
+			job.DestinationPrefix = "dst/"
+
+			plan, err := job.Plan(context.Background())
+			if err != nil {
+				t.Fatalf("Plan: %v", err)
+			}
+
+			if got := planActions(plan); !slices.Equal(got, tc.want) {
+				t.Errorf("Plan() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestStorageSyncJobRun(t *testing.T) {
+	source, destination := newSyncBuckets(t, storagev1mem.NewStorageServiceClient())
+
+	var applied []string
+
+	job := &storagev1.StorageSyncJob{
+		Source:                    source,
+		SourcePrefix:              "src/",
+		Destination:               destination,
+		DestinationPrefix:         "dst/",
+		DeleteObjectsUniqueInSink: true,
+		Progress: func(entry *storagev1.StorageSyncEntry) {
+			applied = append(applied, entry.Action.String()+" "+entry.Name)
+		},
+	}
+
+	report, err := job.Run(context.Background())
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	want := storagev1.StorageSyncReport{Copied: 2, CopiedBytes: 10, Skipped: 1, SkippedBytes: 4, Deleted: 1, DeletedBytes: 6}
+	if *report != want {
+		t.Errorf("Run() = %+v, want %+v", *report, want)
+	}
+
+	if want := []string{"skip a", "copy b", "copy c", "delete d"}; !slices.Equal(applied, want) {
+		t.Errorf("applied = %v, want %v", applied, want)
+	}
+
+	if got, want := objectNames(t, destination), []string{"dst/a", "dst/b", "dst/c"}; !slices.Equal(got, want) {
+		t.Errorf("destination = %v, want %v", got, want)
+	}
+
+	if data, _ := getObject(t, destination.Object("dst/b")); data != "new b" {
+		t.Errorf("dst/b = %q, want %q", data, "new b")
+	}
+
+	// the destination is the same as the source
+	plan, err := job.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	if got, want := planActions(plan), []string{"skip a", "skip b", "skip c"}; !slices.Equal(got, want) {
+		t.Errorf("Plan() after Run = %v, want %v", got, want)
+	}
+}
+
+func TestStorageSyncJobApplyReplaced(t *testing.T) {
+	source, destination := newSyncBuckets(t, storagev1mem.NewStorageServiceClient())
+
+	job := &storagev1.StorageSyncJob{
+		Source:                    source,
+		SourcePrefix:              "src/",
+		Destination:               destination,
+		DestinationPrefix:         "dst/",
+		DeleteObjectsUniqueInSink: true,
+	}
+
+	plan, err := job.Plan(context.Background())
+	if err != nil {
+		t.Fatalf("Plan: %v", err)
+	}
+
+	// the unique object is replaced after the plan, so that it is kept
+	putObject(t, destination.Object("dst/d"), "replaced", nil)
+
+	report, err := job.Apply(context.Background(), plan)
+	if err == nil {
+		t.Fatal("Apply() = nil, want the error of d")
+	}
+
+	if report.Failed != 1 || report.Copied != 2 || report.Skipped != 1 {
+		t.Errorf("Apply() = %+v, want 1 failed, 2 copied and 1 skipped", *report)
+	}
+
+	if data, _ := getObject(t, destination.Object("dst/d")); data != "replaced" {
+		t.Errorf("dst/d = %q, want replaced", data)
+	}
+}
+
+func TestStorageSyncJobBackends(t *testing.T) {
+	cases := []struct {
+		name        string
+		source      func(t *testing.T) storagev1.StorageServiceClient
+		destination func(t *testing.T) storagev1.StorageServiceClient
+	}{
+		{
+			name: "SDKToMemory",
+			source: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1retry.NewStorageServiceClient(newEmulatedClient(t)))
+			},
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+			},
+		},
+		{
+			name: "MemoryToSDK",
+			source: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1retry.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+			},
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1retry.NewStorageServiceClient(newEmulatedClient(t)))
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newStorageBucket(t, tc.source(t), "source")
+			putObject(t, source.Object("a"), "hello", nil)
+			putObject(t, source.Object("b"), "world", nil)
+
+			destination := newStorageBucket(t, tc.destination(t), "destination")
+			putObject(t, destination.Object("b"), "other", nil)
+
+			job := &storagev1.StorageSyncJob{
+				Source:      source,
+				Destination: destination,
+			}
+
+			report, err := job.Run(context.Background())
+			if err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+
+			if report.Copied != 2 {
+				t.Errorf("Copied = %d, want 2", report.Copied)
+			}
+
+			for name, want := range map[string]string{"a": "hello", "b": "world"} {
+				if data, _ := getObject(t, destination.Object(name)); data != want {
+					t.Errorf("%s = %q, want %q", name, data, want)
+				}
+			}
+
+			// the checksums of the copies match across the backends
+			plan, err := job.Plan(context.Background())
+			if err != nil {
+				t.Fatalf("Plan: %v", err)
+			}
+
+			if got, want := planActions(plan), []string{"skip a", "skip b"}; !slices.Equal(got, want) {
+				t.Errorf("Plan() after Run = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestStorageSyncAction(t *testing.T) {
+	cases := []struct {
+		action storagev1.StorageSyncAction
+		want   string
+	}{
+		{storagev1.StorageSyncActionSkip, "skip"},
+		{storagev1.StorageSyncActionCopy, "copy"},
+		{storagev1.StorageSyncActionDelete, "delete"},
+		{storagev1.StorageSyncAction(9), "StorageSyncAction(9)"},
+	}
+
+	for _, tc := range cases {
+		if got := tc.action.String(); got != tc.want {
+			t.Errorf("String() = %q, want %q", got, tc.want)
+		}
+	}
+
+	entry := &storagev1.StorageSyncEntry{Destination: &storage.ObjectAttrs{Size: 3}}
+	if got := entry.Size(); got != 3 {
+		t.Errorf("Size() of a deletion = %d, want 3", got)
+	}
+}
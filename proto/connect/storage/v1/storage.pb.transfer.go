@@ -0,0 +1,310 @@
+package storagev1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+)
+
+// DefaultStorageTransferCheckpointInterval is the number of the objects
+// between two checkpoints of a transfer.
+const DefaultStorageTransferCheckpointInterval = 100
+
+// StorageTransferState is the progress of a transfer that a checkpoint keeps.
+type StorageTransferState struct {
+	// After is the name of the source object up to which every object has
+	// been copied.
+	After string `json:"after,omitempty"`
+	// Done are the names of the source objects after it that have been
+	// copied.
+	Done []string `json:"done,omitempty"`
+}
+
+//counterfeiter:generate -o storagev1fake . StorageTransferCheckpoint
+
+// StorageTransferCheckpoint keeps the progress of a transfer, so that it can
+// resume.
+type StorageTransferCheckpoint interface {
+	// Load returns the progress of the transfer, or nil when the transfer has
+	// not started.
+	Load(ctx context.Context) (*StorageTransferState, error)
+	// Save records the progress of the transfer.
+	Save(ctx context.Context, state *StorageTransferState) error
+}
+
+// StorageTransferJob copies every object under a prefix of a source bucket to
+// a destination bucket. The buckets may belong to any two
+// StorageServiceClients, such as Cloud Storage and a local directory. The
+// objects are copied with CopierFrom when both buckets belong to the same
+// backend under their decorators, and they are streamed from the source to
+// the destination otherwise.
+//
+// The checkpoint records the objects that have been copied, so that a job
+// that fails or is interrupted resumes without copying them again.
+//
+// Example:
+//
+//	job := &storagev1.StorageTransferJob{
+//		Source:            fsClient.Bucket("exports"),
+//		SourcePrefix:      "2024/",
+//		Destination:       gcsClient.Bucket("archive"),
+//		DestinationPrefix: "exports/2024/",
+//		Concurrency:       16,
+//		Checkpoint:        checkpoint,
+//	}
+//
+//	if err := job.Run(ctx); err != nil {
+//		return err
+//	}
+type StorageTransferJob struct {
+	// Source is the bucket the objects are copied from.
+	Source StorageBucketHandle
+	// SourcePrefix is the prefix of the objects that are copied.
+	SourcePrefix string
+	// Destination is the bucket the objects are copied to.
+	Destination StorageBucketHandle
+	// DestinationPrefix replaces the source prefix in the names of the
+	// copies.
+	DestinationPrefix string
+	// Conditions selects the source objects that are copied. The time
+	// conditions are evaluated at the start of the run.
+	Conditions *StorageObjectConditions
+	// Concurrency is the number of the objects that are copied at a time. The
+	// default is one.
+	Concurrency int
+	// Checkpoint keeps the progress of the transfer.
+	Checkpoint StorageTransferCheckpoint
+	// CheckpointInterval is the number of the objects between two
+	// checkpoints. The default is DefaultStorageTransferCheckpointInterval.
+	CheckpointInterval int
+	// Progress is called for every object, with its copy or its error.
+	Progress func(src, dst *storage.ObjectAttrs, err error)
+}
+
+// Run copies the objects until every object has been copied, a copy fails or
+// the context is done.
+func (x *StorageTransferJob) Run(ctx context.Context) error {
+	if x.Conditions != nil {
+		if err := x.Conditions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	state := &StorageTransferState{}
+	// resume after the checkpoint
+	if x.Checkpoint != nil {
+		loaded, err := x.Checkpoint.Load(ctx)
+		if err != nil {
+			return err
+		}
+
+		if loaded != nil {
+			state = loaded
+		}
+	}
+
+	copied := make(map[string]bool, len(state.Done))
+	for _, name := range state.Done {
+		copied[name] = true
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	now := time.Now()
+
+	query := &storage.Query{Prefix: x.SourcePrefix}
+	if x.Conditions != nil {
+		query = x.Conditions.Query(x.SourcePrefix)
+	}
+
+	// the start offset is inclusive
+	if state.After != "" {
+		query.StartOffset = max(query.StartOffset, state.After+"\x00")
+	}
+
+	tracker := &watermark{
+		name:     state.After,
+		interval: x.CheckpointInterval,
+		done:     make(map[int]string),
+	}
+
+	if tracker.interval <= 0 {
+		tracker.interval = DefaultStorageTransferCheckpointInterval
+	}
+
+	if x.Checkpoint != nil {
+		tracker.save = func(ctx context.Context, name string, done []string) error {
+			return x.Checkpoint.Save(ctx, &StorageTransferState{After: name, Done: done})
+		}
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, max(x.Concurrency, 1))
+	)
+
+	iter := x.Source.Objects(ctx, query)
+	// dispatch the objects
+	for index := 0; ; index++ {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			cancel(err)
+			break
+		}
+
+		// skip the objects copied before the checkpoint and the objects that
+		// are not selected
+		if copied[attrs.Name] || x.Conditions != nil && !x.Conditions.Match(attrs, now) {
+			if err := tracker.add(ctx, index, attrs.Name); err != nil {
+				cancel(err)
+				break
+			}
+
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(index int, attrs *storage.ObjectAttrs) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			result, err := x.copy(ctx, attrs)
+			if x.Progress != nil {
+				x.Progress(attrs, result, err)
+			}
+
+			if err != nil {
+				cancel(err)
+				return
+			}
+
+			if err := tracker.add(ctx, index, attrs.Name); err != nil {
+				cancel(err)
+			}
+		}(index, attrs)
+	}
+
+	wg.Wait()
+	// save the progress, also when the transfer fails
+	err := tracker.flush(context.WithoutCancel(ctx))
+	if cause := context.Cause(ctx); cause != nil {
+		return cause
+	}
+
+	// done!
+	return err
+}
+
+// copy copies an object to the destination.
+func (x *StorageTransferJob) copy(ctx context.Context, attrs *storage.ObjectAttrs) (*storage.ObjectAttrs, error) {
+	name := x.DestinationPrefix + strings.TrimPrefix(attrs.Name, x.SourcePrefix)
+
+	src := x.Source.Object(attrs.Name)
+	dst := x.Destination.Object(name)
+	// done!
+	return copyObject(ctx, src, dst, attrs)
+}
+
+// StorageObjectHandleWrapper is implemented by the handles that decorate the
+// handle of another StorageServiceClient, such as the retrying and the
+// instrumented handles.
+type StorageObjectHandleWrapper interface {
+	// Unwrap returns the decorated handle.
+	Unwrap() StorageObjectHandle
+}
+
+// UnwrapStorageObjectHandle returns the handle of the backend under the
+// decorators of a handle.
+func UnwrapStorageObjectHandle(handle StorageObjectHandle) StorageObjectHandle {
+	for {
+		wrapper, ok := handle.(StorageObjectHandleWrapper)
+		if !ok {
+			return handle
+		}
+
+		handle = wrapper.Unwrap()
+	}
+}
+
+// copyObject copies the generation of the source object in the attributes to
+// the destination, with CopierFrom when both objects belong to the same
+// backend and by streaming otherwise.
+func copyObject(ctx context.Context, src, dst StorageObjectHandle, attrs *storage.ObjectAttrs) (*storage.ObjectAttrs, error) {
+	src = src.Generation(attrs.Generation)
+	// copy within the backend, whose copier recognizes the source under the
+	// decorators of the destination only
+	if backend := UnwrapStorageObjectHandle(src); reflect.TypeOf(backend) == reflect.TypeOf(UnwrapStorageObjectHandle(dst)) {
+		return dst.CopierFrom(backend).Run(ctx)
+	}
+
+	// done!
+	return streamObject(ctx, src.ReadCompressed(true), dst, attrs)
+}
+
+// streamObject copies an object between two backends by reading it from the
+// source and writing it to the destination. The copy keeps the metadata and
+// the checksum of the source.
+func streamObject(ctx context.Context, src, dst StorageObjectHandle, attrs *storage.ObjectAttrs) (*storage.ObjectAttrs, error) {
+	reader, err := src.NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	writer := dst.NewWriter(ctx)
+	// prepare the attributes
+	copyObjectAttrs(writer.ObjectAttrs(), attrs)
+
+	if attrs.CRC32C != 0 {
+		writer.SetCRC32C(attrs.CRC32C)
+	}
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		writer.CloseWithError(err)
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// done!
+	return writer.Attrs(), nil
+}
+
+// copyObjectAttrs copies the content and the custom metadata of an object to
+// the attributes of a new object.
+func copyObjectAttrs(dst, src *storage.ObjectAttrs) {
+	dst.ContentType = src.ContentType
+	dst.ContentLanguage = src.ContentLanguage
+	dst.ContentEncoding = src.ContentEncoding
+	dst.ContentDisposition = src.ContentDisposition
+	dst.CacheControl = src.CacheControl
+	dst.Metadata = src.Metadata
+	dst.CustomTime = src.CustomTime
+}
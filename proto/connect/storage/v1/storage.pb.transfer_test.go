@@ -0,0 +1,369 @@
+package storagev1_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+	option "google.golang.org/api/option"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1chaos"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1emu"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1otel"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1retry"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+// newEmulatedClient returns a Cloud Storage client of an emulator on top of a
+// memory backend.
+func newEmulatedClient(t *testing.T) storagev1.StorageServiceClient {
+	t.Helper()
+
+	server := httptest.NewServer(storagev1emu.NewStorageEmulator(storagev1mem.NewStorageServiceClient()))
+	t.Cleanup(server.Close)
+
+	client, err := storagev1sdk.NewStorageServiceClient(context.Background(),
+		option.WithEndpoint(server.URL+"/storage/v1/"),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// newStorageBucket creates a bucket of a client.
+func newStorageBucket(t *testing.T, client storagev1.StorageServiceClient, name string) storagev1.StorageBucketHandle {
+	t.Helper()
+
+	bucket := client.Bucket(name)
+	if err := bucket.Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	return bucket
+}
+
+// putObject writes a text object with the given metadata.
+func putObject(t *testing.T, handle storagev1.StorageObjectHandle, data string, metadata map[string]string) {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	writer.ObjectAttrs().ContentType = "text/plain"
+	writer.ObjectAttrs().Metadata = metadata
+
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+// getObject returns the content and the attributes of an object.
+func getObject(t *testing.T, handle storagev1.StorageObjectHandle) (string, *storage.ObjectAttrs) {
+	t.Helper()
+
+	reader, err := handle.NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	attrs, err := handle.Attrs(context.Background())
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	return string(data), attrs
+}
+
+// objectNames returns the names of the objects of a bucket.
+func objectNames(t *testing.T, bucket storagev1.StorageBucketHandle) []string {
+	t.Helper()
+
+	var names []string
+
+	iter := bucket.Objects(context.Background(), nil)
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return names
+		}
+
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		names = append(names, attrs.Name)
+	}
+}
+
+func TestStorageTransferJob(t *testing.T) {
+	client := storagev1mem.NewStorageServiceClient()
+
+	source := newStorageBucket(t, client, "source")
+	for _, name := range []string{"logs/a.txt", "logs/b.gz", "logs/old/c.txt", "other.txt"} {
+		putObject(t, source.Object(name), "data of "+name, map[string]string{"name": name})
+	}
+
+	destination := newStorageBucket(t, client, "destination")
+
+	var (
+		mu     sync.Mutex
+		copied []string
+	)
+
+	job := &storagev1.StorageTransferJob{
+		Source:            source,
+		SourcePrefix:      "logs/",
+		Destination:       destination,
+		DestinationPrefix: "archive/",
+		Conditions: &storagev1.StorageObjectConditions{
+			ExcludePrefixes: []string{"logs/old/"},
+			Glob:            "logs/*.txt",
+		},
+		Concurrency: 4,
+		Progress: func(src, dst *storage.ObjectAttrs, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				t.Errorf("Progress(%s) = %v", src.Name, err)
+				return
+			}
+
+			copied = append(copied, src.Name+">"+dst.Name)
+		},
+	}
+
+	if err := job.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"logs/a.txt>archive/a.txt"}; !slices.Equal(copied, want) {
+		t.Errorf("copied = %v, want %v", copied, want)
+	}
+
+	data, attrs := getObject(t, destination.Object("archive/a.txt"))
+	if data != "data of logs/a.txt" || attrs.ContentType != "text/plain" || attrs.Metadata["name"] != "logs/a.txt" {
+		t.Errorf("copy = %q, %+v", data, attrs)
+	}
+}
+
+func TestStorageTransferJobBackends(t *testing.T) {
+	metadata := map[string]string{"owner": "team"}
+
+	cases := []struct {
+		name        string
+		source      func(t *testing.T) storagev1.StorageServiceClient
+		destination func(t *testing.T) storagev1.StorageServiceClient
+	}{
+		{
+			name: "SDKToMemory",
+			source: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1retry.NewStorageServiceClient(newEmulatedClient(t)))
+			},
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1retry.NewStorageServiceClient(storagev1mem.NewStorageServiceClient()))
+			},
+		},
+		{
+			name: "MemoryToSDK",
+			source: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1retry.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+			},
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(newEmulatedClient(t))
+			},
+		},
+		{
+			name: "MemoryToMemory",
+			source: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1retry.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+			},
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1chaos.NewStorageServiceClient(storagev1mem.NewStorageServiceClient()))
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := newStorageBucket(t, tc.source(t), "source")
+			putObject(t, source.Object("a"), "hello", metadata)
+			putObject(t, source.Object("b"), "world", metadata)
+
+			destination := newStorageBucket(t, tc.destination(t), "destination")
+
+			job := &storagev1.StorageTransferJob{
+				Source:      source,
+				Destination: destination,
+			}
+
+			if err := job.Run(context.Background()); err != nil {
+				t.Fatalf("Run: %v", err)
+			}
+
+			for name, want := range map[string]string{"a": "hello", "b": "world"} {
+				data, attrs := getObject(t, destination.Object(name))
+				if data != want {
+					t.Errorf("copy of %s = %q, want %q", name, data, want)
+				}
+
+				if attrs.ContentType != "text/plain" || !maps.Equal(attrs.Metadata, metadata) {
+					t.Errorf("copy of %s: ContentType = %q, Metadata = %v", name, attrs.ContentType, attrs.Metadata)
+				}
+			}
+		})
+	}
+}
+
+func TestStorageTransferJobCopier(t *testing.T) {
+	backend := storagev1mem.NewStorageServiceClient()
+
+	// every read of the source fails, so that only a copy within the backend
+	// succeeds
+	source := storagev1chaos.NewStorageServiceClient(backend)
+	source.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectOpen},
+		Schedule: storagev1chaos.Always(),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(503)},
+	})
+
+	putObject(t, newStorageBucket(t, backend, "source").Object("a"), "hello", nil)
+
+	cases := []struct {
+		name        string
+		destination func(t *testing.T) storagev1.StorageServiceClient
+		wantErr     bool
+	}{
+		{
+			name: "SameBackend",
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(storagev1retry.NewStorageServiceClient(backend))
+			},
+		},
+		{
+			name: "OtherBackend",
+			destination: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1otel.NewStorageServiceClient(newEmulatedClient(t))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			destination := newStorageBucket(t, tc.destination(t), "destination-"+strings.ToLower(tc.name))
+
+			job := &storagev1.StorageTransferJob{
+				Source:      source.Bucket("source"),
+				Destination: destination,
+			}
+
+			err := job.Run(context.Background())
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("Run() = %v, want error %v", err, tc.wantErr)
+			}
+
+			if !tc.wantErr {
+				if data, _ := getObject(t, destination.Object("a")); data != "hello" {
+					t.Errorf("copy = %q, want hello", data)
+				}
+			}
+		})
+	}
+}
+
+func TestStorageTransferJobResume(t *testing.T) {
+	source := newStorageBucket(t, storagev1mem.NewStorageServiceClient(), "source")
+	for _, name := range []string{"a", "b", "c", "d"} {
+		putObject(t, source.Object(name), name, nil)
+	}
+
+	// the first copy of c fails
+	destination := storagev1chaos.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+	destination.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectCopy},
+		Prefix:   "c",
+		Schedule: storagev1chaos.First(1),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(503)},
+	})
+
+	checkpoint := storagev1mem.NewStorageTransferCheckpoint()
+
+	var copied []string
+
+	job := &storagev1.StorageTransferJob{
+		Source:             source,
+		Destination:        newStorageBucket(t, destination, "destination"),
+		Checkpoint:         checkpoint,
+		CheckpointInterval: 1,
+		Progress: func(src, dst *storage.ObjectAttrs, err error) {
+			if err == nil {
+				copied = append(copied, src.Name)
+			}
+		},
+	}
+
+	if err := job.Run(context.Background()); err == nil {
+		t.Fatal("Run() = nil, want the error of c")
+	}
+
+	state, err := checkpoint.Load(context.Background())
+	if err != nil || state == nil || state.After != "b" {
+		t.Fatalf("Load() = %+v, %v, want after b", state, err)
+	}
+
+	// the next run copies the objects after the checkpoint only
+	copied = nil
+
+	if err := job.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if want := []string{"c", "d"}; !slices.Equal(copied, want) {
+		t.Errorf("copied = %v, want %v", copied, want)
+	}
+
+	if got, want := objectNames(t, job.Destination), []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("destination = %v, want %v", got, want)
+	}
+}
+
+func TestUnwrapStorageObjectHandle(t *testing.T) {
+	backend := storagev1mem.NewStorageServiceClient()
+	handle := backend.Bucket("bucket").Object("a")
+
+	clients := []storagev1.StorageServiceClient{
+		backend,
+		storagev1retry.NewStorageServiceClient(backend),
+		storagev1otel.NewStorageServiceClient(storagev1chaos.NewStorageServiceClient(backend)),
+	}
+
+	for _, client := range clients {
+		got := storagev1.UnwrapStorageObjectHandle(client.Bucket("bucket").Object("a"))
+		if _, ok := got.(*storagev1mem.StorageObjectHandle); !ok {
+			t.Errorf("UnwrapStorageObjectHandle(%T) = %T, want %T", client, got, handle)
+		}
+	}
+}
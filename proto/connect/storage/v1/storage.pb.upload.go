@@ -0,0 +1,347 @@
+package storagev1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+
+	storage "cloud.google.com/go/storage"
+)
+
+const (
+	// StorageComposeMaxComponents is the maximum number of the source objects
+	// of a compose request.
+	StorageComposeMaxComponents = 32
+	// DefaultStorageCompositeUploadParts is the number of the parts of a
+	// parallel composite upload.
+	DefaultStorageCompositeUploadParts = 32
+)
+
+// StorageCompositeUploader uploads an object as a parallel composite upload:
+// it splits the data into parts, uploads the parts concurrently as temporary
+// objects and composes them into the object. The parts are composed in
+// several steps when there are more than StorageComposeMaxComponents of them.
+//
+// The CRC32C checksum of every part and of the object is checked against the
+// checksum of the data. The temporary objects are deleted once the upload is
+// done, also when it fails.
+//
+// A composite object has no MD5 hash, and its parts are billed as objects in
+// their storage class until they are deleted.
+//
+// Example:
+//
+//	uploader := &storagev1.StorageCompositeUploader{
+//		Bucket:      client.Bucket("my-bucket"),
+//		Parts:       16,
+//		Concurrency: 8,
+//	}
+//
+//	attrs, err := uploader.UploadFile(ctx, &storage.ObjectAttrs{Name: "backup.tar"}, "/var/backup.tar")
+type StorageCompositeUploader struct {
+	// Bucket is the bucket of the object.
+	Bucket StorageBucketHandle
+	// Parts is the number of the parts. The default is
+	// DefaultStorageCompositeUploadParts.
+	Parts int
+	// Concurrency is the number of the parts that are uploaded at a time. The
+	// default is the number of the parts.
+	Concurrency int
+	// ChunkSize is the chunk size of the writers of the parts. Zero keeps the
+	// default of the backend.
+	ChunkSize int
+	// TempPrefix is the prefix of the names of the temporary objects. The
+	// default is the name of the object.
+	TempPrefix string
+}
+
+// UploadFile uploads a local file as the object with the given attributes.
+func (x *StorageCompositeUploader) UploadFile(ctx context.Context, attrs *storage.ObjectAttrs, name string) (*storage.ObjectAttrs, error) {
+	file, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	// done!
+	return x.Upload(ctx, attrs, file, info.Size())
+}
+
+// Upload uploads the size bytes of the reader as the object with the given
+// attributes. The name of the attributes is the name of the object, and their
+// content and custom metadata are the ones of the object. The error of the
+// cleanup of the temporary objects is returned with the object.
+func (x *StorageCompositeUploader) Upload(ctx context.Context, attrs *storage.ObjectAttrs, r io.ReaderAt, size int64) (_ *storage.ObjectAttrs, err error) {
+	switch {
+	case attrs == nil:
+		return nil, errors.New("storage composite upload: object attributes are required")
+	case attrs.Name == "":
+		return nil, errors.New("storage composite upload: object name is required")
+	}
+
+	id, err := newUploadID()
+	if err != nil {
+		return nil, err
+	}
+
+	upload := &compositeUpload{
+		uploader: x,
+		prefix:   fmt.Sprintf("%s.%s.part-", x.tempPrefix(attrs), id),
+	}
+	// delete the temporary objects
+	defer func() {
+		err = errors.Join(err, upload.cleanup(context.WithoutCancel(ctx)))
+	}()
+
+	count := int64(x.Parts)
+	if count <= 0 {
+		count = DefaultStorageCompositeUploadParts
+	}
+
+	// every part has at least one byte
+	count = max(min(count, size), 1)
+	length := max((size+count-1)/count, 1)
+	count = max((size+length-1)/length, 1)
+
+	var (
+		crcs = make([]uint32, count)
+		srcs = make([]StorageObjectHandle, count)
+		jobs = make([]func(context.Context) error, count)
+	)
+
+	for index := range count {
+		offset := index * length
+		section := io.NewSectionReader(r, offset, min(length, size-offset))
+
+		jobs[index] = func(ctx context.Context) error {
+			handle, crc, err := upload.part(ctx, section)
+			srcs[index], crcs[index] = handle, crc
+			return err
+		}
+	}
+
+	// upload the parts
+	if err := x.run(ctx, jobs); err != nil {
+		return nil, err
+	}
+
+	var crc uint32
+	// the checksum of the object
+	for index, value := range crcs {
+		offset := int64(index) * length
+		crc = crc32cCombine(crc, value, min(length, size-offset))
+	}
+
+	handle := x.Bucket.Object(attrs.Name)
+	// compose the parts
+	result, err := upload.compose(ctx, handle, attrs, srcs)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.CRC32C != crc {
+		// do not leave an object whose data is not the uploaded data
+		handle = handle.If(storage.Conditions{GenerationMatch: result.Generation})
+		// done!
+		return nil, errors.Join(&StorageChecksumError{Name: attrs.Name, Want: crc, Got: result.CRC32C}, handle.Delete(context.WithoutCancel(ctx)))
+	}
+
+	// done!
+	return result, nil
+}
+
+// tempPrefix returns the prefix of the temporary objects of an object.
+func (x *StorageCompositeUploader) tempPrefix(attrs *storage.ObjectAttrs) string {
+	if x.TempPrefix != "" {
+		return x.TempPrefix
+	}
+
+	return attrs.Name
+}
+
+// run runs the jobs concurrently, and returns the first error.
+func (x *StorageCompositeUploader) run(ctx context.Context, jobs []func(context.Context) error) error {
+	return runConcurrently(ctx, x.Concurrency, jobs)
+}
+
+// runConcurrently runs at most concurrency jobs at a time, or every job at
+// once when concurrency is not positive. It cancels the other jobs when a job
+// fails, and returns its error.
+func runConcurrently(ctx context.Context, concurrency int, jobs []func(context.Context) error) error {
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	if concurrency <= 0 {
+		concurrency = len(jobs)
+	}
+
+	var (
+		wg  sync.WaitGroup
+		sem = make(chan struct{}, max(concurrency, 1))
+	)
+
+	for _, job := range jobs {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := job(ctx); err != nil {
+				cancel(err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	// done!
+	return context.Cause(ctx)
+}
+
+// compositeUpload is the state of an upload: the temporary objects that it
+// has created.
+type compositeUpload struct {
+	uploader *StorageCompositeUploader
+	prefix   string
+	mu       sync.Mutex
+	temps    []StorageObjectHandle
+}
+
+// temp returns a new temporary object, which is deleted by cleanup.
+func (x *compositeUpload) temp() StorageObjectHandle {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	handle := x.uploader.Bucket.Object(fmt.Sprintf("%s%d", x.prefix, len(x.temps)))
+	x.temps = append(x.temps, handle)
+	// done!
+	return handle
+}
+
+// part uploads a part as a temporary object, and returns the object and the
+// checksum of its data.
+func (x *compositeUpload) part(ctx context.Context, r io.Reader) (StorageObjectHandle, uint32, error) {
+	handle := x.temp()
+
+	writer := handle.NewWriter(ctx)
+	if x.uploader.ChunkSize > 0 {
+		writer.SetChunkSize(x.uploader.ChunkSize)
+	}
+
+	crc := crc32.New(crc32cTable)
+	// upload the data
+	if _, err := io.Copy(writer, io.TeeReader(r, crc)); err != nil {
+		writer.CloseWithError(err)
+		return nil, 0, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, 0, err
+	}
+
+	result := writer.Attrs()
+	if result.CRC32C != crc.Sum32() {
+		return nil, 0, &StorageChecksumError{Name: result.Name, Want: crc.Sum32(), Got: result.CRC32C}
+	}
+
+	// the part stays the same while it is composed
+	handle = handle.If(storage.Conditions{GenerationMatch: result.Generation})
+	// done!
+	return handle, result.CRC32C, nil
+}
+
+// compose composes the sources into the object, through temporary objects of
+// at most StorageComposeMaxComponents sources each when there are more.
+func (x *compositeUpload) compose(ctx context.Context, handle StorageObjectHandle, attrs *storage.ObjectAttrs, srcs []StorageObjectHandle) (*storage.ObjectAttrs, error) {
+	for len(srcs) > StorageComposeMaxComponents {
+		var (
+			next = make([]StorageObjectHandle, (len(srcs)+StorageComposeMaxComponents-1)/StorageComposeMaxComponents)
+			jobs = make([]func(context.Context) error, len(next))
+		)
+
+		for index := range next {
+			group := srcs[index*StorageComposeMaxComponents : min((index+1)*StorageComposeMaxComponents, len(srcs))]
+
+			jobs[index] = func(ctx context.Context) error {
+				handle := x.temp()
+
+				result, err := handle.ComposerFrom(group...).Run(ctx)
+				if err != nil {
+					return err
+				}
+
+				next[index] = handle.If(storage.Conditions{GenerationMatch: result.Generation})
+				return nil
+			}
+		}
+
+		// compose the groups
+		if err := x.uploader.run(ctx, jobs); err != nil {
+			return nil, err
+		}
+
+		srcs = next
+	}
+
+	composer := handle.ComposerFrom(srcs...)
+	copyObjectAttrs(composer.ObjectAttrs(), attrs)
+	// done!
+	return composer.Run(ctx)
+}
+
+// cleanup deletes the temporary objects.
+func (x *compositeUpload) cleanup(ctx context.Context) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	jobs := make([]func(context.Context) error, len(x.temps))
+	errs := make([]error, len(x.temps))
+
+	for index, handle := range x.temps {
+		jobs[index] = func(ctx context.Context) error {
+			if err := handle.Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+				errs[index] = err
+			}
+
+			// delete the other objects also when it fails
+			return nil
+		}
+	}
+
+	if err := x.uploader.run(ctx, jobs); err != nil {
+		return err
+	}
+
+	// done!
+	return errors.Join(errs...)
+}
+
+// newUploadID returns a random ID of an upload.
+func newUploadID() (string, error) {
+	data := make([]byte, 8)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(data), nil
+}
@@ -0,0 +1,218 @@
+package storagev1_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1chaos"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+// newData returns size bytes of data that differ from one offset to another.
+func newData(size int) []byte {
+	data := make([]byte, size)
+	for index := range data {
+		data[index] = byte(index*7 + index/251)
+	}
+
+	return data
+}
+
+// checkObject checks the data and the checksum of an object.
+func checkObject(t *testing.T, handle storagev1.StorageObjectHandle, attrs *storage.ObjectAttrs, want []byte) {
+	t.Helper()
+
+	reader, err := handle.NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if !bytes.Equal(data, want) {
+		t.Errorf("object has %d bytes, want %d bytes", len(data), len(want))
+	}
+
+	if crc := crc32.Checksum(want, crc32.MakeTable(crc32.Castagnoli)); attrs.CRC32C != crc {
+		t.Errorf("CRC32C = %d, want %d", attrs.CRC32C, crc)
+	}
+}
+
+func TestStorageCompositeUploader(t *testing.T) {
+	for _, size := range []int{0, 1, 7, 1000, 100003} {
+		for _, parts := range []int{0, 1, 3, 33, 100} {
+			t.Run(fmt.Sprintf("Size%dParts%d", size, parts), func(t *testing.T) {
+				bucket := newStorageBucket(t, storagev1mem.NewStorageServiceClient(), "bucket")
+				data := newData(size)
+
+				uploader := &storagev1.StorageCompositeUploader{
+					Bucket:      bucket,
+					Parts:       parts,
+					Concurrency: 4,
+				}
+
+				attrs, err := uploader.Upload(context.Background(), &storage.ObjectAttrs{Name: "object"}, bytes.NewReader(data), int64(size))
+				if err != nil {
+					t.Fatalf("Upload: %v", err)
+				}
+
+				checkObject(t, bucket.Object("object"), attrs, data)
+
+				// the temporary objects are deleted
+				if got, want := objectNames(t, bucket), []string{"object"}; !slices.Equal(got, want) {
+					t.Errorf("objects = %v, want %v", got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestStorageCompositeUploaderBackends(t *testing.T) {
+	cases := []struct {
+		name   string
+		client func(t *testing.T) storagev1.StorageServiceClient
+	}{
+		{
+			name: "Memory",
+			client: func(t *testing.T) storagev1.StorageServiceClient {
+				return storagev1mem.NewStorageServiceClient()
+			},
+		},
+		{
+			name:   "SDK",
+			client: newEmulatedClient,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bucket := newStorageBucket(t, tc.client(t), "bucket")
+			data := newData(1000)
+
+			uploader := &storagev1.StorageCompositeUploader{
+				Bucket:     bucket,
+				Parts:      40,
+				TempPrefix: "tmp/upload",
+			}
+
+			attrs := &storage.ObjectAttrs{
+				Name:        "object",
+				ContentType: "application/octet-stream",
+				Metadata:    map[string]string{"owner": "team"},
+			}
+
+			result, err := uploader.Upload(context.Background(), attrs, bytes.NewReader(data), int64(len(data)))
+			if err != nil {
+				t.Fatalf("Upload: %v", err)
+			}
+
+			checkObject(t, bucket.Object("object"), result, data)
+
+			// the composition has the attributes of the object
+			if result.ContentType != attrs.ContentType || result.Metadata["owner"] != "team" {
+				t.Errorf("Upload() = ContentType %q, Metadata %v, want the attributes of the object", result.ContentType, result.Metadata)
+			}
+
+			if got, want := objectNames(t, bucket), []string{"object"}; !slices.Equal(got, want) {
+				t.Errorf("objects = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestStorageCompositeUploaderFailure(t *testing.T) {
+	backend := storagev1mem.NewStorageServiceClient()
+	newStorageBucket(t, backend, "bucket")
+
+	// the write of the second part fails
+	client := storagev1chaos.NewStorageServiceClient(backend)
+	client.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectClose},
+		Prefix:   "tmp/",
+		Schedule: storagev1chaos.Calls(2),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(503)},
+	})
+
+	uploader := &storagev1.StorageCompositeUploader{
+		Bucket:      client.Bucket("bucket"),
+		Parts:       4,
+		Concurrency: 1,
+		TempPrefix:  "tmp/upload",
+	}
+
+	data := newData(1000)
+	if _, err := uploader.Upload(context.Background(), &storage.ObjectAttrs{Name: "object"}, bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Fatal("Upload() = nil, want the error of the second part")
+	}
+
+	// neither the object nor the temporary objects are left
+	if got := objectNames(t, backend.Bucket("bucket")); len(got) != 0 {
+		t.Errorf("objects = %v, want none", got)
+	}
+}
+
+func TestStorageCompositeUploaderInvalidAttrs(t *testing.T) {
+	backend := storagev1mem.NewStorageServiceClient()
+	newStorageBucket(t, backend, "bucket")
+
+	uploader := &storagev1.StorageCompositeUploader{
+		Bucket: backend.Bucket("bucket"),
+	}
+
+	for _, tc := range []struct {
+		name  string
+		attrs *storage.ObjectAttrs
+	}{
+		{name: "Nil"},
+		{name: "Name", attrs: &storage.ObjectAttrs{ContentType: "text/plain"}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data := newData(100)
+			if _, err := uploader.Upload(context.Background(), tc.attrs, bytes.NewReader(data), int64(len(data))); err == nil {
+				t.Fatal("Upload() = nil, want an error")
+			}
+
+			// nothing is uploaded
+			if got := objectNames(t, backend.Bucket("bucket")); len(got) != 0 {
+				t.Errorf("objects = %v, want none", got)
+			}
+		})
+	}
+}
+
+func TestStorageCompositeUploaderUploadFile(t *testing.T) {
+	bucket := newStorageBucket(t, storagev1mem.NewStorageServiceClient(), "bucket")
+	data := newData(100003)
+
+	name := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(name, data, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	uploader := &storagev1.StorageCompositeUploader{Bucket: bucket, Parts: 5}
+
+	attrs, err := uploader.UploadFile(context.Background(), &storage.ObjectAttrs{Name: "object"}, name)
+	if err != nil {
+		t.Fatalf("UploadFile: %v", err)
+	}
+
+	checkObject(t, bucket.Object("object"), attrs, data)
+
+	if _, err := uploader.UploadFile(context.Background(), &storage.ObjectAttrs{Name: "missing"}, filepath.Join(t.TempDir(), "missing")); !os.IsNotExist(err) {
+		t.Errorf("UploadFile(missing) = %v, want a missing file", err)
+	}
+}
@@ -35,76 +35,90 @@ var (
 	_ = sort.Sort
 )
 
-// Validate checks the field values on StorageObject with the rules defined in
+// Validate checks the field values on StorageBucket with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *StorageObject) Validate() error {
+func (m *StorageBucket) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObject with the rules defined
+// ValidateAll checks the field values on StorageBucket with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StorageObjectMultiError, or
+// result is a list of violation errors wrapped in StorageBucketMultiError, or
 // nil if none found.
-func (m *StorageObject) ValidateAll() error {
+func (m *StorageBucket) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObject) validate(all bool) error {
+func (m *StorageBucket) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ContentEncoding
+	// no validation rules for Name
 
-	// no validation rules for ContentDisposition
+	// no validation rules for ProjectNumber
 
-	// no validation rules for CacheControl
+	// no validation rules for Location
 
-	// no validation rules for ContentLanguage
+	// no validation rules for LocationType
 
-	// no validation rules for Metageneration
+	// no validation rules for StorageClass
+
+	// no validation rules for Labels
+
+	// no validation rules for VersioningEnabled
+
+	// no validation rules for RequesterPays
+
+	// no validation rules for DefaultEventBasedHold
+
+	// no validation rules for DefaultKmsKeyName
 
 	if all {
-		switch v := interface{}(m.GetTimeDeleted()).(type) {
+		switch v := interface{}(m.GetRetentionPolicy()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "TimeDeleted",
+				errors = append(errors, StorageBucketValidationError{
+					field:  "RetentionPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "TimeDeleted",
+				errors = append(errors, StorageBucketValidationError{
+					field:  "RetentionPolicy",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTimeDeleted()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRetentionPolicy()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
-				field:  "TimeDeleted",
+			return StorageBucketValidationError{
+				field:  "RetentionPolicy",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for ContentType
+	// no validation rules for UniformBucketLevelAccess
 
-	// no validation rules for Size
+	// no validation rules for PublicAccessPrevention
+
+	// no validation rules for Metageneration
+
+	// no validation rules for Etag
 
 	if all {
 		switch v := interface{}(m.GetTimeCreated()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
+				errors = append(errors, StorageBucketValidationError{
 					field:  "TimeCreated",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -112,7 +126,7 @@ func (m *StorageObject) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
+				errors = append(errors, StorageBucketValidationError{
 					field:  "TimeCreated",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -121,7 +135,7 @@ func (m *StorageObject) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetTimeCreated()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
+			return StorageBucketValidationError{
 				field:  "TimeCreated",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -129,19 +143,11 @@ func (m *StorageObject) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for Crc32C
-
-	// no validation rules for ComponentCount
-
-	// no validation rules for Md5Hash
-
-	// no validation rules for Etag
-
 	if all {
 		switch v := interface{}(m.GetUpdated()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
+				errors = append(errors, StorageBucketValidationError{
 					field:  "Updated",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -149,7 +155,7 @@ func (m *StorageObject) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
+				errors = append(errors, StorageBucketValidationError{
 					field:  "Updated",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -158,7 +164,7 @@ func (m *StorageObject) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdated()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
+			return StorageBucketValidationError{
 				field:  "Updated",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -166,131 +172,20 @@ func (m *StorageObject) validate(all bool) error {
 		}
 	}
 
-	// no validation rules for StorageClass
-
-	// no validation rules for KmsKeyName
-
-	if all {
-		switch v := interface{}(m.GetTimeStorageClassUpdated()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "TimeStorageClassUpdated",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "TimeStorageClassUpdated",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimeStorageClassUpdated()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
-				field:  "TimeStorageClassUpdated",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for TemporaryHold
-
-	if all {
-		switch v := interface{}(m.GetRetentionExpirationTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "RetentionExpirationTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "RetentionExpirationTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetRetentionExpirationTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
-				field:  "RetentionExpirationTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for Metadata
-
-	// no validation rules for EventBasedHold
-
-	// no validation rules for Name
-
-	// no validation rules for Id
-
-	// no validation rules for Bucket
-
-	// no validation rules for Generation
-
-	if all {
-		switch v := interface{}(m.GetCustomerEncryption()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "CustomerEncryption",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectValidationError{
-					field:  "CustomerEncryption",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCustomerEncryption()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectValidationError{
-				field:  "CustomerEncryption",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for MediaLink
-
-	// no validation rules for SelfLink
-
-	// no validation rules for Kind
-
 	if len(errors) > 0 {
-		return StorageObjectMultiError(errors)
+		return StorageBucketMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObjectMultiError is an error wrapping multiple validation errors
-// returned by StorageObject.ValidateAll() if the designated constraints
+// StorageBucketMultiError is an error wrapping multiple validation errors
+// returned by StorageBucket.ValidateAll() if the designated constraints
 // aren't met.
-type StorageObjectMultiError []error
+type StorageBucketMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObjectMultiError) Error() string {
+func (m StorageBucketMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -299,11 +194,11 @@ func (m StorageObjectMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObjectMultiError) AllErrors() []error { return m }
+func (m StorageBucketMultiError) AllErrors() []error { return m }
 
-// StorageObjectValidationError is the validation error returned by
-// StorageObject.Validate if the designated constraints aren't met.
-type StorageObjectValidationError struct {
+// StorageBucketValidationError is the validation error returned by
+// StorageBucket.Validate if the designated constraints aren't met.
+type StorageBucketValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -311,22 +206,22 @@ type StorageObjectValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObjectValidationError) Field() string { return e.field }
+func (e StorageBucketValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObjectValidationError) Reason() string { return e.reason }
+func (e StorageBucketValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObjectValidationError) Cause() error { return e.cause }
+func (e StorageBucketValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObjectValidationError) Key() bool { return e.key }
+func (e StorageBucketValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObjectValidationError) ErrorName() string { return "StorageObjectValidationError" }
+func (e StorageBucketValidationError) ErrorName() string { return "StorageBucketValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StorageObjectValidationError) Error() string {
+func (e StorageBucketValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -338,14 +233,14 @@ func (e StorageObjectValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObject.%s: %s%s",
+		"invalid %sStorageBucket.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObjectValidationError{}
+var _ error = StorageBucketValidationError{}
 
 var _ interface {
 	Field() string
@@ -353,34 +248,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObjectValidationError{}
+} = StorageBucketValidationError{}
 
-// Validate checks the field values on StorageObjectFinalizedEvent with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StorageObjectFinalizedEvent) Validate() error {
+// Validate checks the field values on GetBucketRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBucketRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObjectFinalizedEvent with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StorageObjectFinalizedEventMultiError, or nil if none found.
-func (m *StorageObjectFinalizedEvent) ValidateAll() error {
+// GetBucketRequestMultiError, or nil if none found.
+func (m *GetBucketRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObjectFinalizedEvent) validate(all bool) error {
+func (m *GetBucketRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetObject() == nil {
-		err := StorageObjectFinalizedEventValidationError{
-			field:  "Object",
-			reason: "value is required",
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := GetBucketRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -388,49 +283,32 @@ func (m *StorageObjectFinalizedEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetObject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectFinalizedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectFinalizedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectFinalizedEventValidationError{
-				field:  "Object",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for UserProject
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
 	}
 
 	if len(errors) > 0 {
-		return StorageObjectFinalizedEventMultiError(errors)
+		return GetBucketRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObjectFinalizedEventMultiError is an error wrapping multiple
-// validation errors returned by StorageObjectFinalizedEvent.ValidateAll() if
-// the designated constraints aren't met.
-type StorageObjectFinalizedEventMultiError []error
+// GetBucketRequestMultiError is an error wrapping multiple validation errors
+// returned by GetBucketRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetBucketRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObjectFinalizedEventMultiError) Error() string {
+func (m GetBucketRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -439,12 +317,11 @@ func (m StorageObjectFinalizedEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObjectFinalizedEventMultiError) AllErrors() []error { return m }
+func (m GetBucketRequestMultiError) AllErrors() []error { return m }
 
-// StorageObjectFinalizedEventValidationError is the validation error returned
-// by StorageObjectFinalizedEvent.Validate if the designated constraints
-// aren't met.
-type StorageObjectFinalizedEventValidationError struct {
+// GetBucketRequestValidationError is the validation error returned by
+// GetBucketRequest.Validate if the designated constraints aren't met.
+type GetBucketRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -452,24 +329,22 @@ type StorageObjectFinalizedEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObjectFinalizedEventValidationError) Field() string { return e.field }
+func (e GetBucketRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObjectFinalizedEventValidationError) Reason() string { return e.reason }
+func (e GetBucketRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObjectFinalizedEventValidationError) Cause() error { return e.cause }
+func (e GetBucketRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObjectFinalizedEventValidationError) Key() bool { return e.key }
+func (e GetBucketRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObjectFinalizedEventValidationError) ErrorName() string {
-	return "StorageObjectFinalizedEventValidationError"
-}
+func (e GetBucketRequestValidationError) ErrorName() string { return "GetBucketRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StorageObjectFinalizedEventValidationError) Error() string {
+func (e GetBucketRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -481,14 +356,14 @@ func (e StorageObjectFinalizedEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObjectFinalizedEvent.%s: %s%s",
+		"invalid %sGetBucketRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObjectFinalizedEventValidationError{}
+var _ error = GetBucketRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -496,84 +371,5458 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObjectFinalizedEventValidationError{}
+} = GetBucketRequestValidationError{}
 
-// Validate checks the field values on StorageObjectArchivedEvent with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StorageObjectArchivedEvent) Validate() error {
+// Validate checks the field values on GetBucketResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetBucketResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObjectArchivedEvent with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// StorageObjectArchivedEventMultiError, or nil if none found.
-func (m *StorageObjectArchivedEvent) ValidateAll() error {
+// GetBucketResponseMultiError, or nil if none found.
+func (m *GetBucketResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObjectArchivedEvent) validate(all bool) error {
+func (m *GetBucketResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetObject() == nil {
-		err := StorageObjectArchivedEventValidationError{
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetBucketResponseValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetBucketResponseMultiError is an error wrapping multiple validation errors
+// returned by GetBucketResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetBucketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetBucketResponseMultiError) AllErrors() []error { return m }
+
+// GetBucketResponseValidationError is the validation error returned by
+// GetBucketResponse.Validate if the designated constraints aren't met.
+type GetBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetBucketResponseValidationError) ErrorName() string {
+	return "GetBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetBucketResponseValidationError{}
+
+// Validate checks the field values on ListBucketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListBucketsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBucketsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBucketsRequestMultiError, or nil if none found.
+func (m *ListBucketsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBucketsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := ListBucketsRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prefix
+
+	if m.GetPageSize() < 0 {
+		err := ListBucketsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	if len(errors) > 0 {
+		return ListBucketsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBucketsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListBucketsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListBucketsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBucketsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBucketsRequestMultiError) AllErrors() []error { return m }
+
+// ListBucketsRequestValidationError is the validation error returned by
+// ListBucketsRequest.Validate if the designated constraints aren't met.
+type ListBucketsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBucketsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBucketsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBucketsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBucketsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBucketsRequestValidationError) ErrorName() string {
+	return "ListBucketsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBucketsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBucketsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBucketsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBucketsRequestValidationError{}
+
+// Validate checks the field values on ListBucketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListBucketsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListBucketsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListBucketsResponseMultiError, or nil if none found.
+func (m *ListBucketsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListBucketsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetBuckets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListBucketsResponseValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListBucketsResponseValidationError{
+						field:  fmt.Sprintf("Buckets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListBucketsResponseValidationError{
+					field:  fmt.Sprintf("Buckets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListBucketsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListBucketsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListBucketsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListBucketsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListBucketsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListBucketsResponseMultiError) AllErrors() []error { return m }
+
+// ListBucketsResponseValidationError is the validation error returned by
+// ListBucketsResponse.Validate if the designated constraints aren't met.
+type ListBucketsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListBucketsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListBucketsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListBucketsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListBucketsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListBucketsResponseValidationError) ErrorName() string {
+	return "ListBucketsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListBucketsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListBucketsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListBucketsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListBucketsResponseValidationError{}
+
+// Validate checks the field values on CreateBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateBucketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBucketRequestMultiError, or nil if none found.
+func (m *CreateBucketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBucketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetProject()) < 1 {
+		err := CreateBucketRequestValidationError{
+			field:  "Project",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := CreateBucketRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBucketRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBucketRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBucketRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBucketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBucketRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateBucketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBucketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBucketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBucketRequestMultiError) AllErrors() []error { return m }
+
+// CreateBucketRequestValidationError is the validation error returned by
+// CreateBucketRequest.Validate if the designated constraints aren't met.
+type CreateBucketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBucketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBucketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBucketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBucketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBucketRequestValidationError) ErrorName() string {
+	return "CreateBucketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBucketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBucketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBucketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBucketRequestValidationError{}
+
+// Validate checks the field values on CreateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateBucketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateBucketResponseMultiError, or nil if none found.
+func (m *CreateBucketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateBucketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateBucketResponseValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateBucketResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateBucketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateBucketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateBucketResponseMultiError) AllErrors() []error { return m }
+
+// CreateBucketResponseValidationError is the validation error returned by
+// CreateBucketResponse.Validate if the designated constraints aren't met.
+type CreateBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateBucketResponseValidationError) ErrorName() string {
+	return "CreateBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateBucketResponseValidationError{}
+
+// Validate checks the field values on UpdateBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateBucketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBucketRequestMultiError, or nil if none found.
+func (m *UpdateBucketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBucketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := UpdateBucketRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetResource() == nil {
+		err := UpdateBucketRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBucketRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBucketRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBucketRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateBucketRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBucketRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBucketRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBucketRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UserProject
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateBucketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBucketRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateBucketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBucketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBucketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBucketRequestMultiError) AllErrors() []error { return m }
+
+// UpdateBucketRequestValidationError is the validation error returned by
+// UpdateBucketRequest.Validate if the designated constraints aren't met.
+type UpdateBucketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBucketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBucketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBucketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBucketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBucketRequestValidationError) ErrorName() string {
+	return "UpdateBucketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBucketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBucketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBucketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBucketRequestValidationError{}
+
+// Validate checks the field values on UpdateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateBucketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateBucketResponseMultiError, or nil if none found.
+func (m *UpdateBucketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateBucketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateBucketResponseValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateBucketResponseValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateBucketResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateBucketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateBucketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateBucketResponseMultiError) AllErrors() []error { return m }
+
+// UpdateBucketResponseValidationError is the validation error returned by
+// UpdateBucketResponse.Validate if the designated constraints aren't met.
+type UpdateBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateBucketResponseValidationError) ErrorName() string {
+	return "UpdateBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateBucketResponseValidationError{}
+
+// Validate checks the field values on DeleteBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteBucketRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBucketRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBucketRequestMultiError, or nil if none found.
+func (m *DeleteBucketRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBucketRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := DeleteBucketRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for UserProject
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteBucketRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBucketRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteBucketRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBucketRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBucketRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBucketRequestMultiError) AllErrors() []error { return m }
+
+// DeleteBucketRequestValidationError is the validation error returned by
+// DeleteBucketRequest.Validate if the designated constraints aren't met.
+type DeleteBucketRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBucketRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBucketRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBucketRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBucketRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBucketRequestValidationError) ErrorName() string {
+	return "DeleteBucketRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBucketRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBucketRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBucketRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBucketRequestValidationError{}
+
+// Validate checks the field values on DeleteBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteBucketResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteBucketResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteBucketResponseMultiError, or nil if none found.
+func (m *DeleteBucketResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteBucketResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteBucketResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteBucketResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteBucketResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteBucketResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteBucketResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteBucketResponseMultiError) AllErrors() []error { return m }
+
+// DeleteBucketResponseValidationError is the validation error returned by
+// DeleteBucketResponse.Validate if the designated constraints aren't met.
+type DeleteBucketResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteBucketResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteBucketResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteBucketResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteBucketResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteBucketResponseValidationError) ErrorName() string {
+	return "DeleteBucketResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteBucketResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteBucketResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteBucketResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteBucketResponseValidationError{}
+
+// Validate checks the field values on GetObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectRequestMultiError, or nil if none found.
+func (m *GetObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := GetObjectRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := GetObjectRequestValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Generation
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return GetObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by GetObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectRequestMultiError) AllErrors() []error { return m }
+
+// GetObjectRequestValidationError is the validation error returned by
+// GetObjectRequest.Validate if the designated constraints aren't met.
+type GetObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectRequestValidationError) ErrorName() string { return "GetObjectRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectRequestValidationError{}
+
+// Validate checks the field values on GetObjectResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetObjectResponseMultiError, or nil if none found.
+func (m *GetObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetObjectResponseValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by GetObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetObjectResponseMultiError) AllErrors() []error { return m }
+
+// GetObjectResponseValidationError is the validation error returned by
+// GetObjectResponse.Validate if the designated constraints aren't met.
+type GetObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetObjectResponseValidationError) ErrorName() string {
+	return "GetObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetObjectResponseValidationError{}
+
+// Validate checks the field values on ListObjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListObjectsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListObjectsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListObjectsRequestMultiError, or nil if none found.
+func (m *ListObjectsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListObjectsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := ListObjectsRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Prefix
+
+	// no validation rules for Delimiter
+
+	// no validation rules for StartOffset
+
+	// no validation rules for EndOffset
+
+	// no validation rules for MatchGlob
+
+	// no validation rules for Versions
+
+	// no validation rules for IncludeTrailingDelimiter
+
+	if m.GetPageSize() < 0 {
+		err := ListObjectsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageToken
+
+	// no validation rules for UserProject
+
+	if len(errors) > 0 {
+		return ListObjectsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListObjectsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListObjectsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListObjectsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListObjectsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListObjectsRequestMultiError) AllErrors() []error { return m }
+
+// ListObjectsRequestValidationError is the validation error returned by
+// ListObjectsRequest.Validate if the designated constraints aren't met.
+type ListObjectsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListObjectsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListObjectsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListObjectsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListObjectsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListObjectsRequestValidationError) ErrorName() string {
+	return "ListObjectsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListObjectsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListObjectsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListObjectsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListObjectsRequestValidationError{}
+
+// Validate checks the field values on ListObjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListObjectsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListObjectsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListObjectsResponseMultiError, or nil if none found.
+func (m *ListObjectsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListObjectsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListObjectsResponseValidationError{
+						field:  fmt.Sprintf("Objects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListObjectsResponseValidationError{
+						field:  fmt.Sprintf("Objects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListObjectsResponseValidationError{
+					field:  fmt.Sprintf("Objects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return ListObjectsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListObjectsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListObjectsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListObjectsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListObjectsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListObjectsResponseMultiError) AllErrors() []error { return m }
+
+// ListObjectsResponseValidationError is the validation error returned by
+// ListObjectsResponse.Validate if the designated constraints aren't met.
+type ListObjectsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListObjectsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListObjectsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListObjectsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListObjectsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListObjectsResponseValidationError) ErrorName() string {
+	return "ListObjectsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListObjectsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListObjectsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListObjectsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListObjectsResponseValidationError{}
+
+// Validate checks the field values on ReadObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReadObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadObjectRequestMultiError, or nil if none found.
+func (m *ReadObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := ReadObjectRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := ReadObjectRequestValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Generation
+
+	// no validation rules for ReadOffset
+
+	if m.GetReadLimit() < 0 {
+		err := ReadObjectRequestValidationError{
+			field:  "ReadLimit",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for ReadCompressed
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return ReadObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by ReadObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReadObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadObjectRequestMultiError) AllErrors() []error { return m }
+
+// ReadObjectRequestValidationError is the validation error returned by
+// ReadObjectRequest.Validate if the designated constraints aren't met.
+type ReadObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadObjectRequestValidationError) ErrorName() string {
+	return "ReadObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadObjectRequestValidationError{}
+
+// Validate checks the field values on ReadObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ReadObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadObjectResponseMultiError, or nil if none found.
+func (m *ReadObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Data
+
+	// no validation rules for Size
+
+	// no validation rules for Remain
+
+	// no validation rules for ContentType
+
+	// no validation rules for ContentEncoding
+
+	// no validation rules for CacheControl
+
+	if len(errors) > 0 {
+		return ReadObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by ReadObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReadObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadObjectResponseMultiError) AllErrors() []error { return m }
+
+// ReadObjectResponseValidationError is the validation error returned by
+// ReadObjectResponse.Validate if the designated constraints aren't met.
+type ReadObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadObjectResponseValidationError) ErrorName() string {
+	return "ReadObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadObjectResponseValidationError{}
+
+// Validate checks the field values on WriteObjectSpec with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WriteObjectSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteObjectSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteObjectSpecMultiError, or nil if none found.
+func (m *WriteObjectSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteObjectSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := WriteObjectSpecValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := WriteObjectSpecValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WriteObjectSpecValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WriteObjectSpecValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WriteObjectSpecValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return WriteObjectSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteObjectSpecMultiError is an error wrapping multiple validation errors
+// returned by WriteObjectSpec.ValidateAll() if the designated constraints
+// aren't met.
+type WriteObjectSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteObjectSpecMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteObjectSpecMultiError) AllErrors() []error { return m }
+
+// WriteObjectSpecValidationError is the validation error returned by
+// WriteObjectSpec.Validate if the designated constraints aren't met.
+type WriteObjectSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteObjectSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteObjectSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteObjectSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteObjectSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteObjectSpecValidationError) ErrorName() string { return "WriteObjectSpecValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WriteObjectSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteObjectSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteObjectSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteObjectSpecValidationError{}
+
+// Validate checks the field values on WriteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *WriteObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteObjectRequestMultiError, or nil if none found.
+func (m *WriteObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSpec()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WriteObjectRequestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WriteObjectRequestValidationError{
+					field:  "Spec",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSpec()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WriteObjectRequestValidationError{
+				field:  "Spec",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Data
+
+	if len(errors) > 0 {
+		return WriteObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by WriteObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type WriteObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteObjectRequestMultiError) AllErrors() []error { return m }
+
+// WriteObjectRequestValidationError is the validation error returned by
+// WriteObjectRequest.Validate if the designated constraints aren't met.
+type WriteObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteObjectRequestValidationError) ErrorName() string {
+	return "WriteObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteObjectRequestValidationError{}
+
+// Validate checks the field values on WriteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *WriteObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WriteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WriteObjectResponseMultiError, or nil if none found.
+func (m *WriteObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WriteObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WriteObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WriteObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WriteObjectResponseValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WriteObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WriteObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by WriteObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WriteObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WriteObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WriteObjectResponseMultiError) AllErrors() []error { return m }
+
+// WriteObjectResponseValidationError is the validation error returned by
+// WriteObjectResponse.Validate if the designated constraints aren't met.
+type WriteObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WriteObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WriteObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WriteObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WriteObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WriteObjectResponseValidationError) ErrorName() string {
+	return "WriteObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WriteObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWriteObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WriteObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WriteObjectResponseValidationError{}
+
+// Validate checks the field values on UpdateObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateObjectRequestMultiError, or nil if none found.
+func (m *UpdateObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := UpdateObjectRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := UpdateObjectRequestValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Generation
+
+	if m.GetResource() == nil {
+		err := UpdateObjectRequestValidationError{
+			field:  "Resource",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateObjectRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateObjectRequestValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateObjectRequestValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateObjectRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateObjectRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateObjectRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateObjectRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return UpdateObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateObjectRequestMultiError) AllErrors() []error { return m }
+
+// UpdateObjectRequestValidationError is the validation error returned by
+// UpdateObjectRequest.Validate if the designated constraints aren't met.
+type UpdateObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateObjectRequestValidationError) ErrorName() string {
+	return "UpdateObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateObjectRequestValidationError{}
+
+// Validate checks the field values on UpdateObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateObjectResponseMultiError, or nil if none found.
+func (m *UpdateObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateObjectResponseValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateObjectResponseMultiError) AllErrors() []error { return m }
+
+// UpdateObjectResponseValidationError is the validation error returned by
+// UpdateObjectResponse.Validate if the designated constraints aren't met.
+type UpdateObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateObjectResponseValidationError) ErrorName() string {
+	return "UpdateObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateObjectResponseValidationError{}
+
+// Validate checks the field values on DeleteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteObjectRequestMultiError, or nil if none found.
+func (m *DeleteObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetBucket()) < 1 {
+		err := DeleteObjectRequestValidationError{
+			field:  "Bucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetObject()) < 1 {
+		err := DeleteObjectRequestValidationError{
+			field:  "Object",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Generation
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return DeleteObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteObjectRequestMultiError) AllErrors() []error { return m }
+
+// DeleteObjectRequestValidationError is the validation error returned by
+// DeleteObjectRequest.Validate if the designated constraints aren't met.
+type DeleteObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteObjectRequestValidationError) ErrorName() string {
+	return "DeleteObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteObjectRequestValidationError{}
+
+// Validate checks the field values on DeleteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *DeleteObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteObjectResponseMultiError, or nil if none found.
+func (m *DeleteObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteObjectResponseMultiError) AllErrors() []error { return m }
+
+// DeleteObjectResponseValidationError is the validation error returned by
+// DeleteObjectResponse.Validate if the designated constraints aren't met.
+type DeleteObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteObjectResponseValidationError) ErrorName() string {
+	return "DeleteObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteObjectResponseValidationError{}
+
+// Validate checks the field values on CopyObjectRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CopyObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyObjectRequestMultiError, or nil if none found.
+func (m *CopyObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDestinationBucket()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "DestinationBucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestinationObject()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "DestinationObject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDestination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyObjectRequestValidationError{
+					field:  "Destination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyObjectRequestValidationError{
+					field:  "Destination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDestination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyObjectRequestValidationError{
+				field:  "Destination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DestinationKmsKeyName
+
+	if utf8.RuneCountInString(m.GetSourceBucket()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "SourceBucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSourceObject()) < 1 {
+		err := CopyObjectRequestValidationError{
+			field:  "SourceObject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SourceGeneration
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for SourceEncryptionKey
+
+	// no validation rules for RewriteToken
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if m.IfSourceGenerationMatch != nil {
+		// no validation rules for IfSourceGenerationMatch
+
+	}
+
+	if m.IfSourceGenerationNotMatch != nil {
+		// no validation rules for IfSourceGenerationNotMatch
+
+	}
+
+	if m.IfSourceMetagenerationMatch != nil {
+		// no validation rules for IfSourceMetagenerationMatch
+
+	}
+
+	if m.IfSourceMetagenerationNotMatch != nil {
+		// no validation rules for IfSourceMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return CopyObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyObjectRequestMultiError is an error wrapping multiple validation errors
+// returned by CopyObjectRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CopyObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyObjectRequestMultiError) AllErrors() []error { return m }
+
+// CopyObjectRequestValidationError is the validation error returned by
+// CopyObjectRequest.Validate if the designated constraints aren't met.
+type CopyObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyObjectRequestValidationError) ErrorName() string {
+	return "CopyObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyObjectRequestValidationError{}
+
+// Validate checks the field values on CopyObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CopyObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CopyObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CopyObjectResponseMultiError, or nil if none found.
+func (m *CopyObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CopyObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CopyObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CopyObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CopyObjectResponseValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CopyObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CopyObjectResponseMultiError is an error wrapping multiple validation errors
+// returned by CopyObjectResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CopyObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CopyObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CopyObjectResponseMultiError) AllErrors() []error { return m }
+
+// CopyObjectResponseValidationError is the validation error returned by
+// CopyObjectResponse.Validate if the designated constraints aren't met.
+type CopyObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CopyObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CopyObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CopyObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CopyObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CopyObjectResponseValidationError) ErrorName() string {
+	return "CopyObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CopyObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCopyObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CopyObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CopyObjectResponseValidationError{}
+
+// Validate checks the field values on ComposeObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ComposeObjectRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComposeObjectRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComposeObjectRequestMultiError, or nil if none found.
+func (m *ComposeObjectRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComposeObjectRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetDestinationBucket()) < 1 {
+		err := ComposeObjectRequestValidationError{
+			field:  "DestinationBucket",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDestinationObject()) < 1 {
+		err := ComposeObjectRequestValidationError{
+			field:  "DestinationObject",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDestination()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComposeObjectRequestValidationError{
+					field:  "Destination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComposeObjectRequestValidationError{
+					field:  "Destination",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDestination()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComposeObjectRequestValidationError{
+				field:  "Destination",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if l := len(m.GetSourceObjects()); l < 1 || l > 32 {
+		err := ComposeObjectRequestValidationError{
+			field:  "SourceObjects",
+			reason: "value must contain between 1 and 32 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSourceObjects() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ComposeObjectRequestValidationError{
+						field:  fmt.Sprintf("SourceObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ComposeObjectRequestValidationError{
+						field:  fmt.Sprintf("SourceObjects[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ComposeObjectRequestValidationError{
+					field:  fmt.Sprintf("SourceObjects[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for EncryptionKey
+
+	// no validation rules for UserProject
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
+	if m.IfGenerationNotMatch != nil {
+		// no validation rules for IfGenerationNotMatch
+
+	}
+
+	if m.IfMetagenerationMatch != nil {
+		// no validation rules for IfMetagenerationMatch
+
+	}
+
+	if m.IfMetagenerationNotMatch != nil {
+		// no validation rules for IfMetagenerationNotMatch
+
+	}
+
+	if len(errors) > 0 {
+		return ComposeObjectRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComposeObjectRequestMultiError is an error wrapping multiple validation
+// errors returned by ComposeObjectRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ComposeObjectRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComposeObjectRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComposeObjectRequestMultiError) AllErrors() []error { return m }
+
+// ComposeObjectRequestValidationError is the validation error returned by
+// ComposeObjectRequest.Validate if the designated constraints aren't met.
+type ComposeObjectRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComposeObjectRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComposeObjectRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComposeObjectRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComposeObjectRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComposeObjectRequestValidationError) ErrorName() string {
+	return "ComposeObjectRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComposeObjectRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComposeObjectRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComposeObjectRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComposeObjectRequestValidationError{}
+
+// Validate checks the field values on ComposeObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ComposeObjectResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComposeObjectResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ComposeObjectResponseMultiError, or nil if none found.
+func (m *ComposeObjectResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComposeObjectResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ComposeObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ComposeObjectResponseValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ComposeObjectResponseValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ComposeObjectResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ComposeObjectResponseMultiError is an error wrapping multiple validation
+// errors returned by ComposeObjectResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ComposeObjectResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ComposeObjectResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ComposeObjectResponseMultiError) AllErrors() []error { return m }
+
+// ComposeObjectResponseValidationError is the validation error returned by
+// ComposeObjectResponse.Validate if the designated constraints aren't met.
+type ComposeObjectResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ComposeObjectResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ComposeObjectResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ComposeObjectResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ComposeObjectResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ComposeObjectResponseValidationError) ErrorName() string {
+	return "ComposeObjectResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ComposeObjectResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sComposeObjectResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ComposeObjectResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ComposeObjectResponseValidationError{}
+
+// Validate checks the field values on StorageObject with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StorageObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObject with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StorageObjectMultiError, or
+// nil if none found.
+func (m *StorageObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ContentEncoding
+
+	// no validation rules for ContentDisposition
+
+	// no validation rules for CacheControl
+
+	// no validation rules for ContentLanguage
+
+	// no validation rules for Metageneration
+
+	if all {
+		switch v := interface{}(m.GetTimeDeleted()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeDeleted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeDeleted",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeDeleted()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "TimeDeleted",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ContentType
+
+	// no validation rules for Size
+
+	if all {
+		switch v := interface{}(m.GetTimeCreated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeCreated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeCreated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "TimeCreated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Crc32C
+
+	// no validation rules for ComponentCount
+
+	// no validation rules for Md5Hash
+
+	// no validation rules for Etag
+
+	if all {
+		switch v := interface{}(m.GetUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Updated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Updated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "Updated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StorageClass
+
+	// no validation rules for KmsKeyName
+
+	if all {
+		switch v := interface{}(m.GetTimeStorageClassUpdated()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeStorageClassUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeStorageClassUpdated",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeStorageClassUpdated()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "TimeStorageClassUpdated",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TemporaryHold
+
+	if all {
+		switch v := interface{}(m.GetRetentionExpirationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "RetentionExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "RetentionExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionExpirationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "RetentionExpirationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Metadata
+
+	// no validation rules for EventBasedHold
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	// no validation rules for Bucket
+
+	// no validation rules for Generation
+
+	if all {
+		switch v := interface{}(m.GetCustomerEncryption()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "CustomerEncryption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "CustomerEncryption",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomerEncryption()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "CustomerEncryption",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOwner()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Owner",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOwner()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "Owner",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCustomTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "CustomTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "CustomTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCustomTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "CustomTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTimeFinalized()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeFinalized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "TimeFinalized",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeFinalized()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "TimeFinalized",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSoftDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "SoftDeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "SoftDeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSoftDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "SoftDeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHardDeleteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "HardDeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectValidationError{
+					field:  "HardDeleteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHardDeleteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectValidationError{
+				field:  "HardDeleteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MediaLink
+
+	// no validation rules for SelfLink
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return StorageObjectMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectMultiError is an error wrapping multiple validation errors
+// returned by StorageObject.ValidateAll() if the designated constraints
+// aren't met.
+type StorageObjectMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectMultiError) AllErrors() []error { return m }
+
+// StorageObjectValidationError is the validation error returned by
+// StorageObject.Validate if the designated constraints aren't met.
+type StorageObjectValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectValidationError) ErrorName() string { return "StorageObjectValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StorageObjectValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObject.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectValidationError{}
+
+// Validate checks the field values on StorageObjectEventEnvelope with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObjectEventEnvelope) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObjectEventEnvelope with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObjectEventEnvelopeMultiError, or nil if none found.
+func (m *StorageObjectEventEnvelope) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObjectEventEnvelope) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MessageId
+
+	if all {
+		switch v := interface{}(m.GetPublishTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectEventEnvelopeValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectEventEnvelopeValidationError{
+					field:  "PublishTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPublishTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectEventEnvelopeValidationError{
+				field:  "PublishTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EventType
+
+	// no validation rules for BucketId
+
+	// no validation rules for ObjectId
+
+	// no validation rules for ObjectGeneration
+
+	if all {
+		switch v := interface{}(m.GetEventTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectEventEnvelopeValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectEventEnvelopeValidationError{
+					field:  "EventTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEventTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectEventEnvelopeValidationError{
+				field:  "EventTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for NotificationConfig
+
+	// no validation rules for PayloadFormat
+
+	// no validation rules for OverwroteGeneration
+
+	// no validation rules for OverwrittenByGeneration
+
+	if len(errors) > 0 {
+		return StorageObjectEventEnvelopeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectEventEnvelopeMultiError is an error wrapping multiple
+// validation errors returned by StorageObjectEventEnvelope.ValidateAll() if
+// the designated constraints aren't met.
+type StorageObjectEventEnvelopeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectEventEnvelopeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectEventEnvelopeMultiError) AllErrors() []error { return m }
+
+// StorageObjectEventEnvelopeValidationError is the validation error returned
+// by StorageObjectEventEnvelope.Validate if the designated constraints aren't
+// met.
+type StorageObjectEventEnvelopeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectEventEnvelopeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectEventEnvelopeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectEventEnvelopeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectEventEnvelopeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectEventEnvelopeValidationError) ErrorName() string {
+	return "StorageObjectEventEnvelopeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageObjectEventEnvelopeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObjectEventEnvelope.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectEventEnvelopeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectEventEnvelopeValidationError{}
+
+// Validate checks the field values on StorageObjectFinalizedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObjectFinalizedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObjectFinalizedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObjectFinalizedEventMultiError, or nil if none found.
+func (m *StorageObjectFinalizedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObjectFinalizedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := StorageObjectFinalizedEventValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectFinalizedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectFinalizedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectFinalizedEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectFinalizedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectFinalizedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectFinalizedEventValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageObjectFinalizedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectFinalizedEventMultiError is an error wrapping multiple
+// validation errors returned by StorageObjectFinalizedEvent.ValidateAll() if
+// the designated constraints aren't met.
+type StorageObjectFinalizedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectFinalizedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectFinalizedEventMultiError) AllErrors() []error { return m }
+
+// StorageObjectFinalizedEventValidationError is the validation error returned
+// by StorageObjectFinalizedEvent.Validate if the designated constraints
+// aren't met.
+type StorageObjectFinalizedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectFinalizedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectFinalizedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectFinalizedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectFinalizedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectFinalizedEventValidationError) ErrorName() string {
+	return "StorageObjectFinalizedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageObjectFinalizedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObjectFinalizedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectFinalizedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectFinalizedEventValidationError{}
+
+// Validate checks the field values on StorageObjectArchivedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObjectArchivedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObjectArchivedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObjectArchivedEventMultiError, or nil if none found.
+func (m *StorageObjectArchivedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObjectArchivedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := StorageObjectArchivedEventValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectArchivedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectArchivedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectArchivedEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectArchivedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectArchivedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectArchivedEventValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageObjectArchivedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectArchivedEventMultiError is an error wrapping multiple
+// validation errors returned by StorageObjectArchivedEvent.ValidateAll() if
+// the designated constraints aren't met.
+type StorageObjectArchivedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectArchivedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectArchivedEventMultiError) AllErrors() []error { return m }
+
+// StorageObjectArchivedEventValidationError is the validation error returned
+// by StorageObjectArchivedEvent.Validate if the designated constraints aren't
+// met.
+type StorageObjectArchivedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectArchivedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectArchivedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectArchivedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectArchivedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectArchivedEventValidationError) ErrorName() string {
+	return "StorageObjectArchivedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageObjectArchivedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObjectArchivedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectArchivedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectArchivedEventValidationError{}
+
+// Validate checks the field values on StorageObjectDeletedEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObjectDeletedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObjectDeletedEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObjectDeletedEventMultiError, or nil if none found.
+func (m *StorageObjectDeletedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObjectDeletedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := StorageObjectDeletedEventValidationError{
+			field:  "Object",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectDeletedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectDeletedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectDeletedEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnvelope()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectDeletedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectDeletedEventValidationError{
+					field:  "Envelope",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectDeletedEventValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageObjectDeletedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectDeletedEventMultiError is an error wrapping multiple validation
+// errors returned by StorageObjectDeletedEvent.ValidateAll() if the
+// designated constraints aren't met.
+type StorageObjectDeletedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectDeletedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectDeletedEventMultiError) AllErrors() []error { return m }
+
+// StorageObjectDeletedEventValidationError is the validation error returned by
+// StorageObjectDeletedEvent.Validate if the designated constraints aren't
+// met.
+type StorageObjectDeletedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectDeletedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectDeletedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectDeletedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectDeletedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectDeletedEventValidationError) ErrorName() string {
+	return "StorageObjectDeletedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageObjectDeletedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObjectDeletedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectDeletedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectDeletedEventValidationError{}
+
+// Validate checks the field values on StorageObjectMetadataUpdatedEvent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StorageObjectMetadataUpdatedEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageObjectMetadataUpdatedEvent
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StorageObjectMetadataUpdatedEventMultiError, or nil if none found.
+func (m *StorageObjectMetadataUpdatedEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageObjectMetadataUpdatedEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetObject() == nil {
+		err := StorageObjectMetadataUpdatedEventValidationError{
 			field:  "Object",
 			reason: "value is required",
 		}
-		if !all {
-			return err
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetObject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
+					field:  "Object",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObjectMetadataUpdatedEventValidationError{
+				field:  "Object",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetObject()).(type) {
+		switch v := interface{}(m.GetEnvelope()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectArchivedEventValidationError{
-					field:  "Object",
+				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
+					field:  "Envelope",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectArchivedEventValidationError{
-					field:  "Object",
+				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
+					field:  "Envelope",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnvelope()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StorageObjectArchivedEventValidationError{
-				field:  "Object",
+			return StorageObjectMetadataUpdatedEventValidationError{
+				field:  "Envelope",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StorageObjectMetadataUpdatedEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageObjectMetadataUpdatedEventMultiError is an error wrapping multiple
+// validation errors returned by
+// StorageObjectMetadataUpdatedEvent.ValidateAll() if the designated
+// constraints aren't met.
+type StorageObjectMetadataUpdatedEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageObjectMetadataUpdatedEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageObjectMetadataUpdatedEventMultiError) AllErrors() []error { return m }
+
+// StorageObjectMetadataUpdatedEventValidationError is the validation error
+// returned by StorageObjectMetadataUpdatedEvent.Validate if the designated
+// constraints aren't met.
+type StorageObjectMetadataUpdatedEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageObjectMetadataUpdatedEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageObjectMetadataUpdatedEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageObjectMetadataUpdatedEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageObjectMetadataUpdatedEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageObjectMetadataUpdatedEventValidationError) ErrorName() string {
+	return "StorageObjectMetadataUpdatedEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageObjectMetadataUpdatedEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageObjectMetadataUpdatedEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageObjectMetadataUpdatedEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageObjectMetadataUpdatedEventValidationError{}
+
+// Validate checks the field values on StorageBucket_RetentionPolicy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageBucket_RetentionPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StorageBucket_RetentionPolicy with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StorageBucket_RetentionPolicyMultiError, or nil if none found.
+func (m *StorageBucket_RetentionPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StorageBucket_RetentionPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRetentionPeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageBucket_RetentionPolicyValidationError{
+					field:  "RetentionPeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageBucket_RetentionPolicyValidationError{
+					field:  "RetentionPeriod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetentionPeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageBucket_RetentionPolicyValidationError{
+				field:  "RetentionPeriod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEffectiveTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageBucket_RetentionPolicyValidationError{
+					field:  "EffectiveTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageBucket_RetentionPolicyValidationError{
+					field:  "EffectiveTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageBucket_RetentionPolicyValidationError{
+				field:  "EffectiveTime",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for IsLocked
+
+	if len(errors) > 0 {
+		return StorageBucket_RetentionPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// StorageBucket_RetentionPolicyMultiError is an error wrapping multiple
+// validation errors returned by StorageBucket_RetentionPolicy.ValidateAll()
+// if the designated constraints aren't met.
+type StorageBucket_RetentionPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StorageBucket_RetentionPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StorageBucket_RetentionPolicyMultiError) AllErrors() []error { return m }
+
+// StorageBucket_RetentionPolicyValidationError is the validation error
+// returned by StorageBucket_RetentionPolicy.Validate if the designated
+// constraints aren't met.
+type StorageBucket_RetentionPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StorageBucket_RetentionPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StorageBucket_RetentionPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StorageBucket_RetentionPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StorageBucket_RetentionPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StorageBucket_RetentionPolicyValidationError) ErrorName() string {
+	return "StorageBucket_RetentionPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StorageBucket_RetentionPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStorageBucket_RetentionPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StorageBucket_RetentionPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StorageBucket_RetentionPolicyValidationError{}
+
+// Validate checks the field values on ComposeObjectRequest_SourceObject with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ComposeObjectRequest_SourceObject) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ComposeObjectRequest_SourceObject
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ComposeObjectRequest_SourceObjectMultiError, or nil if none found.
+func (m *ComposeObjectRequest_SourceObject) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ComposeObjectRequest_SourceObject) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ComposeObjectRequest_SourceObjectValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Generation
+
+	if m.IfGenerationMatch != nil {
+		// no validation rules for IfGenerationMatch
+
+	}
+
 	if len(errors) > 0 {
-		return StorageObjectArchivedEventMultiError(errors)
+		return ComposeObjectRequest_SourceObjectMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObjectArchivedEventMultiError is an error wrapping multiple
-// validation errors returned by StorageObjectArchivedEvent.ValidateAll() if
-// the designated constraints aren't met.
-type StorageObjectArchivedEventMultiError []error
+// ComposeObjectRequest_SourceObjectMultiError is an error wrapping multiple
+// validation errors returned by
+// ComposeObjectRequest_SourceObject.ValidateAll() if the designated
+// constraints aren't met.
+type ComposeObjectRequest_SourceObjectMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObjectArchivedEventMultiError) Error() string {
+func (m ComposeObjectRequest_SourceObjectMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -582,11 +5831,12 @@ func (m StorageObjectArchivedEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObjectArchivedEventMultiError) AllErrors() []error { return m }
+func (m ComposeObjectRequest_SourceObjectMultiError) AllErrors() []error { return m }
 
-// StorageObjectArchivedEventValidationError is the validation error returned
-// by StorageObjectArchivedEvent.Validate if the designated constraints aren't met.
-type StorageObjectArchivedEventValidationError struct {
+// ComposeObjectRequest_SourceObjectValidationError is the validation error
+// returned by ComposeObjectRequest_SourceObject.Validate if the designated
+// constraints aren't met.
+type ComposeObjectRequest_SourceObjectValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -594,24 +5844,24 @@ type StorageObjectArchivedEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObjectArchivedEventValidationError) Field() string { return e.field }
+func (e ComposeObjectRequest_SourceObjectValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObjectArchivedEventValidationError) Reason() string { return e.reason }
+func (e ComposeObjectRequest_SourceObjectValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObjectArchivedEventValidationError) Cause() error { return e.cause }
+func (e ComposeObjectRequest_SourceObjectValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObjectArchivedEventValidationError) Key() bool { return e.key }
+func (e ComposeObjectRequest_SourceObjectValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObjectArchivedEventValidationError) ErrorName() string {
-	return "StorageObjectArchivedEventValidationError"
+func (e ComposeObjectRequest_SourceObjectValidationError) ErrorName() string {
+	return "ComposeObjectRequest_SourceObjectValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StorageObjectArchivedEventValidationError) Error() string {
+func (e ComposeObjectRequest_SourceObjectValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -623,14 +5873,14 @@ func (e StorageObjectArchivedEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObjectArchivedEvent.%s: %s%s",
+		"invalid %sComposeObjectRequest_SourceObject.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObjectArchivedEventValidationError{}
+var _ error = ComposeObjectRequest_SourceObjectValidationError{}
 
 var _ interface {
 	Field() string
@@ -638,84 +5888,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObjectArchivedEventValidationError{}
+} = ComposeObjectRequest_SourceObjectValidationError{}
 
-// Validate checks the field values on StorageObjectDeletedEvent with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StorageObjectDeletedEvent) Validate() error {
+// Validate checks the field values on StorageObject_CustomerEncryption with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StorageObject_CustomerEncryption) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObjectDeletedEvent with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StorageObjectDeletedEventMultiError, or nil if none found.
-func (m *StorageObjectDeletedEvent) ValidateAll() error {
+// ValidateAll checks the field values on StorageObject_CustomerEncryption with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StorageObject_CustomerEncryptionMultiError, or nil if none found.
+func (m *StorageObject_CustomerEncryption) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObjectDeletedEvent) validate(all bool) error {
+func (m *StorageObject_CustomerEncryption) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetObject() == nil {
-		err := StorageObjectDeletedEventValidationError{
-			field:  "Object",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for EncryptionAlgorithm
 
-	if all {
-		switch v := interface{}(m.GetObject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectDeletedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectDeletedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectDeletedEventValidationError{
-				field:  "Object",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for KeySha256
 
 	if len(errors) > 0 {
-		return StorageObjectDeletedEventMultiError(errors)
+		return StorageObject_CustomerEncryptionMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObjectDeletedEventMultiError is an error wrapping multiple validation
-// errors returned by StorageObjectDeletedEvent.ValidateAll() if the
-// designated constraints aren't met.
-type StorageObjectDeletedEventMultiError []error
+// StorageObject_CustomerEncryptionMultiError is an error wrapping multiple
+// validation errors returned by
+// StorageObject_CustomerEncryption.ValidateAll() if the designated
+// constraints aren't met.
+type StorageObject_CustomerEncryptionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObjectDeletedEventMultiError) Error() string {
+func (m StorageObject_CustomerEncryptionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -724,11 +5940,12 @@ func (m StorageObjectDeletedEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObjectDeletedEventMultiError) AllErrors() []error { return m }
+func (m StorageObject_CustomerEncryptionMultiError) AllErrors() []error { return m }
 
-// StorageObjectDeletedEventValidationError is the validation error returned by
-// StorageObjectDeletedEvent.Validate if the designated constraints aren't met.
-type StorageObjectDeletedEventValidationError struct {
+// StorageObject_CustomerEncryptionValidationError is the validation error
+// returned by StorageObject_CustomerEncryption.Validate if the designated
+// constraints aren't met.
+type StorageObject_CustomerEncryptionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -736,24 +5953,24 @@ type StorageObjectDeletedEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObjectDeletedEventValidationError) Field() string { return e.field }
+func (e StorageObject_CustomerEncryptionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObjectDeletedEventValidationError) Reason() string { return e.reason }
+func (e StorageObject_CustomerEncryptionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObjectDeletedEventValidationError) Cause() error { return e.cause }
+func (e StorageObject_CustomerEncryptionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObjectDeletedEventValidationError) Key() bool { return e.key }
+func (e StorageObject_CustomerEncryptionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObjectDeletedEventValidationError) ErrorName() string {
-	return "StorageObjectDeletedEventValidationError"
+func (e StorageObject_CustomerEncryptionValidationError) ErrorName() string {
+	return "StorageObject_CustomerEncryptionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StorageObjectDeletedEventValidationError) Error() string {
+func (e StorageObject_CustomerEncryptionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -765,14 +5982,14 @@ func (e StorageObjectDeletedEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObjectDeletedEvent.%s: %s%s",
+		"invalid %sStorageObject_CustomerEncryption.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObjectDeletedEventValidationError{}
+var _ error = StorageObject_CustomerEncryptionValidationError{}
 
 var _ interface {
 	Field() string
@@ -780,86 +5997,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObjectDeletedEventValidationError{}
+} = StorageObject_CustomerEncryptionValidationError{}
 
-// Validate checks the field values on StorageObjectMetadataUpdatedEvent with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *StorageObjectMetadataUpdatedEvent) Validate() error {
+// Validate checks the field values on StorageObject_Owner with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObject_Owner) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObjectMetadataUpdatedEvent
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// StorageObjectMetadataUpdatedEventMultiError, or nil if none found.
-func (m *StorageObjectMetadataUpdatedEvent) ValidateAll() error {
+// ValidateAll checks the field values on StorageObject_Owner with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObject_OwnerMultiError, or nil if none found.
+func (m *StorageObject_Owner) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObjectMetadataUpdatedEvent) validate(all bool) error {
+func (m *StorageObject_Owner) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetObject() == nil {
-		err := StorageObjectMetadataUpdatedEventValidationError{
-			field:  "Object",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Entity
 
-	if all {
-		switch v := interface{}(m.GetObject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StorageObjectMetadataUpdatedEventValidationError{
-					field:  "Object",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetObject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StorageObjectMetadataUpdatedEventValidationError{
-				field:  "Object",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for EntityId
 
 	if len(errors) > 0 {
-		return StorageObjectMetadataUpdatedEventMultiError(errors)
+		return StorageObject_OwnerMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObjectMetadataUpdatedEventMultiError is an error wrapping multiple
-// validation errors returned by
-// StorageObjectMetadataUpdatedEvent.ValidateAll() if the designated
+// StorageObject_OwnerMultiError is an error wrapping multiple validation
+// errors returned by StorageObject_Owner.ValidateAll() if the designated
 // constraints aren't met.
-type StorageObjectMetadataUpdatedEventMultiError []error
+type StorageObject_OwnerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObjectMetadataUpdatedEventMultiError) Error() string {
+func (m StorageObject_OwnerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -868,12 +6048,11 @@ func (m StorageObjectMetadataUpdatedEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObjectMetadataUpdatedEventMultiError) AllErrors() []error { return m }
+func (m StorageObject_OwnerMultiError) AllErrors() []error { return m }
 
-// StorageObjectMetadataUpdatedEventValidationError is the validation error
-// returned by StorageObjectMetadataUpdatedEvent.Validate if the designated
-// constraints aren't met.
-type StorageObjectMetadataUpdatedEventValidationError struct {
+// StorageObject_OwnerValidationError is the validation error returned by
+// StorageObject_Owner.Validate if the designated constraints aren't met.
+type StorageObject_OwnerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -881,24 +6060,24 @@ type StorageObjectMetadataUpdatedEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObjectMetadataUpdatedEventValidationError) Field() string { return e.field }
+func (e StorageObject_OwnerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObjectMetadataUpdatedEventValidationError) Reason() string { return e.reason }
+func (e StorageObject_OwnerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObjectMetadataUpdatedEventValidationError) Cause() error { return e.cause }
+func (e StorageObject_OwnerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObjectMetadataUpdatedEventValidationError) Key() bool { return e.key }
+func (e StorageObject_OwnerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObjectMetadataUpdatedEventValidationError) ErrorName() string {
-	return "StorageObjectMetadataUpdatedEventValidationError"
+func (e StorageObject_OwnerValidationError) ErrorName() string {
+	return "StorageObject_OwnerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StorageObjectMetadataUpdatedEventValidationError) Error() string {
+func (e StorageObject_OwnerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -910,14 +6089,14 @@ func (e StorageObjectMetadataUpdatedEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObjectMetadataUpdatedEvent.%s: %s%s",
+		"invalid %sStorageObject_Owner.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObjectMetadataUpdatedEventValidationError{}
+var _ error = StorageObject_OwnerValidationError{}
 
 var _ interface {
 	Field() string
@@ -925,50 +6104,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObjectMetadataUpdatedEventValidationError{}
+} = StorageObject_OwnerValidationError{}
 
-// Validate checks the field values on StorageObject_CustomerEncryption with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *StorageObject_CustomerEncryption) Validate() error {
+// Validate checks the field values on StorageObject_Retention with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *StorageObject_Retention) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StorageObject_CustomerEncryption with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// StorageObject_CustomerEncryptionMultiError, or nil if none found.
-func (m *StorageObject_CustomerEncryption) ValidateAll() error {
+// ValidateAll checks the field values on StorageObject_Retention with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StorageObject_RetentionMultiError, or nil if none found.
+func (m *StorageObject_Retention) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StorageObject_CustomerEncryption) validate(all bool) error {
+func (m *StorageObject_Retention) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for EncryptionAlgorithm
+	// no validation rules for Mode
 
-	// no validation rules for KeySha256
+	if all {
+		switch v := interface{}(m.GetRetainUntilTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StorageObject_RetentionValidationError{
+					field:  "RetainUntilTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StorageObject_RetentionValidationError{
+					field:  "RetainUntilTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetainUntilTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StorageObject_RetentionValidationError{
+				field:  "RetainUntilTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return StorageObject_CustomerEncryptionMultiError(errors)
+		return StorageObject_RetentionMultiError(errors)
 	}
 
 	return nil
 }
 
-// StorageObject_CustomerEncryptionMultiError is an error wrapping multiple
-// validation errors returned by
-// StorageObject_CustomerEncryption.ValidateAll() if the designated
+// StorageObject_RetentionMultiError is an error wrapping multiple validation
+// errors returned by StorageObject_Retention.ValidateAll() if the designated
 // constraints aren't met.
-type StorageObject_CustomerEncryptionMultiError []error
+type StorageObject_RetentionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StorageObject_CustomerEncryptionMultiError) Error() string {
+func (m StorageObject_RetentionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -977,12 +6182,11 @@ func (m StorageObject_CustomerEncryptionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StorageObject_CustomerEncryptionMultiError) AllErrors() []error { return m }
+func (m StorageObject_RetentionMultiError) AllErrors() []error { return m }
 
-// StorageObject_CustomerEncryptionValidationError is the validation error
-// returned by StorageObject_CustomerEncryption.Validate if the designated
-// constraints aren't met.
-type StorageObject_CustomerEncryptionValidationError struct {
+// StorageObject_RetentionValidationError is the validation error returned by
+// StorageObject_Retention.Validate if the designated constraints aren't met.
+type StorageObject_RetentionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -990,24 +6194,24 @@ type StorageObject_CustomerEncryptionValidationError struct {
 }
 
 // Field function returns field value.
-func (e StorageObject_CustomerEncryptionValidationError) Field() string { return e.field }
+func (e StorageObject_RetentionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StorageObject_CustomerEncryptionValidationError) Reason() string { return e.reason }
+func (e StorageObject_RetentionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StorageObject_CustomerEncryptionValidationError) Cause() error { return e.cause }
+func (e StorageObject_RetentionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StorageObject_CustomerEncryptionValidationError) Key() bool { return e.key }
+func (e StorageObject_RetentionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StorageObject_CustomerEncryptionValidationError) ErrorName() string {
-	return "StorageObject_CustomerEncryptionValidationError"
+func (e StorageObject_RetentionValidationError) ErrorName() string {
+	return "StorageObject_RetentionValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StorageObject_CustomerEncryptionValidationError) Error() string {
+func (e StorageObject_RetentionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1019,14 +6223,14 @@ func (e StorageObject_CustomerEncryptionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStorageObject_CustomerEncryption.%s: %s%s",
+		"invalid %sStorageObject_Retention.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StorageObject_CustomerEncryptionValidationError{}
+var _ error = StorageObject_RetentionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1034,4 +6238,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StorageObject_CustomerEncryptionValidationError{}
+} = StorageObject_RetentionValidationError{}
@@ -0,0 +1,664 @@
+// Package storagev1chaos decorates any storagev1.StorageServiceClient with
+// injected failures, so that the code on top of the storage can be proven to
+// survive a flaky one:
+//
+//	client := storagev1chaos.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+//	// fail one upload out of ten
+//	client.AddRule(storagev1chaos.Rule{
+//		Methods:     []storagev1chaos.Method{storagev1chaos.MethodObjectClose},
+//		Probability: 0.1,
+//		Fault:       storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+//	})
+//	// truncate the second read of the logs
+//	client.AddRule(storagev1chaos.Rule{
+//		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+//		Bucket:   "bucket",
+//		Prefix:   "logs/",
+//		Schedule: storagev1chaos.Calls(2),
+//		Fault:    storagev1chaos.Fault{Err: io.ErrUnexpectedEOF, Offset: 1024},
+//	})
+package storagev1chaos
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents a storage service client that injects
+// failures into the calls of another client.
+type StorageServiceClient struct {
+	client storagev1.StorageServiceClient
+	config *config
+}
+
+// NewStorageServiceClient creates a new StorageServiceClient that injects
+// failures into the calls of the given client. It injects none until a rule
+// is added.
+func NewStorageServiceClient(client storagev1.StorageServiceClient) *StorageServiceClient {
+	return &StorageServiceClient{
+		client: client,
+		config: &config{rand: rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))},
+	}
+}
+
+// AddRule adds a rule. The first rule that selects a call injects its fault.
+func (x *StorageServiceClient) AddRule(r Rule) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// add the rule
+	x.config.rules = append(x.config.rules, &rule{Rule: r})
+}
+
+// ClearRules removes the rules.
+func (x *StorageServiceClient) ClearRules() {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// remove the rules
+	x.config.rules = nil
+}
+
+// SetSeed sets the seed of the probabilities, so that a scenario can be
+// replayed.
+func (x *StorageServiceClient) SetSeed(seed uint64) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// set the seed
+	x.config.rand = rand.New(rand.NewPCG(seed, seed))
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{handle: x.client.Bucket(name), config: x.config, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{ctx: ctx, config: x.config, iter: x.client.Buckets(ctx, projectID)}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return x.client.Close()
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents a storage bucket handle that injects
+// failures into the calls of another handle.
+type StorageBucketHandle struct {
+	handle storagev1.StorageBucketHandle
+	config *config
+	name   string
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config, bucket: x.name}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	if err := x.config.fault(MethodNotification, x.name, "").inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.AddNotification(ctx, n)
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	if err := x.config.fault(MethodBucketAttrs, x.name, "").inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.Attrs(ctx)
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	if err := x.config.fault(MethodBucketCreate, x.name, "").inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.Create(ctx, projectID, attrs)
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.DefaultObjectACL(), config: x.config, bucket: x.name}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	if err := x.config.fault(MethodBucketDelete, x.name, "").inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.Delete(ctx)
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	if err := x.config.fault(MethodNotification, x.name, "").inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.DeleteNotification(ctx, id)
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(conds storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	if err := x.config.fault(MethodBucketLock, x.name, "").inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.LockRetentionPolicy(ctx)
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	if err := x.config.fault(MethodNotification, x.name, "").inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.Notifications(ctx)
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{handle: x.handle.Object(name), config: x.config, bucket: x.name, name: name}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	iter := &StorageObjectIterator{
+		ctx:    ctx,
+		config: x.config,
+		bucket: x.name,
+		iter:   x.handle.Objects(ctx, query),
+	}
+
+	if query != nil {
+		iter.prefix = query.Prefix
+	}
+
+	return iter
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, uattrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	if err := x.config.fault(MethodBucketUpdate, x.name, "").inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.Update(ctx, uattrs)
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.UserProject(projectID)
+	return &handle
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents a storage bucket iterator that injects
+// failures into its Next calls.
+type StorageBucketIterator struct {
+	ctx    context.Context
+	config *config
+	iter   storagev1.StorageBucketIterator
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	if err := x.config.fault(MethodBucketList, "", "").inject(x.ctx); err != nil {
+		return nil, err
+	}
+
+	return x.iter.Next()
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents a storage object iterator that injects
+// failures into its Next calls.
+type StorageObjectIterator struct {
+	ctx    context.Context
+	config *config
+	bucket string
+	prefix string
+	iter   storagev1.StorageObjectIterator
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if err := x.config.fault(MethodObjectList, x.bucket, x.prefix).inject(x.ctx); err != nil {
+		return nil, err
+	}
+
+	return x.iter.Next()
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+var (
+	_ storagev1.StorageObjectHandle        = &StorageObjectHandle{}
+	_ storagev1.StorageObjectHandleWrapper = &StorageObjectHandle{}
+)
+
+// StorageObjectHandle represents a storage object handle that injects
+// failures into the calls of another handle.
+type StorageObjectHandle struct {
+	handle storagev1.StorageObjectHandle
+	config *config
+	bucket string
+	name   string
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config, bucket: x.bucket, object: x.name}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.config.fault(MethodObjectAttrs, x.bucket, x.name).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.Attrs(ctx)
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(srcs ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	handles := make([]storagev1.StorageObjectHandle, len(srcs))
+	for i, src := range srcs {
+		handles[i] = unwrap(src)
+	}
+
+	return &StorageObjectComposer{composer: x.handle.ComposerFrom(handles...), handle: x}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(src storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	return &StorageObjectCopier{copier: x.handle.CopierFrom(unwrap(src)), handle: x}
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	if err := x.config.fault(MethodObjectDelete, x.bucket, x.name).inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.Delete(ctx)
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Generation(gen)
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(conds storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(key []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Key(key)
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset, length int64) (storagev1.StorageObjectReader, error) {
+	if err := x.config.fault(MethodObjectOpen, x.bucket, x.name).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	reader, err := x.handle.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageObjectReader{
+		ctx:    ctx,
+		reader: reader,
+		fault:  x.config.fault(MethodObjectRead, x.bucket, x.name),
+	}, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	return &StorageObjectWriter{
+		ctx:    ctx,
+		handle: x,
+		writer: x.handle.NewWriter(ctx),
+		fault:  x.config.fault(MethodObjectWrite, x.bucket, x.name),
+	}
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.ReadCompressed(compressed)
+	return &handle
+}
+
+// Unwrap implements storagev1.StorageObjectHandleWrapper.
+func (x *StorageObjectHandle) Unwrap() storagev1.StorageObjectHandle {
+	return x.handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, uattrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	if err := x.config.fault(MethodObjectUpdate, x.bucket, x.name).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.Update(ctx, uattrs)
+}
+
+// unwrap returns the decorated handle of a StorageObjectHandle, so that the
+// backends can recognize their own handles.
+func unwrap(handle storagev1.StorageObjectHandle) storagev1.StorageObjectHandle {
+	if v, ok := handle.(*StorageObjectHandle); ok {
+		return v.handle
+	}
+
+	return handle
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents a storage object reader whose content is
+// truncated by a fault.
+type StorageObjectReader struct {
+	ctx    context.Context
+	reader storagev1.StorageObjectReader
+	fault  *Fault
+	read   int64
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.reader.CacheControl()
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	return x.reader.Close()
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.reader.ContentEncoding()
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.reader.ContentType()
+}
+
+// Generation returns the generation of the object.
+func (x *StorageObjectReader) Generation() int64 {
+	return storagev1.StorageObjectReaderGeneration(x.reader)
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	if x.fault == nil {
+		return x.reader.Read(p)
+	}
+
+	remain := x.fault.Offset - x.read
+	// the content is truncated at the offset
+	if remain <= 0 {
+		if err := x.fault.inject(x.ctx); err != nil {
+			return 0, err
+		}
+
+		return 0, io.EOF
+	}
+
+	if int64(len(p)) > remain {
+		p = p[:remain]
+	}
+
+	n, err := x.reader.Read(p)
+	x.read += int64(n)
+	return n, err
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return x.reader.Remain()
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.reader.Size()
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents a storage object writer whose content is cut
+// short and whose Close call fails by a fault.
+type StorageObjectWriter struct {
+	ctx     context.Context
+	handle  *StorageObjectHandle
+	writer  storagev1.StorageObjectWriter
+	fault   *Fault
+	written int64
+	err     error
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.writer.Attrs()
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	if x.err == nil {
+		x.err = x.handle.config.fault(MethodObjectClose, x.handle.bucket, x.handle.name).inject(x.ctx)
+	}
+
+	// the content is discarded
+	if x.err != nil {
+		x.writer.CloseWithError(x.err)
+		return x.err
+	}
+
+	return x.writer.Close()
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	return x.writer.CloseWithError(err)
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return x.writer.ObjectAttrs()
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.writer.SetCRC32C(v)
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.writer.SetChunkSize(v)
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.writer.SetContentType(v)
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.writer.SetProgressFunc(fn)
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	if x.err != nil {
+		return 0, x.err
+	}
+
+	if x.fault == nil || x.written+int64(len(p)) <= x.fault.Offset {
+		n, err := x.writer.Write(p)
+		x.written += int64(n)
+		return n, err
+	}
+
+	// the content is cut short at the offset
+	n, err := x.writer.Write(p[:max(x.fault.Offset-x.written, 0)])
+	x.written += int64(n)
+	if err != nil {
+		return n, err
+	}
+
+	if err := x.fault.inject(x.ctx); err != nil {
+		x.err = err
+	} else {
+		x.err = io.ErrShortWrite
+	}
+
+	return n, x.err
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents a storage object copier that injects
+// failures into its runs.
+type StorageObjectCopier struct {
+	copier storagev1.StorageObjectCopier
+	handle *StorageObjectHandle
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return x.copier.ObjectAttrs()
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.handle.config.fault(MethodObjectCopy, x.handle.bucket, x.handle.name).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.copier.Run(ctx)
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(v string) {
+	x.copier.SetDestinationKMSKeyName(v)
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.copier.SetProgressFunc(fn)
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(v string) {
+	x.copier.SetRewriteToken(v)
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents a storage object composer that injects
+// failures into its runs.
+type StorageObjectComposer struct {
+	composer storagev1.StorageObjectComposer
+	handle   *StorageObjectHandle
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return x.composer.ObjectAttrs()
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.handle.config.fault(MethodObjectCompose, x.handle.bucket, x.handle.name).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.composer.Run(ctx)
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+// StorageACLHandle represents a storage ACL handle that injects failures into
+// the calls of another handle.
+type StorageACLHandle struct {
+	handle storagev1.StorageACLHandle
+	config *config
+	bucket string
+	object string
+}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	if err := x.config.fault(MethodACL, x.bucket, x.object).inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.Delete(ctx, entity)
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	if err := x.config.fault(MethodACL, x.bucket, x.object).inject(ctx); err != nil {
+		return nil, err
+	}
+
+	return x.handle.List(ctx)
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	if err := x.config.fault(MethodACL, x.bucket, x.object).inject(ctx); err != nil {
+		return err
+	}
+
+	return x.handle.Set(ctx, entity, role)
+}
@@ -0,0 +1,188 @@
+package storagev1chaos_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1chaos"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+// newClient returns a chaos client on top of a memory backend with a bucket.
+func newClient(t *testing.T) (*storagev1chaos.StorageServiceClient, storagev1.StorageServiceClient) {
+	t.Helper()
+
+	backend := storagev1mem.NewStorageServiceClient()
+	if err := backend.Bucket("bucket").Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	return storagev1chaos.NewStorageServiceClient(backend), backend
+}
+
+func writeObject(t *testing.T, handle storagev1.StorageObjectHandle, data string) {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	cases := []struct {
+		name     string
+		schedule func(int) bool
+		want     []bool
+	}{
+		{"Always", storagev1chaos.Always(), []bool{true, true, true, true}},
+		{"Calls", storagev1chaos.Calls(2, 4), []bool{false, true, false, true}},
+		{"Every", storagev1chaos.Every(2), []bool{false, true, false, true}},
+		{"EveryZero", storagev1chaos.Every(0), []bool{false, false, false, false}},
+		{"First", storagev1chaos.First(2), []bool{true, true, false, false}},
+		{"After", storagev1chaos.After(2), []bool{false, false, true, true}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			for index, want := range tc.want {
+				if got := tc.schedule(index + 1); got != want {
+					t.Errorf("%s(%d) = %v, want %v", tc.name, index+1, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRuleSelection(t *testing.T) {
+	fault := storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)}
+
+	cases := []struct {
+		name string
+		rule storagev1chaos.Rule
+		want []bool
+	}{
+		{
+			name: "ZeroProbability",
+			rule: storagev1chaos.Rule{Fault: fault},
+			want: []bool{false, false, false},
+		},
+		{
+			name: "FullProbability",
+			rule: storagev1chaos.Rule{Probability: 1, Fault: fault},
+			want: []bool{true, true, true},
+		},
+		{
+			name: "Always",
+			rule: storagev1chaos.Rule{Schedule: storagev1chaos.Always(), Fault: fault},
+			want: []bool{true, true, true},
+		},
+		{
+			name: "Schedule",
+			rule: storagev1chaos.Rule{Schedule: storagev1chaos.Calls(2), Fault: fault},
+			want: []bool{false, true, false},
+		},
+		{
+			name: "OtherMethod",
+			rule: storagev1chaos.Rule{Methods: []storagev1chaos.Method{storagev1chaos.MethodObjectDelete}, Schedule: storagev1chaos.Always(), Fault: fault},
+			want: []bool{false, false, false},
+		},
+		{
+			name: "OtherBucket",
+			rule: storagev1chaos.Rule{Bucket: "other", Schedule: storagev1chaos.Always(), Fault: fault},
+			want: []bool{false, false, false},
+		},
+		{
+			name: "OtherPrefix",
+			rule: storagev1chaos.Rule{Prefix: "logs/", Schedule: storagev1chaos.Always(), Fault: fault},
+			want: []bool{false, false, false},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client, backend := newClient(t)
+			writeObject(t, backend.Bucket("bucket").Object("a"), "hello")
+			client.AddRule(tc.rule)
+
+			for index, want := range tc.want {
+				_, err := client.Bucket("bucket").Object("a").Attrs(context.Background())
+				if got := err != nil; got != want {
+					t.Errorf("Attrs call %d error = %v, want fault %v", index+1, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestClearRules(t *testing.T) {
+	client, backend := newClient(t)
+	writeObject(t, backend.Bucket("bucket").Object("a"), "hello")
+
+	client.AddRule(storagev1chaos.Rule{Schedule: storagev1chaos.Always(), Fault: storagev1chaos.Fault{Err: storagev1chaos.ErrPreconditionFailed}})
+	if _, err := client.Bucket("bucket").Object("a").Attrs(context.Background()); !errors.Is(err, storagev1chaos.ErrPreconditionFailed) {
+		t.Fatalf("Attrs() error = %v, want %v", err, storagev1chaos.ErrPreconditionFailed)
+	}
+
+	client.ClearRules()
+	if _, err := client.Bucket("bucket").Object("a").Attrs(context.Background()); err != nil {
+		t.Errorf("Attrs() after ClearRules error = %v, want nil", err)
+	}
+}
+
+func TestFaultRead(t *testing.T) {
+	client, backend := newClient(t)
+	writeObject(t, backend.Bucket("bucket").Object("a"), "hello world")
+
+	client.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+		Schedule: storagev1chaos.Always(),
+		Fault:    storagev1chaos.Fault{Err: io.ErrUnexpectedEOF, Offset: 5},
+	})
+
+	reader, err := client.Bucket("bucket").Object("a").NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("ReadAll() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+
+	if string(data) != "hello" {
+		t.Errorf("ReadAll() = %q, want %q", data, "hello")
+	}
+}
+
+func TestFaultWrite(t *testing.T) {
+	client, backend := newClient(t)
+
+	client.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectWrite},
+		Schedule: storagev1chaos.Always(),
+		Fault:    storagev1chaos.Fault{Offset: 5},
+	})
+
+	writer := client.Bucket("bucket").Object("a").NewWriter(context.Background())
+	if _, err := io.WriteString(writer, "hello world"); !errors.Is(err, io.ErrShortWrite) {
+		t.Errorf("Write() error = %v, want %v", err, io.ErrShortWrite)
+	}
+
+	if err := writer.Close(); err == nil {
+		t.Errorf("Close() error = nil, want an error")
+	}
+
+	if _, err := backend.Bucket("bucket").Object("a").Attrs(context.Background()); err == nil {
+		t.Errorf("Attrs() error = nil, want the object not to exist")
+	}
+}
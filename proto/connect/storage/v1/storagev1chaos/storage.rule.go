@@ -0,0 +1,232 @@
+package storagev1chaos
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// Method identifies the call of a storage handle that a rule applies to.
+type Method string
+
+const (
+	// MethodBucketList is the Next call of a bucket iterator.
+	MethodBucketList Method = "bucket.list"
+	// MethodBucketAttrs is the Attrs call of a bucket handle.
+	MethodBucketAttrs Method = "bucket.attrs"
+	// MethodBucketCreate is the Create call of a bucket handle.
+	MethodBucketCreate Method = "bucket.create"
+	// MethodBucketUpdate is the Update call of a bucket handle.
+	MethodBucketUpdate Method = "bucket.update"
+	// MethodBucketDelete is the Delete call of a bucket handle.
+	MethodBucketDelete Method = "bucket.delete"
+	// MethodBucketLock is the LockRetentionPolicy call of a bucket handle.
+	MethodBucketLock Method = "bucket.lock"
+	// MethodNotification is a notification call of a bucket handle.
+	MethodNotification Method = "notification"
+	// MethodACL is a call of an ACL handle.
+	MethodACL Method = "acl"
+	// MethodObjectList is the Next call of an object iterator.
+	MethodObjectList Method = "object.list"
+	// MethodObjectAttrs is the Attrs call of an object handle.
+	MethodObjectAttrs Method = "object.attrs"
+	// MethodObjectUpdate is the Update call of an object handle.
+	MethodObjectUpdate Method = "object.update"
+	// MethodObjectDelete is the Delete call of an object handle.
+	MethodObjectDelete Method = "object.delete"
+	// MethodObjectOpen is the NewReader or NewRangeReader call of an object
+	// handle.
+	MethodObjectOpen Method = "object.open"
+	// MethodObjectRead is the content of a reader. The rule is matched once
+	// per reader, when it is opened, and its fault truncates the content at
+	// Fault.Offset.
+	MethodObjectRead Method = "object.read"
+	// MethodObjectWrite is the content of a writer. The rule is matched once
+	// per writer, when it is created, and its fault cuts the content short at
+	// Fault.Offset.
+	MethodObjectWrite Method = "object.write"
+	// MethodObjectClose is the Close call of a writer, which discards the
+	// content when it fails.
+	MethodObjectClose Method = "object.close"
+	// MethodObjectCopy is the Run call of a copier.
+	MethodObjectCopy Method = "object.copy"
+	// MethodObjectCompose is the Run call of a composer.
+	MethodObjectCompose Method = "object.compose"
+)
+
+// ErrPreconditionFailed is the error of a failed generation or metageneration
+// precondition.
+var ErrPreconditionFailed = StatusError(http.StatusPreconditionFailed)
+
+// StatusError returns the googleapi.Error of the given HTTP status, as the
+// backends return it.
+func StatusError(code int) error {
+	return &googleapi.Error{Code: code, Message: http.StatusText(code)}
+}
+
+// Fault describes a failure that is injected into a call.
+type Fault struct {
+	// Latency delays the call. The delay ends early with the error of the
+	// context when the context is done.
+	Latency time.Duration
+	// Err is the error of the call.
+	//
+	// The content of a reader ends with Err at Offset, or with io.EOF when Err
+	// is nil. The content of a writer is cut short at Offset with Err, or with
+	// io.ErrShortWrite when Err is nil, and the writer fails to close.
+	Err error
+	// Offset is the byte offset at which the content of a reader or a writer
+	// fails. It is ignored by the other methods.
+	Offset int64
+}
+
+// inject delays the call and returns the error of the fault.
+func (x *Fault) inject(ctx context.Context) error {
+	if x == nil {
+		return nil
+	}
+
+	if x.Latency > 0 {
+		timer := time.NewTimer(x.Latency)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return x.Err
+}
+
+// Rule selects the calls that a fault is injected into.
+//
+// A rule matches the calls of its methods on its bucket and on the objects
+// that have its prefix. A matching call is selected by the schedule when it is
+// set, by the probability otherwise. The zero probability selects no call, so
+// that a rule that selects every matching call sets the Always schedule.
+type Rule struct {
+	// Methods are the methods that the rule matches. No methods match every
+	// method.
+	Methods []Method
+	// Bucket is the bucket that the rule matches. An empty bucket matches
+	// every bucket.
+	Bucket string
+	// Prefix is the object prefix that the rule matches. A rule that has a
+	// prefix matches the calls on the objects and the listings whose prefix
+	// has the rule prefix only.
+	Prefix string
+	// Probability is the probability, from 0 to 1, that a matching call is
+	// selected. Zero selects no call.
+	Probability float64
+	// Schedule reports whether the n-th matching call, starting from 1, is
+	// selected.
+	Schedule func(n int) bool
+	// Fault is the fault that is injected into the selected calls.
+	Fault Fault
+}
+
+// Always returns a schedule that selects every call.
+func Always() func(int) bool {
+	return func(int) bool {
+		return true
+	}
+}
+
+// Calls returns a schedule that selects the given calls, starting from 1.
+func Calls(n ...int) func(int) bool {
+	return func(call int) bool {
+		return slices.Contains(n, call)
+	}
+}
+
+// Every returns a schedule that selects every n-th call.
+func Every(n int) func(int) bool {
+	return func(call int) bool {
+		return n > 0 && call%n == 0
+	}
+}
+
+// First returns a schedule that selects the first n calls.
+func First(n int) func(int) bool {
+	return func(call int) bool {
+		return call <= n
+	}
+}
+
+// After returns a schedule that selects every call after the first n calls.
+func After(n int) func(int) bool {
+	return func(call int) bool {
+		return call > n
+	}
+}
+
+// rule is a rule with its count of the matching calls.
+type rule struct {
+	Rule
+	calls int
+}
+
+// match reports whether the rule matches a call. The object is empty for the
+// calls on a bucket.
+func (x *rule) match(method Method, bucket, object string) bool {
+	if len(x.Methods) > 0 && !slices.Contains(x.Methods, method) {
+		return false
+	}
+
+	if x.Bucket != "" && x.Bucket != bucket {
+		return false
+	}
+
+	if x.Prefix != "" && !strings.HasPrefix(object, x.Prefix) {
+		return false
+	}
+
+	return true
+}
+
+// config holds the rules shared by a client and its handles.
+type config struct {
+	mu    sync.Mutex
+	rules []*rule
+	rand  *rand.Rand
+}
+
+// fault returns the fault of the first rule that selects a call, or nil. Every
+// matching rule counts the call.
+func (x *config) fault(method Method, bucket, object string) *Fault {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	var fault *Fault
+
+	for _, r := range x.rules {
+		if !r.match(method, bucket, object) {
+			continue
+		}
+
+		r.calls++
+
+		var selected bool
+		switch {
+		case r.Schedule != nil:
+			selected = r.Schedule(r.calls)
+		case r.Probability > 0:
+			selected = x.rand.Float64() < r.Probability
+		}
+
+		if selected && fault == nil {
+			v := r.Fault
+			fault = &v
+		}
+	}
+
+	return fault
+}
@@ -0,0 +1,1059 @@
+// Package storagev1emu serves the Cloud Storage JSON and XML APIs on top of
+// any storagev1.StorageServiceClient, so that clients built with the
+// official SDK can be pointed at a local backend:
+//
+//	server := httptest.NewServer(storagev1emu.NewStorageEmulator(storagev1mem.NewStorageServiceClient()))
+//	defer server.Close()
+//
+//	client, err := storagev1sdk.NewStorageServiceClient(ctx,
+//		option.WithEndpoint(server.URL+"/storage/v1/"),
+//		option.WithoutAuthentication(),
+//	)
+package storagev1emu
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+	raw "google.golang.org/api/storage/v1"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// DefaultMaxResults is the page size used when a list request does not set maxResults.
+const DefaultMaxResults = 1000
+
+var _ http.Handler = &StorageEmulator{}
+
+// StorageEmulator serves the Cloud Storage JSON API (/storage/v1/...), the
+// upload and download endpoints (/upload/storage/v1/..., /download/storage/v1/...)
+// and the XML API object endpoints (/{bucket}/{object}) from a storage service client.
+type StorageEmulator struct {
+	client  storagev1.StorageServiceClient
+	mu      sync.Mutex
+	uploads map[string]*upload
+}
+
+// NewStorageEmulator creates a new StorageEmulator for the given client.
+func NewStorageEmulator(client storagev1.StorageServiceClient) *StorageEmulator {
+	return &StorageEmulator{
+		client:  client,
+		uploads: make(map[string]*upload),
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (x *StorageEmulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path, err := split(r.URL.EscapedPath())
+	if err != nil {
+		writeError(w, newError(http.StatusBadRequest, "Invalid path: %v", err))
+		return
+	}
+
+	switch {
+	case match(path, "storage", "v1"):
+		x.serveJSON(w, r, path[2:])
+	case match(path, "upload", "storage", "v1", "b", "*", "o"):
+		x.serveUpload(w, r, path[4])
+	case match(path, "download", "storage", "v1", "b", "*", "o", "*"):
+		x.serveDownload(w, r, path[4], path[6])
+	case len(path) >= 2 && path[0] != "":
+		x.serveXML(w, r, path[0], strings.Join(path[1:], "/"))
+	default:
+		writeError(w, newError(http.StatusNotFound, "Not Found"))
+	}
+}
+
+func (x *StorageEmulator) serveJSON(w http.ResponseWriter, r *http.Request, path []string) {
+	switch {
+	case len(path) == 1 && path[0] == "b":
+		switch r.Method {
+		case http.MethodGet:
+			x.listBuckets(w, r)
+		case http.MethodPost:
+			x.insertBucket(w, r)
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case len(path) == 2 && path[0] == "b":
+		switch r.Method {
+		case http.MethodGet:
+			x.getBucket(w, r, path[1])
+		case http.MethodPatch, http.MethodPut:
+			x.patchBucket(w, r, path[1])
+		case http.MethodDelete:
+			x.deleteBucket(w, r, path[1])
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case match(path, "b", "*", "lockRetentionPolicy") && r.Method == http.MethodPost:
+		x.lockRetentionPolicy(w, r, path[1])
+	case match(path, "b", "*", "acl") && len(path) <= 4:
+		x.serveACL(w, r, x.bucket(r, path[1]).ACL(), path[3:], bucketACL)
+	case match(path, "b", "*", "defaultObjectAcl") && len(path) <= 4:
+		x.serveACL(w, r, x.bucket(r, path[1]).DefaultObjectACL(), path[3:], objectACL)
+	case match(path, "b", "*", "notificationConfigs") && len(path) <= 4:
+		x.serveNotifications(w, r, path[1], path[3:])
+	case len(path) == 3 && match(path, "b", "*", "o"):
+		switch r.Method {
+		case http.MethodGet:
+			x.listObjects(w, r, path[1])
+		case http.MethodPost:
+			x.insertObject(w, r, path[1])
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case len(path) == 4 && match(path, "b", "*", "o", "*"):
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("alt") == "media" {
+				x.serveDownload(w, r, path[1], path[3])
+			} else {
+				x.getObject(w, r, path[1], path[3])
+			}
+		case http.MethodPatch, http.MethodPut:
+			x.patchObject(w, r, path[1], path[3])
+		case http.MethodDelete:
+			x.deleteObject(w, r, path[1], path[3])
+		default:
+			writeMethodNotAllowed(w)
+		}
+	case match(path, "b", "*", "o", "*", "acl") && len(path) <= 6:
+		handle, err := x.object(r, path[1], path[3])
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		x.serveACL(w, r, handle.ACL(), path[5:], objectACL)
+	case len(path) == 5 && match(path, "b", "*", "o", "*", "compose") && r.Method == http.MethodPost:
+		x.composeObject(w, r, path[1], path[3])
+	case len(path) == 9 && match(path, "b", "*", "o", "*", "rewriteTo", "b", "*", "o", "*") && r.Method == http.MethodPost:
+		x.rewriteObject(w, r, path[1], path[3], path[6], path[8], true)
+	case len(path) == 9 && match(path, "b", "*", "o", "*", "copyTo", "b", "*", "o", "*") && r.Method == http.MethodPost:
+		x.rewriteObject(w, r, path[1], path[3], path[6], path[8], false)
+	default:
+		writeError(w, newError(http.StatusNotFound, "Not Found"))
+	}
+}
+
+func (x *StorageEmulator) listBuckets(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	project := query.Get("project")
+	if project == "" {
+		writeError(w, newError(http.StatusBadRequest, "Required parameter: project"))
+		return
+	}
+
+	size, err := pageSize(query)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var token string
+	// resume after the last bucket of the previous page
+	if value := query.Get("pageToken"); value != "" {
+		if token, _, err = decodeToken(value); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	response := &raw.Buckets{Kind: "storage#buckets"}
+	// collect the buckets
+	iter := x.client.Buckets(r.Context(), project)
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if attrs.Name <= token || !strings.HasPrefix(attrs.Name, query.Get("prefix")) {
+			continue
+		}
+
+		if len(response.Items) == size {
+			response.NextPageToken = encodeToken(response.Items[size-1].Name, 0)
+			break
+		}
+
+		response.Items = append(response.Items, toRawBucket(baseURL(r), attrs))
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (x *StorageEmulator) insertBucket(w http.ResponseWriter, r *http.Request) {
+	project := r.URL.Query().Get("project")
+	if project == "" {
+		writeError(w, newError(http.StatusBadRequest, "Required parameter: project"))
+		return
+	}
+
+	body := &raw.Bucket{}
+	// decode the body
+	if _, err := decode(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := fromRawBucket(body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	handle := x.bucket(r, body.Name)
+	// create the bucket
+	if err := handle.Create(r.Context(), project, attrs); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if attrs, err = handle.Attrs(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawBucket(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) getBucket(w http.ResponseWriter, r *http.Request, name string) {
+	handle, err := x.bucketIf(r, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := handle.Attrs(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawBucket(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) patchBucket(w http.ResponseWriter, r *http.Request, name string) {
+	handle, err := x.bucketIf(r, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body := &raw.Bucket{}
+	// decode the body
+	fields, err := decode(r, body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	update, err := toBucketAttrsToUpdate(body, fields)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := handle.Update(r.Context(), update)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawBucket(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) deleteBucket(w http.ResponseWriter, r *http.Request, name string) {
+	handle, err := x.bucketIf(r, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := handle.Delete(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (x *StorageEmulator) lockRetentionPolicy(w http.ResponseWriter, r *http.Request, name string) {
+	handle, err := x.bucketIf(r, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := handle.LockRetentionPolicy(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := x.bucket(r, name).Attrs(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawBucket(baseURL(r), attrs))
+}
+
+type aclKind int
+
+const (
+	bucketACL aclKind = iota
+	objectACL
+)
+
+// String returns the JSON API kind of an access control.
+func (x aclKind) String() string {
+	if x == bucketACL {
+		return "storage#bucketAccessControl"
+	}
+
+	return "storage#objectAccessControl"
+}
+
+func (x *StorageEmulator) serveACL(w http.ResponseWriter, r *http.Request, handle storagev1.StorageACLHandle, path []string, kind aclKind) {
+	// render converts the rules to the JSON API representation of the kind.
+	render := func(rules []storage.ACLRule) []any {
+		var collection []any
+		// convert the rules
+		if kind == bucketACL {
+			for _, item := range toRawBucketACL(rules) {
+				collection = append(collection, item)
+			}
+		} else {
+			for _, item := range toRawObjectACL(rules) {
+				collection = append(collection, item)
+			}
+		}
+
+		return collection
+	}
+
+	var entity storage.ACLEntity
+	// the entity is part of the path for everything but list and insert
+	if len(path) == 1 {
+		entity = storage.ACLEntity(path[0])
+	}
+
+	switch {
+	case r.Method == http.MethodGet:
+		rules, err := handle.List(r.Context())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if entity == "" {
+			writeJSON(w, http.StatusOK, map[string]any{"kind": kind.String() + "s", "items": render(rules)})
+			return
+		}
+
+		for _, rule := range rules {
+			if rule.Entity == entity {
+				writeJSON(w, http.StatusOK, render([]storage.ACLRule{rule})[0])
+				return
+			}
+		}
+
+		writeError(w, newError(http.StatusNotFound, "Not Found"))
+	case r.Method == http.MethodDelete && entity != "":
+		if err := handle.Delete(r.Context(), entity); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && entity == "", (r.Method == http.MethodPut || r.Method == http.MethodPatch) && entity != "":
+		body := &raw.ObjectAccessControl{}
+		// decode the body
+		if _, err := decode(r, body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if entity == "" {
+			entity = storage.ACLEntity(body.Entity)
+		}
+
+		if entity == "" || body.Role == "" {
+			writeError(w, newError(http.StatusBadRequest, "Required: entity and role"))
+			return
+		}
+
+		if err := handle.Set(r.Context(), entity, storage.ACLRole(body.Role)); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		rule := storage.ACLRule{Entity: entity, Role: storage.ACLRole(body.Role)}
+		// done!
+		writeJSON(w, http.StatusOK, render([]storage.ACLRule{rule})[0])
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (x *StorageEmulator) serveNotifications(w http.ResponseWriter, r *http.Request, bucket string, path []string) {
+	handle := x.bucket(r, bucket)
+
+	switch {
+	case r.Method == http.MethodGet:
+		collection, err := handle.Notifications(r.Context())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if len(path) == 1 {
+			n, ok := collection[path[0]]
+			if !ok {
+				writeError(w, newError(http.StatusNotFound, "Not Found"))
+				return
+			}
+
+			writeJSON(w, http.StatusOK, toRawNotification(baseURL(r), bucket, n))
+			return
+		}
+
+		response := &raw.Notifications{Kind: "storage#notifications"}
+		// collect the notifications
+		for _, n := range collection {
+			response.Items = append(response.Items, toRawNotification(baseURL(r), bucket, n))
+		}
+
+		writeJSON(w, http.StatusOK, response)
+	case r.Method == http.MethodPost && len(path) == 0:
+		body := &raw.Notification{}
+		// decode the body
+		if _, err := decode(r, body); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		n, err := fromRawNotification(body)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		if n, err = handle.AddNotification(r.Context(), n); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, toRawNotification(baseURL(r), bucket, n))
+	case r.Method == http.MethodDelete && len(path) == 1:
+		if err := handle.DeleteNotification(r.Context(), path[0]); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeMethodNotAllowed(w)
+	}
+}
+
+func (x *StorageEmulator) listObjects(w http.ResponseWriter, r *http.Request, bucket string) {
+	params := r.URL.Query()
+
+	size, err := pageSize(params)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	query := &storage.Query{
+		Prefix:                   params.Get("prefix"),
+		Delimiter:                params.Get("delimiter"),
+		StartOffset:              params.Get("startOffset"),
+		EndOffset:                params.Get("endOffset"),
+		MatchGlob:                params.Get("matchGlob"),
+		Versions:                 params.Get("versions") == "true",
+		IncludeTrailingDelimiter: params.Get("includeTrailingDelimiter") == "true",
+		IncludeFoldersAsPrefixes: params.Get("includeFoldersAsPrefixes") == "true",
+		SoftDeleted:              params.Get("softDeleted") == "true",
+	}
+
+	var (
+		token      string
+		generation = int64(-1)
+	)
+	// resume after the last item of the previous page
+	if value := params.Get("pageToken"); value != "" {
+		if token, generation, err = decodeToken(value); err != nil {
+			writeError(w, err)
+			return
+		}
+
+		query.StartOffset = max(query.StartOffset, token)
+	}
+
+	response := &raw.Objects{Kind: "storage#objects"}
+	// collect the objects
+	iter := x.bucket(r, bucket).Objects(r.Context(), query)
+	for count := 0; ; {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		name, gen := attrs.Name, attrs.Generation
+		if attrs.Prefix != "" {
+			name, gen = attrs.Prefix, 0
+		}
+
+		if name < token || (name == token && gen <= generation) {
+			continue
+		}
+
+		// more items follow, so the token points at the last item of the page
+		if count == size {
+			response.NextPageToken = encodeToken(token, generation)
+			break
+		}
+
+		if attrs.Prefix != "" {
+			response.Prefixes = append(response.Prefixes, attrs.Prefix)
+		} else {
+			response.Items = append(response.Items, toRawObject(baseURL(r), attrs))
+		}
+
+		token, generation = name, gen
+		count++
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (x *StorageEmulator) getObject(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := handle.Attrs(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawObject(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) insertObject(w http.ResponseWriter, r *http.Request, bucket string) {
+	body := &raw.Object{}
+	// decode the body
+	if _, err := decode(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	x.write(w, r, bucket, body, "", http.NoBody)
+}
+
+func (x *StorageEmulator) patchObject(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body := &raw.Object{}
+	// decode the body
+	fields, err := decode(r, body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	update, err := toObjectAttrsToUpdate(body, fields)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := handle.Update(r.Context(), update)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawObject(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) deleteObject(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := handle.Delete(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (x *StorageEmulator) composeObject(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body := &raw.ComposeRequest{}
+	// decode the body
+	if _, err := decode(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var handles []storagev1.StorageObjectHandle
+	// prepare the sources
+	for _, source := range body.SourceObjects {
+		src := x.bucket(r, bucket).Object(source.Name)
+		// apply the generation
+		if source.Generation != 0 {
+			src = src.Generation(source.Generation)
+		}
+
+		if cond := source.ObjectPreconditions; cond != nil && cond.IfGenerationMatch != 0 {
+			src = src.If(storage.Conditions{GenerationMatch: cond.IfGenerationMatch})
+		}
+
+		handles = append(handles, src)
+	}
+
+	composer := handle.ComposerFrom(handles...)
+
+	if body.Destination != nil {
+		attrs, err := fromRawObject(body.Destination)
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		*composer.ObjectAttrs() = *attrs
+	}
+
+	if kms := r.URL.Query().Get("kmsKeyName"); kms != "" {
+		composer.ObjectAttrs().KMSKeyName = kms
+	}
+
+	attrs, err := composer.Run(r.Context())
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawObject(baseURL(r), attrs))
+}
+
+func (x *StorageEmulator) rewriteObject(w http.ResponseWriter, r *http.Request, srcBucket, srcName, dstBucket, dstName string, rewrite bool) {
+	dst, err := x.object(r, dstBucket, dstName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	src, err := x.source(r, srcBucket, srcName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	body := &raw.Object{}
+	// decode the body
+	if _, err := decode(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	attrs, err := fromRawObject(body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	copier := dst.CopierFrom(src)
+	// prepare the destination
+	*copier.ObjectAttrs() = *attrs
+
+	if kms := r.URL.Query().Get("destinationKmsKeyName"); kms != "" {
+		copier.SetDestinationKMSKeyName(kms)
+	}
+
+	if attrs, err = copier.Run(r.Context()); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if !rewrite {
+		writeJSON(w, http.StatusOK, toRawObject(baseURL(r), attrs))
+		return
+	}
+
+	response := &raw.RewriteResponse{
+		Kind:                "storage#rewriteResponse",
+		Done:                true,
+		ObjectSize:          attrs.Size,
+		TotalBytesRewritten: attrs.Size,
+		Resource:            toRawObject(baseURL(r), attrs),
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// bucket returns the bucket handle for a request.
+func (x *StorageEmulator) bucket(r *http.Request, name string) storagev1.StorageBucketHandle {
+	handle := x.client.Bucket(name)
+	// bill the requester
+	if project := r.URL.Query().Get("userProject"); project != "" {
+		handle = handle.UserProject(project)
+	}
+
+	return handle
+}
+
+// bucketIf returns the bucket handle for a request with the preconditions of the request.
+func (x *StorageEmulator) bucketIf(r *http.Request, name string) (storagev1.StorageBucketHandle, error) {
+	query := r.URL.Query()
+
+	var (
+		cond storage.BucketConditions
+		err  error
+	)
+
+	if cond.MetagenerationMatch, err = parseInt(query, "ifMetagenerationMatch"); err != nil {
+		return nil, err
+	}
+
+	if cond.MetagenerationNotMatch, err = parseInt(query, "ifMetagenerationNotMatch"); err != nil {
+		return nil, err
+	}
+
+	handle := x.bucket(r, name)
+	// apply the preconditions
+	if cond != (storage.BucketConditions{}) || query.Has("ifMetagenerationMatch") {
+		handle = handle.If(cond)
+	}
+
+	return handle, nil
+}
+
+// object returns the object handle for a request with the generation, the
+// preconditions and the encryption key of the request.
+func (x *StorageEmulator) object(r *http.Request, bucket, name string) (storagev1.StorageObjectHandle, error) {
+	query := r.URL.Query()
+
+	handle := x.bucket(r, bucket).Object(name)
+	// apply the generation
+	if query.Has("generation") {
+		generation, err := parseInt(query, "generation")
+		if err != nil {
+			return nil, err
+		}
+
+		handle = handle.Generation(generation)
+	}
+
+	cond, ok, err := conditions(query, "if")
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		handle = handle.If(cond)
+	}
+
+	key, err := encryptionKey(r.Header, "x-goog-encryption-key")
+	if err != nil {
+		return nil, err
+	}
+
+	if key != nil {
+		handle = handle.Key(key)
+	}
+
+	return handle, nil
+}
+
+// source returns the source object handle of a rewrite request.
+func (x *StorageEmulator) source(r *http.Request, bucket, name string) (storagev1.StorageObjectHandle, error) {
+	query := r.URL.Query()
+
+	handle := x.bucket(r, bucket).Object(name)
+	// apply the generation
+	if query.Has("sourceGeneration") {
+		generation, err := parseInt(query, "sourceGeneration")
+		if err != nil {
+			return nil, err
+		}
+
+		handle = handle.Generation(generation)
+	}
+
+	cond, ok, err := conditions(query, "ifSource")
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		handle = handle.If(cond)
+	}
+
+	key, err := encryptionKey(r.Header, "x-goog-copy-source-encryption-key")
+	if err != nil {
+		return nil, err
+	}
+
+	if key != nil {
+		handle = handle.Key(key)
+	}
+
+	return handle, nil
+}
+
+// conditions parses the object preconditions with the given parameter prefix.
+func conditions(query url.Values, prefix string) (storage.Conditions, bool, error) {
+	var (
+		cond storage.Conditions
+		err  error
+	)
+
+	if query.Has(prefix + "GenerationMatch") {
+		if cond.GenerationMatch, err = parseInt(query, prefix+"GenerationMatch"); err != nil {
+			return cond, false, err
+		}
+		// a zero generation means that the object must not exist
+		if cond.GenerationMatch == 0 {
+			cond.DoesNotExist = true
+		}
+	}
+
+	if cond.GenerationNotMatch, err = parseInt(query, prefix+"GenerationNotMatch"); err != nil {
+		return cond, false, err
+	}
+
+	if cond.MetagenerationMatch, err = parseInt(query, prefix+"MetagenerationMatch"); err != nil {
+		return cond, false, err
+	}
+
+	if cond.MetagenerationNotMatch, err = parseInt(query, prefix+"MetagenerationNotMatch"); err != nil {
+		return cond, false, err
+	}
+
+	return cond, cond != (storage.Conditions{}), nil
+}
+
+// encryptionKey parses a customer-supplied encryption key header.
+func encryptionKey(header http.Header, name string) ([]byte, error) {
+	value := header.Get(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, newError(http.StatusBadRequest, "Invalid %s header.", name)
+	}
+
+	return key, nil
+}
+
+func parseInt(query url.Values, name string) (int64, error) {
+	value := query.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, newError(http.StatusBadRequest, "Invalid value for %s: %q", name, value)
+	}
+
+	return result, nil
+}
+
+// pageSize parses the page size of a list request.
+func pageSize(query url.Values) (int, error) {
+	value := query.Get("maxResults")
+	if value == "" {
+		return DefaultMaxResults, nil
+	}
+
+	size, err := strconv.Atoi(value)
+	if err != nil || size < 0 {
+		return 0, newError(http.StatusBadRequest, "Invalid value for maxResults: %q", value)
+	}
+
+	if size == 0 {
+		return DefaultMaxResults, nil
+	}
+
+	return min(size, DefaultMaxResults), nil
+}
+
+// encodeToken encodes the position of the last item of a page.
+func encodeToken(name string, generation int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(name + "\x00" + strconv.FormatInt(generation, 10)))
+}
+
+// decodeToken decodes the position of the last item of a page.
+func decodeToken(token string) (string, int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, newError(http.StatusBadRequest, "Invalid page token.")
+	}
+
+	name, value, ok := strings.Cut(string(data), "\x00")
+	if !ok {
+		return "", 0, newError(http.StatusBadRequest, "Invalid page token.")
+	}
+
+	generation, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return "", 0, newError(http.StatusBadRequest, "Invalid page token.")
+	}
+
+	return name, generation, nil
+}
+
+// decode decodes the JSON body of a request into v and returns the raw
+// top-level fields, so that fields set to null can be recognized.
+func decode(r *http.Request, v any) (map[string]json.RawMessage, error) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]json.RawMessage{}
+	// an empty body is an empty resource
+	if len(bytes.TrimSpace(data)) == 0 {
+		return fields, nil
+	}
+
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, newError(http.StatusBadRequest, "Invalid JSON payload: %v", err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, newError(http.StatusBadRequest, "Invalid JSON payload: %v", err)
+	}
+
+	return fields, nil
+}
+
+// split splits an escaped path into unescaped segments.
+func split(path string) ([]string, error) {
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	// unescape the segments
+	for index, segment := range segments {
+		value, err := url.PathUnescape(segment)
+		if err != nil {
+			return nil, err
+		}
+
+		segments[index] = value
+	}
+
+	return segments, nil
+}
+
+// match reports whether the path starts with the pattern. A "*" matches any segment.
+func match(path []string, pattern ...string) bool {
+	if len(path) < len(pattern) {
+		return false
+	}
+
+	for index, segment := range pattern {
+		if segment != "*" && segment != path[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// baseURL returns the URL under which the emulator was reached.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	// detect TLS
+	if r.TLS != nil {
+		scheme = "https"
+	}
+
+	return scheme + "://" + r.Host
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	code, message := errorCode(err)
+	// a 304 response has no body
+	if code == http.StatusNotModified {
+		w.WriteHeader(code)
+		return
+	}
+
+	response := map[string]any{
+		"error": map[string]any{
+			"code":    code,
+			"message": message,
+			"errors": []map[string]any{
+				{"domain": "global", "reason": errorReason(code), "message": message},
+			},
+		},
+	}
+
+	data, _ := json.Marshal(response)
+	// write the error
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	w.Write(data)
+}
+
+func writeMethodNotAllowed(w http.ResponseWriter) {
+	writeError(w, newError(http.StatusMethodNotAllowed, "Method Not Allowed"))
+}
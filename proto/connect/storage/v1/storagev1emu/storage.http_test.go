@@ -0,0 +1,321 @@
+package storagev1emu_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+	option "google.golang.org/api/option"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1emu"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+func newClient(t *testing.T) storagev1.StorageServiceClient {
+	t.Helper()
+
+	server := httptest.NewServer(storagev1emu.NewStorageEmulator(storagev1mem.NewStorageServiceClient()))
+	t.Cleanup(server.Close)
+
+	client, err := storagev1sdk.NewStorageServiceClient(context.Background(),
+		option.WithEndpoint(server.URL+"/storage/v1/"),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestStorageEmulator(t *testing.T) {
+	ctx := context.Background()
+	bucket := newClient(t).Bucket("bucket")
+
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	for _, name := range []string{"a/1.txt", "a/2.txt", "b.txt"} {
+		writer := bucket.Object(name).NewWriter(ctx)
+		writer.SetContentType("text/plain")
+
+		if _, err := io.WriteString(writer, "data of "+name); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	}
+
+	attrs, err := bucket.Object("a/1.txt").Attrs(ctx)
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	if attrs.ContentType != "text/plain" || attrs.Size != int64(len("data of a/1.txt")) {
+		t.Errorf("Attrs() = %+v", attrs)
+	}
+
+	reader, err := bucket.Object("a/1.txt").NewRangeReader(ctx, 8, -1)
+	if err != nil {
+		t.Fatalf("NewRangeReader: %v", err)
+	}
+
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || string(data) != "a/1.txt" {
+		t.Errorf("NewRangeReader(8, -1) = %q, %v", data, err)
+	}
+
+	var names []string
+
+	iter := bucket.Objects(ctx, &storage.Query{Prefix: "a/"})
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		names = append(names, attrs.Name)
+	}
+
+	if want := []string{"a/1.txt", "a/2.txt"}; !slices.Equal(names, want) {
+		t.Errorf("Objects = %v, want %v", names, want)
+	}
+
+	composed, err := bucket.Object("c.txt").ComposerFrom(bucket.Object("a/1.txt"), bucket.Object("b.txt")).Run(ctx)
+	if err != nil {
+		t.Fatalf("ComposerFrom: %v", err)
+	}
+
+	if composed.ComponentCount != 2 {
+		t.Errorf("ComponentCount = %d, want 2", composed.ComponentCount)
+	}
+
+	if _, err := bucket.Object("d.txt").CopierFrom(bucket.Object("b.txt")).Run(ctx); err != nil {
+		t.Fatalf("CopierFrom: %v", err)
+	}
+
+	if err := bucket.Object("b.txt").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := bucket.Object("b.txt").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs after Delete = %v, want ErrObjectNotExist", err)
+	}
+}
+
+func TestStorageEmulatorConditions(t *testing.T) {
+	ctx := context.Background()
+	bucket := newClient(t).Bucket("bucket")
+
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	writer := bucket.Object("a").If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	io.Copy(writer, strings.NewReader("first"))
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	writer = bucket.Object("a").If(storage.Conditions{DoesNotExist: true}).NewWriter(ctx)
+	io.Copy(writer, strings.NewReader("second"))
+	if err := writer.Close(); err == nil {
+		t.Error("Close of an existing object with DoesNotExist: want an error")
+	}
+}
+
+func TestStorageEmulatorChunkedUpload(t *testing.T) {
+	ctx := context.Background()
+	bucket := newClient(t).Bucket("bucket")
+
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	data := bytes.Repeat([]byte("0123456789"), 60*1024)
+
+	writer := bucket.Object("a").NewWriter(ctx)
+	// upload the content in three chunks
+	writer.SetChunkSize(256 * 1024)
+
+	if _, err := writer.Write(data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reader, err := bucket.Object("a").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+
+	got, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || !bytes.Equal(got, data) {
+		t.Errorf("NewReader() = %d bytes, %v, want %d bytes", len(got), err, len(data))
+	}
+}
+
+func TestStorageEmulatorInterruptedChunk(t *testing.T) {
+	ctx := context.Background()
+	client := storagev1mem.NewStorageServiceClient()
+
+	if err := client.Bucket("bucket").Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	done := make(chan struct{}, 1)
+	emulator := storagev1emu.NewStorageEmulator(client)
+	// signal the end of every chunk
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		emulator.ServeHTTP(w, r)
+
+		if r.Method == http.MethodPut {
+			done <- struct{}{}
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	response, err := http.Post(server.URL+"/upload/storage/v1/b/bucket/o?uploadType=resumable&name=a", "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+
+	response.Body.Close()
+
+	location := response.Header.Get("Location")
+	if response.StatusCode != http.StatusOK || location == "" {
+		t.Fatalf("Post() = %d, Location %q", response.StatusCode, location)
+	}
+
+	data := bytes.Repeat([]byte("0123456789"), 16*1024)
+
+	put := func(body io.Reader, size int64, span string) *http.Response {
+		t.Helper()
+
+		request, err := http.NewRequest(http.MethodPut, location, body)
+		if err != nil {
+			t.Fatalf("NewRequest: %v", err)
+		}
+
+		request.ContentLength = size
+		request.Header.Set("Content-Range", fmt.Sprintf("bytes %s/%d", span, len(data)))
+
+		response, err := http.DefaultTransport.RoundTrip(request)
+		if err == nil {
+			response.Body.Close()
+		}
+
+		<-done
+		return response
+	}
+
+	// the client fails to read the body in the middle of the chunk
+	body := io.MultiReader(bytes.NewReader(data[:len(data)/2]), iotest.ErrReader(errors.New("connection reset")))
+	put(body, int64(len(data)), fmt.Sprintf("0-%d", len(data)-1))
+
+	response = put(http.NoBody, 0, "*")
+	if response.StatusCode != http.StatusPermanentRedirect {
+		t.Fatalf("status of the session = %d, want %d", response.StatusCode, http.StatusPermanentRedirect)
+	}
+
+	var offset int
+	// resume from the persisted offset
+	if value := response.Header.Get("Range"); value != "" {
+		if _, err := fmt.Sscanf(value, "bytes=0-%d", &offset); err != nil {
+			t.Fatalf("Range = %q: %v", value, err)
+		}
+
+		offset++
+	}
+
+	response = put(bytes.NewReader(data[offset:]), int64(len(data)-offset), fmt.Sprintf("%d-%d", offset, len(data)-1))
+	if response.StatusCode != http.StatusOK {
+		t.Fatalf("resume at %d = %d, want %d", offset, response.StatusCode, http.StatusOK)
+	}
+
+	reader, err := client.Bucket("bucket").Object("a").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+
+	got, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || !bytes.Equal(got, data) {
+		t.Errorf("NewReader() = %d bytes, %v, want %d bytes", len(got), err, len(data))
+	}
+}
+
+func TestStorageEmulatorXML(t *testing.T) {
+	ctx := context.Background()
+	client := storagev1mem.NewStorageServiceClient()
+
+	if err := client.Bucket("bucket").Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	server := httptest.NewServer(storagev1emu.NewStorageEmulator(client))
+	t.Cleanup(server.Close)
+
+	request, err := http.NewRequest(http.MethodPut, server.URL+"/bucket/a/b.txt", strings.NewReader("data"))
+	if err != nil {
+		t.Fatalf("NewRequest: %v", err)
+	}
+
+	request.Header.Set("Content-Type", "text/plain")
+	request.Header.Set("X-Goog-Meta-Owner", "team")
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusOK || response.Header.Get("X-Goog-Generation") == "" {
+		t.Fatalf("PUT = %d, X-Goog-Generation %q", response.StatusCode, response.Header.Get("X-Goog-Generation"))
+	}
+
+	attrs, err := client.Bucket("bucket").Object("a/b.txt").Attrs(ctx)
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	if attrs.ContentType != "text/plain" || attrs.Metadata["owner"] != "team" {
+		t.Errorf("Attrs() = %+v", attrs)
+	}
+
+	response, err = http.Get(server.URL + "/bucket/a/b.txt")
+	if err != nil {
+		t.Fatalf("Get: %v", err)
+	}
+
+	data, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil || string(data) != "data" {
+		t.Errorf("GET = %q, %v", data, err)
+	}
+}
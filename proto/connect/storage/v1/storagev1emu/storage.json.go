@@ -0,0 +1,869 @@
+package storagev1emu
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	googleapi "google.golang.org/api/googleapi"
+	raw "google.golang.org/api/storage/v1"
+)
+
+// rfc3339Date is the date layout used by lifecycle conditions.
+const rfc3339Date = "2006-01-02"
+
+var topicPattern = regexp.MustCompile(`^//pubsub\.googleapis\.com/projects/([^/]+)/topics/([^/]+)$`)
+
+// formatTime formats a timestamp the way the JSON API does.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
+// parseTime parses a JSON API timestamp. An empty value is the zero time.
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339Nano, value)
+}
+
+// encodeUint32 encodes a CRC32C checksum as base64 in big-endian byte order.
+func encodeUint32(v uint32) string {
+	data := binary.BigEndian.AppendUint32(nil, v)
+	// done!
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// decodeUint32 decodes a CRC32C checksum encoded as base64 in big-endian byte order.
+func decodeUint32(value string) (uint32, error) {
+	data, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(data) != 4 {
+		return 0, fmt.Errorf("%q does not encode a 32-bit value", value)
+	}
+
+	return binary.BigEndian.Uint32(data), nil
+}
+
+// escapeObject escapes an object name for use as a single path segment.
+func escapeObject(name string) string {
+	return url.PathEscape(name)
+}
+
+// toRawObject converts the object attributes to the JSON API representation.
+func toRawObject(base string, attrs *storage.ObjectAttrs) *raw.Object {
+	object := &raw.Object{
+		Kind:                    "storage#object",
+		Id:                      fmt.Sprintf("%s/%s/%d", attrs.Bucket, attrs.Name, attrs.Generation),
+		SelfLink:                fmt.Sprintf("%s/storage/v1/b/%s/o/%s", base, attrs.Bucket, escapeObject(attrs.Name)),
+		MediaLink:               fmt.Sprintf("%s/download/storage/v1/b/%s/o/%s?generation=%d&alt=media", base, attrs.Bucket, escapeObject(attrs.Name), attrs.Generation),
+		Bucket:                  attrs.Bucket,
+		Name:                    attrs.Name,
+		Generation:              attrs.Generation,
+		Metageneration:          attrs.Metageneration,
+		ContentType:             attrs.ContentType,
+		ContentLanguage:         attrs.ContentLanguage,
+		ContentEncoding:         attrs.ContentEncoding,
+		ContentDisposition:      attrs.ContentDisposition,
+		CacheControl:            attrs.CacheControl,
+		StorageClass:            attrs.StorageClass,
+		Size:                    uint64(attrs.Size),
+		Crc32c:                  encodeUint32(attrs.CRC32C),
+		ComponentCount:          attrs.ComponentCount,
+		Etag:                    attrs.Etag,
+		EventBasedHold:          attrs.EventBasedHold,
+		TemporaryHold:           attrs.TemporaryHold,
+		KmsKeyName:              attrs.KMSKeyName,
+		Metadata:                attrs.Metadata,
+		Acl:                     toRawObjectACL(attrs.ACL),
+		TimeCreated:             formatTime(attrs.Created),
+		TimeFinalized:           formatTime(attrs.Finalized),
+		TimeDeleted:             formatTime(attrs.Deleted),
+		Updated:                 formatTime(attrs.Updated),
+		CustomTime:              formatTime(attrs.CustomTime),
+		RetentionExpirationTime: formatTime(attrs.RetentionExpirationTime),
+		SoftDeleteTime:          formatTime(attrs.SoftDeleteTime),
+		HardDeleteTime:          formatTime(attrs.HardDeleteTime),
+	}
+
+	if len(attrs.MD5) > 0 {
+		object.Md5Hash = base64.StdEncoding.EncodeToString(attrs.MD5)
+	}
+
+	if attrs.Owner != "" {
+		object.Owner = &raw.ObjectOwner{Entity: attrs.Owner}
+	}
+
+	if attrs.CustomerKeySHA256 != "" {
+		object.CustomerEncryption = &raw.ObjectCustomerEncryption{
+			EncryptionAlgorithm: "AES256",
+			KeySha256:           attrs.CustomerKeySHA256,
+		}
+	}
+
+	if attrs.Retention != nil {
+		object.Retention = &raw.ObjectRetention{
+			Mode:            attrs.Retention.Mode,
+			RetainUntilTime: formatTime(attrs.Retention.RetainUntil),
+		}
+	}
+
+	return object
+}
+
+// fromRawObject converts the writable fields of a JSON API object to object attributes.
+func fromRawObject(object *raw.Object) (*storage.ObjectAttrs, error) {
+	attrs := &storage.ObjectAttrs{
+		Bucket:             object.Bucket,
+		Name:               object.Name,
+		ContentType:        object.ContentType,
+		ContentLanguage:    object.ContentLanguage,
+		ContentEncoding:    object.ContentEncoding,
+		ContentDisposition: object.ContentDisposition,
+		CacheControl:       object.CacheControl,
+		StorageClass:       object.StorageClass,
+		EventBasedHold:     object.EventBasedHold,
+		TemporaryHold:      object.TemporaryHold,
+		KMSKeyName:         object.KmsKeyName,
+		Metadata:           object.Metadata,
+		ACL:                fromRawObjectACL(object.Acl),
+	}
+
+	var err error
+	// parse the timestamps
+	if attrs.CustomTime, err = parseTime(object.CustomTime); err != nil {
+		return nil, newError(http.StatusBadRequest, "Invalid customTime: %v", err)
+	}
+
+	if object.Md5Hash != "" {
+		if attrs.MD5, err = base64.StdEncoding.DecodeString(object.Md5Hash); err != nil {
+			return nil, newError(http.StatusBadRequest, "Invalid md5Hash: %v", err)
+		}
+	}
+
+	if object.Retention != nil {
+		retention := &storage.ObjectRetention{Mode: object.Retention.Mode}
+		// parse the retention time
+		if retention.RetainUntil, err = parseTime(object.Retention.RetainUntilTime); err != nil {
+			return nil, newError(http.StatusBadRequest, "Invalid retainUntilTime: %v", err)
+		}
+
+		attrs.Retention = retention
+	}
+
+	return attrs, nil
+}
+
+// toObjectAttrsToUpdate converts a JSON API patch to the attributes to update.
+// The fields map holds the raw fields of the request body, so that fields
+// set to their zero value or to null are recognized.
+func toObjectAttrsToUpdate(object *raw.Object, fields map[string]json.RawMessage) (storage.ObjectAttrsToUpdate, error) {
+	var update storage.ObjectAttrsToUpdate
+
+	if _, ok := fields["eventBasedHold"]; ok {
+		update.EventBasedHold = object.EventBasedHold
+	}
+
+	if _, ok := fields["temporaryHold"]; ok {
+		update.TemporaryHold = object.TemporaryHold
+	}
+
+	if _, ok := fields["contentType"]; ok {
+		update.ContentType = object.ContentType
+	}
+
+	if _, ok := fields["contentLanguage"]; ok {
+		update.ContentLanguage = object.ContentLanguage
+	}
+
+	if _, ok := fields["contentEncoding"]; ok {
+		update.ContentEncoding = object.ContentEncoding
+	}
+
+	if _, ok := fields["contentDisposition"]; ok {
+		update.ContentDisposition = object.ContentDisposition
+	}
+
+	if _, ok := fields["cacheControl"]; ok {
+		update.CacheControl = object.CacheControl
+	}
+
+	if _, ok := fields["customTime"]; ok {
+		value, err := parseTime(object.CustomTime)
+		if err != nil {
+			return update, newError(http.StatusBadRequest, "Invalid customTime: %v", err)
+		}
+
+		update.CustomTime = value
+	}
+
+	if value, ok := fields["metadata"]; ok {
+		metadata := map[string]*string{}
+		// a null value removes the key
+		if err := json.Unmarshal(value, &metadata); err != nil {
+			return update, newError(http.StatusBadRequest, "Invalid metadata: %v", err)
+		}
+
+		update.Metadata = map[string]string{}
+		// prepare the metadata
+		for k, v := range metadata {
+			if v == nil {
+				update.Metadata[k] = ""
+			} else {
+				update.Metadata[k] = *v
+			}
+		}
+	}
+
+	if _, ok := fields["acl"]; ok {
+		update.ACL = fromRawObjectACL(object.Acl)
+		// an empty list clears the ACL
+		if update.ACL == nil {
+			update.ACL = []storage.ACLRule{}
+		}
+	}
+
+	if _, ok := fields["retention"]; ok {
+		update.Retention = &storage.ObjectRetention{}
+		// a null value removes the retention
+		if object.Retention != nil {
+			value, err := parseTime(object.Retention.RetainUntilTime)
+			if err != nil {
+				return update, newError(http.StatusBadRequest, "Invalid retainUntilTime: %v", err)
+			}
+
+			update.Retention.Mode = object.Retention.Mode
+			update.Retention.RetainUntil = value
+		}
+	}
+
+	return update, nil
+}
+
+func toRawObjectACL(rules []storage.ACLRule) []*raw.ObjectAccessControl {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	collection := make([]*raw.ObjectAccessControl, 0, len(rules))
+	// convert the rules
+	for _, rule := range rules {
+		item := &raw.ObjectAccessControl{
+			Kind:     "storage#objectAccessControl",
+			Entity:   string(rule.Entity),
+			EntityId: rule.EntityID,
+			Role:     string(rule.Role),
+			Domain:   rule.Domain,
+			Email:    rule.Email,
+		}
+
+		if rule.ProjectTeam != nil {
+			item.ProjectTeam = &raw.ObjectAccessControlProjectTeam{
+				ProjectNumber: rule.ProjectTeam.ProjectNumber,
+				Team:          rule.ProjectTeam.Team,
+			}
+		}
+
+		collection = append(collection, item)
+	}
+
+	return collection
+}
+
+func fromRawObjectACL(items []*raw.ObjectAccessControl) []storage.ACLRule {
+	var collection []storage.ACLRule
+	// convert the items
+	for _, item := range items {
+		rule := storage.ACLRule{
+			Entity:   storage.ACLEntity(item.Entity),
+			EntityID: item.EntityId,
+			Role:     storage.ACLRole(item.Role),
+			Domain:   item.Domain,
+			Email:    item.Email,
+		}
+
+		if item.ProjectTeam != nil {
+			rule.ProjectTeam = &storage.ProjectTeam{
+				ProjectNumber: item.ProjectTeam.ProjectNumber,
+				Team:          item.ProjectTeam.Team,
+			}
+		}
+
+		collection = append(collection, rule)
+	}
+
+	return collection
+}
+
+func toRawBucketACL(rules []storage.ACLRule) []*raw.BucketAccessControl {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	collection := make([]*raw.BucketAccessControl, 0, len(rules))
+	// convert the rules
+	for _, rule := range rules {
+		item := &raw.BucketAccessControl{
+			Kind:     "storage#bucketAccessControl",
+			Entity:   string(rule.Entity),
+			EntityId: rule.EntityID,
+			Role:     string(rule.Role),
+			Domain:   rule.Domain,
+			Email:    rule.Email,
+		}
+
+		if rule.ProjectTeam != nil {
+			item.ProjectTeam = &raw.BucketAccessControlProjectTeam{
+				ProjectNumber: rule.ProjectTeam.ProjectNumber,
+				Team:          rule.ProjectTeam.Team,
+			}
+		}
+
+		collection = append(collection, item)
+	}
+
+	return collection
+}
+
+func fromRawBucketACL(items []*raw.BucketAccessControl) []storage.ACLRule {
+	var collection []storage.ACLRule
+	// convert the items
+	for _, item := range items {
+		rule := storage.ACLRule{
+			Entity:   storage.ACLEntity(item.Entity),
+			EntityID: item.EntityId,
+			Role:     storage.ACLRole(item.Role),
+			Domain:   item.Domain,
+			Email:    item.Email,
+		}
+
+		if item.ProjectTeam != nil {
+			rule.ProjectTeam = &storage.ProjectTeam{
+				ProjectNumber: item.ProjectTeam.ProjectNumber,
+				Team:          item.ProjectTeam.Team,
+			}
+		}
+
+		collection = append(collection, rule)
+	}
+
+	return collection
+}
+
+// toRawBucket converts the bucket attributes to the JSON API representation.
+func toRawBucket(base string, attrs *storage.BucketAttrs) *raw.Bucket {
+	bucket := &raw.Bucket{
+		Kind:                  "storage#bucket",
+		Id:                    attrs.Name,
+		SelfLink:              fmt.Sprintf("%s/storage/v1/b/%s", base, attrs.Name),
+		Name:                  attrs.Name,
+		Location:              attrs.Location,
+		LocationType:          attrs.LocationType,
+		StorageClass:          attrs.StorageClass,
+		Metageneration:        attrs.MetaGeneration,
+		Etag:                  attrs.Etag,
+		ProjectNumber:         attrs.ProjectNumber,
+		TimeCreated:           formatTime(attrs.Created),
+		Updated:               formatTime(attrs.Updated),
+		DefaultEventBasedHold: attrs.DefaultEventBasedHold,
+		Labels:                attrs.Labels,
+		Acl:                   toRawBucketACL(attrs.ACL),
+		DefaultObjectAcl:      toRawObjectACL(attrs.DefaultObjectACL),
+		Cors:                  toRawCORS(attrs.CORS),
+		Lifecycle:             toRawLifecycle(attrs.Lifecycle),
+		Rpo:                   attrs.RPO.String(),
+	}
+
+	if attrs.VersioningEnabled {
+		bucket.Versioning = &raw.BucketVersioning{Enabled: true}
+	}
+
+	if attrs.RequesterPays {
+		bucket.Billing = &raw.BucketBilling{RequesterPays: true}
+	}
+
+	if policy := attrs.RetentionPolicy; policy != nil {
+		bucket.RetentionPolicy = &raw.BucketRetentionPolicy{
+			RetentionPeriod: int64(policy.RetentionPeriod / time.Second),
+			EffectiveTime:   formatTime(policy.EffectiveTime),
+			IsLocked:        policy.IsLocked,
+		}
+	}
+
+	if attrs.Encryption != nil {
+		bucket.Encryption = &raw.BucketEncryption{DefaultKmsKeyName: attrs.Encryption.DefaultKMSKeyName}
+	}
+
+	if attrs.Logging != nil {
+		bucket.Logging = &raw.BucketLogging{
+			LogBucket:       attrs.Logging.LogBucket,
+			LogObjectPrefix: attrs.Logging.LogObjectPrefix,
+		}
+	}
+
+	if attrs.Website != nil {
+		bucket.Website = &raw.BucketWebsite{
+			MainPageSuffix: attrs.Website.MainPageSuffix,
+			NotFoundPage:   attrs.Website.NotFoundPage,
+		}
+	}
+
+	enabled := attrs.UniformBucketLevelAccess.Enabled || attrs.BucketPolicyOnly.Enabled
+	// prepare the IAM configuration
+	if enabled || attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		bucket.IamConfiguration = &raw.BucketIamConfiguration{
+			UniformBucketLevelAccess: &raw.BucketIamConfigurationUniformBucketLevelAccess{
+				Enabled: enabled,
+			},
+			BucketPolicyOnly: &raw.BucketIamConfigurationBucketPolicyOnly{
+				Enabled: enabled,
+			},
+		}
+
+		if attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+			bucket.IamConfiguration.PublicAccessPrevention = attrs.PublicAccessPrevention.String()
+		}
+	}
+
+	return bucket
+}
+
+// fromRawBucket converts the writable fields of a JSON API bucket to bucket attributes.
+func fromRawBucket(bucket *raw.Bucket) (*storage.BucketAttrs, error) {
+	attrs := &storage.BucketAttrs{
+		Name:                  bucket.Name,
+		Location:              bucket.Location,
+		LocationType:          bucket.LocationType,
+		StorageClass:          bucket.StorageClass,
+		DefaultEventBasedHold: bucket.DefaultEventBasedHold,
+		Labels:                bucket.Labels,
+		ACL:                   fromRawBucketACL(bucket.Acl),
+		DefaultObjectACL:      fromRawObjectACL(bucket.DefaultObjectAcl),
+		VersioningEnabled:     bucket.Versioning != nil && bucket.Versioning.Enabled,
+		RequesterPays:         bucket.Billing != nil && bucket.Billing.RequesterPays,
+		CORS:                  fromRawCORS(bucket.Cors),
+	}
+
+	var err error
+	// convert the lifecycle
+	if attrs.Lifecycle, err = fromRawLifecycle(bucket.Lifecycle); err != nil {
+		return nil, err
+	}
+
+	if policy := bucket.RetentionPolicy; policy != nil {
+		attrs.RetentionPolicy = &storage.RetentionPolicy{
+			RetentionPeriod: time.Duration(policy.RetentionPeriod) * time.Second,
+		}
+	}
+
+	if bucket.Encryption != nil {
+		attrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: bucket.Encryption.DefaultKmsKeyName}
+	}
+
+	if bucket.Logging != nil {
+		attrs.Logging = &storage.BucketLogging{
+			LogBucket:       bucket.Logging.LogBucket,
+			LogObjectPrefix: bucket.Logging.LogObjectPrefix,
+		}
+	}
+
+	if bucket.Website != nil {
+		attrs.Website = &storage.BucketWebsite{
+			MainPageSuffix: bucket.Website.MainPageSuffix,
+			NotFoundPage:   bucket.Website.NotFoundPage,
+		}
+	}
+
+	if config := bucket.IamConfiguration; config != nil {
+		if config.UniformBucketLevelAccess != nil {
+			attrs.UniformBucketLevelAccess.Enabled = config.UniformBucketLevelAccess.Enabled
+		}
+
+		if config.BucketPolicyOnly != nil && config.BucketPolicyOnly.Enabled {
+			attrs.UniformBucketLevelAccess.Enabled = true
+		}
+
+		attrs.BucketPolicyOnly.Enabled = attrs.UniformBucketLevelAccess.Enabled
+		attrs.PublicAccessPrevention = toPublicAccessPrevention(config.PublicAccessPrevention)
+	}
+
+	return attrs, nil
+}
+
+// toBucketAttrsToUpdate converts a JSON API patch to the attributes to update.
+// The fields map holds the raw fields of the request body, so that fields
+// set to their zero value or to null are recognized.
+func toBucketAttrsToUpdate(bucket *raw.Bucket, fields map[string]json.RawMessage) (storage.BucketAttrsToUpdate, error) {
+	var update storage.BucketAttrsToUpdate
+
+	if _, ok := fields["versioning"]; ok {
+		update.VersioningEnabled = bucket.Versioning != nil && bucket.Versioning.Enabled
+	}
+
+	if _, ok := fields["billing"]; ok {
+		update.RequesterPays = bucket.Billing != nil && bucket.Billing.RequesterPays
+	}
+
+	if _, ok := fields["defaultEventBasedHold"]; ok {
+		update.DefaultEventBasedHold = bucket.DefaultEventBasedHold
+	}
+
+	if _, ok := fields["storageClass"]; ok {
+		update.StorageClass = bucket.StorageClass
+	}
+
+	if _, ok := fields["cors"]; ok {
+		update.CORS = fromRawCORS(bucket.Cors)
+		// an empty list clears the configuration
+		if update.CORS == nil {
+			update.CORS = []storage.CORS{}
+		}
+	}
+
+	if _, ok := fields["lifecycle"]; ok {
+		lifecycle, err := fromRawLifecycle(bucket.Lifecycle)
+		if err != nil {
+			return update, err
+		}
+
+		update.Lifecycle = &lifecycle
+	}
+
+	if _, ok := fields["retentionPolicy"]; ok {
+		update.RetentionPolicy = &storage.RetentionPolicy{}
+		// a null value removes the policy
+		if bucket.RetentionPolicy != nil {
+			update.RetentionPolicy.RetentionPeriod = time.Duration(bucket.RetentionPolicy.RetentionPeriod) * time.Second
+		}
+	}
+
+	if _, ok := fields["encryption"]; ok {
+		update.Encryption = &storage.BucketEncryption{}
+		// a null value removes the encryption
+		if bucket.Encryption != nil {
+			update.Encryption.DefaultKMSKeyName = bucket.Encryption.DefaultKmsKeyName
+		}
+	}
+
+	if _, ok := fields["logging"]; ok {
+		update.Logging = &storage.BucketLogging{}
+		// a null value removes the logging
+		if bucket.Logging != nil {
+			update.Logging.LogBucket = bucket.Logging.LogBucket
+			update.Logging.LogObjectPrefix = bucket.Logging.LogObjectPrefix
+		}
+	}
+
+	if _, ok := fields["website"]; ok {
+		update.Website = &storage.BucketWebsite{}
+		// a null value removes the website
+		if bucket.Website != nil {
+			update.Website.MainPageSuffix = bucket.Website.MainPageSuffix
+			update.Website.NotFoundPage = bucket.Website.NotFoundPage
+		}
+	}
+
+	if config := bucket.IamConfiguration; config != nil {
+		if access := config.UniformBucketLevelAccess; access != nil {
+			update.UniformBucketLevelAccess = &storage.UniformBucketLevelAccess{Enabled: access.Enabled}
+		}
+
+		update.PublicAccessPrevention = toPublicAccessPrevention(config.PublicAccessPrevention)
+	}
+
+	if value, ok := fields["labels"]; ok {
+		labels := map[string]*string{}
+		// a null value removes the label
+		if err := json.Unmarshal(value, &labels); err != nil {
+			return update, newError(http.StatusBadRequest, "Invalid labels: %v", err)
+		}
+
+		for k, v := range labels {
+			if v == nil {
+				update.DeleteLabel(k)
+			} else {
+				update.SetLabel(k, *v)
+			}
+		}
+	}
+
+	return update, nil
+}
+
+func toPublicAccessPrevention(value string) storage.PublicAccessPrevention {
+	switch value {
+	case "enforced":
+		return storage.PublicAccessPreventionEnforced
+	case "inherited", "unspecified":
+		return storage.PublicAccessPreventionInherited
+	default:
+		return storage.PublicAccessPreventionUnknown
+	}
+}
+
+func toRawCORS(rules []storage.CORS) []*raw.BucketCors {
+	var collection []*raw.BucketCors
+	// convert the rules
+	for _, rule := range rules {
+		collection = append(collection, &raw.BucketCors{
+			MaxAgeSeconds:  int64(rule.MaxAge / time.Second),
+			Method:         rule.Methods,
+			Origin:         rule.Origins,
+			ResponseHeader: rule.ResponseHeaders,
+		})
+	}
+
+	return collection
+}
+
+func fromRawCORS(items []*raw.BucketCors) []storage.CORS {
+	var collection []storage.CORS
+	// convert the items
+	for _, item := range items {
+		collection = append(collection, storage.CORS{
+			MaxAge:          time.Duration(item.MaxAgeSeconds) * time.Second,
+			Methods:         item.Method,
+			Origins:         item.Origin,
+			ResponseHeaders: item.ResponseHeader,
+		})
+	}
+
+	return collection
+}
+
+func toRawLifecycle(lifecycle storage.Lifecycle) *raw.BucketLifecycle {
+	if len(lifecycle.Rules) == 0 {
+		return nil
+	}
+
+	result := &raw.BucketLifecycle{}
+	// convert the rules
+	for _, rule := range lifecycle.Rules {
+		item := &raw.BucketLifecycleRule{
+			Action: &raw.BucketLifecycleRuleAction{
+				Type:         rule.Action.Type,
+				StorageClass: rule.Action.StorageClass,
+			},
+			Condition: &raw.BucketLifecycleRuleCondition{
+				DaysSinceCustomTime:     rule.Condition.DaysSinceCustomTime,
+				DaysSinceNoncurrentTime: rule.Condition.DaysSinceNoncurrentTime,
+				MatchesPrefix:           rule.Condition.MatchesPrefix,
+				MatchesStorageClass:     rule.Condition.MatchesStorageClasses,
+				MatchesSuffix:           rule.Condition.MatchesSuffix,
+				NumNewerVersions:        rule.Condition.NumNewerVersions,
+			},
+		}
+
+		switch {
+		case rule.Condition.AllObjects:
+			item.Condition.Age = googleapi.Int64(0)
+			item.Condition.ForceSendFields = []string{"Age"}
+		case rule.Condition.AgeInDays > 0:
+			item.Condition.Age = googleapi.Int64(rule.Condition.AgeInDays)
+		}
+
+		switch rule.Condition.Liveness {
+		case storage.Live:
+			item.Condition.IsLive = googleapi.Bool(true)
+		case storage.Archived:
+			item.Condition.IsLive = googleapi.Bool(false)
+		}
+
+		if !rule.Condition.CreatedBefore.IsZero() {
+			item.Condition.CreatedBefore = rule.Condition.CreatedBefore.Format(rfc3339Date)
+		}
+
+		if !rule.Condition.CustomTimeBefore.IsZero() {
+			item.Condition.CustomTimeBefore = rule.Condition.CustomTimeBefore.Format(rfc3339Date)
+		}
+
+		if !rule.Condition.NoncurrentTimeBefore.IsZero() {
+			item.Condition.NoncurrentTimeBefore = rule.Condition.NoncurrentTimeBefore.Format(rfc3339Date)
+		}
+
+		result.Rule = append(result.Rule, item)
+	}
+
+	return result
+}
+
+func fromRawLifecycle(lifecycle *raw.BucketLifecycle) (storage.Lifecycle, error) {
+	var result storage.Lifecycle
+
+	if lifecycle == nil {
+		return result, nil
+	}
+
+	for _, item := range lifecycle.Rule {
+		if item.Action == nil || item.Condition == nil {
+			return result, newError(http.StatusBadRequest, "A lifecycle rule requires an action and a condition.")
+		}
+
+		rule := storage.LifecycleRule{
+			Action: storage.LifecycleAction{
+				Type:         item.Action.Type,
+				StorageClass: item.Action.StorageClass,
+			},
+			Condition: storage.LifecycleCondition{
+				DaysSinceCustomTime:     item.Condition.DaysSinceCustomTime,
+				DaysSinceNoncurrentTime: item.Condition.DaysSinceNoncurrentTime,
+				MatchesPrefix:           item.Condition.MatchesPrefix,
+				MatchesStorageClasses:   item.Condition.MatchesStorageClass,
+				MatchesSuffix:           item.Condition.MatchesSuffix,
+				NumNewerVersions:        item.Condition.NumNewerVersions,
+			},
+		}
+
+		if age := item.Condition.Age; age != nil {
+			rule.Condition.AgeInDays = *age
+			rule.Condition.AllObjects = *age == 0
+		}
+
+		switch live := item.Condition.IsLive; {
+		case live == nil:
+			rule.Condition.Liveness = storage.LiveAndArchived
+		case *live:
+			rule.Condition.Liveness = storage.Live
+		default:
+			rule.Condition.Liveness = storage.Archived
+		}
+
+		for _, date := range []struct {
+			value  string
+			target *time.Time
+		}{
+			{item.Condition.CreatedBefore, &rule.Condition.CreatedBefore},
+			{item.Condition.CustomTimeBefore, &rule.Condition.CustomTimeBefore},
+			{item.Condition.NoncurrentTimeBefore, &rule.Condition.NoncurrentTimeBefore},
+		} {
+			if date.value == "" {
+				continue
+			}
+
+			value, err := time.Parse(rfc3339Date, date.value)
+			if err != nil {
+				return result, newError(http.StatusBadRequest, "Invalid lifecycle date %q.", date.value)
+			}
+
+			*date.target = value
+		}
+
+		result.Rules = append(result.Rules, rule)
+	}
+
+	return result, nil
+}
+
+// toRawNotification converts the notification to the JSON API representation.
+func toRawNotification(base, bucket string, n *storage.Notification) *raw.Notification {
+	notification := &raw.Notification{
+		Kind:             "storage#notification",
+		Id:               n.ID,
+		SelfLink:         fmt.Sprintf("%s/storage/v1/b/%s/notificationConfigs/%s", base, bucket, n.ID),
+		Topic:            fmt.Sprintf("//pubsub.googleapis.com/projects/%s/topics/%s", n.TopicProjectID, n.TopicID),
+		EventTypes:       n.EventTypes,
+		ObjectNamePrefix: n.ObjectNamePrefix,
+		CustomAttributes: n.CustomAttributes,
+		PayloadFormat:    n.PayloadFormat,
+		Etag:             n.ID,
+	}
+
+	return notification
+}
+
+// fromRawNotification converts a JSON API notification to a notification.
+func fromRawNotification(notification *raw.Notification) (*storage.Notification, error) {
+	n := &storage.Notification{
+		ID:               notification.Id,
+		EventTypes:       notification.EventTypes,
+		ObjectNamePrefix: notification.ObjectNamePrefix,
+		CustomAttributes: notification.CustomAttributes,
+		PayloadFormat:    notification.PayloadFormat,
+	}
+
+	match := topicPattern.FindStringSubmatch(notification.Topic)
+	if match == nil {
+		return nil, newError(http.StatusBadRequest, "Invalid topic name: %q", notification.Topic)
+	}
+
+	n.TopicProjectID, n.TopicID = match[1], match[2]
+	// done!
+	return n, nil
+}
+
+// newError returns a googleapi.Error with the given code and message.
+func newError(code int, format string, args ...any) error {
+	return &googleapi.Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+// errorCode returns the HTTP status code and the message for an error. Errors
+// that do not carry a status are reported as 400 Bad Request, so that clients
+// do not retry them.
+func errorCode(err error) (int, string) {
+	var apierr *googleapi.Error
+
+	switch {
+	case errors.As(err, &apierr):
+		message := apierr.Message
+		// fallback to the body
+		if message == "" {
+			message = apierr.Body
+		}
+
+		if message == "" {
+			message = http.StatusText(apierr.Code)
+		}
+
+		return apierr.Code, message
+	case errors.Is(err, storage.ErrObjectNotExist):
+		return http.StatusNotFound, "No such object."
+	case errors.Is(err, storage.ErrBucketNotExist):
+		return http.StatusNotFound, "The specified bucket does not exist."
+	default:
+		return http.StatusBadRequest, err.Error()
+	}
+}
+
+// errorReason returns the JSON API error reason for a status code.
+func errorReason(code int) string {
+	switch code {
+	case http.StatusNotModified:
+		return "notModified"
+	case http.StatusBadRequest:
+		return "invalid"
+	case http.StatusUnauthorized:
+		return "required"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "notFound"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusPreconditionFailed:
+		return "conditionNotMet"
+	case http.StatusRequestedRangeNotSatisfiable:
+		return "requestedRangeNotSatisfiable"
+	case http.StatusTooManyRequests:
+		return "rateLimitExceeded"
+	case http.StatusServiceUnavailable:
+		return "backendError"
+	default:
+		return "error" + strconv.Itoa(code)
+	}
+}
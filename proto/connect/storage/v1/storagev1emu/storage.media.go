@@ -0,0 +1,730 @@
+package storagev1emu
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	storage "cloud.google.com/go/storage"
+	raw "google.golang.org/api/storage/v1"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// upload represents a resumable upload session.
+type upload struct {
+	mu     sync.Mutex
+	writer storagev1.StorageObjectWriter
+	offset int64
+}
+
+// requestBody records the read error of a request body, which tells an
+// interrupted request apart from a failed object writer.
+type requestBody struct {
+	reader io.Reader
+	err    error
+}
+
+// Read implements io.Reader.
+func (x *requestBody) Read(p []byte) (int, error) {
+	n, err := x.reader.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		x.err = err
+	}
+
+	return n, err
+}
+
+// serveDownload serves the content of an object for the JSON API media endpoints.
+func (x *StorageEmulator) serveDownload(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if err := x.read(w, r, handle); err != nil {
+		writeError(w, err)
+	}
+}
+
+// serveXML serves the object endpoints of the XML API.
+func (x *StorageEmulator) serveXML(w http.ResponseWriter, r *http.Request, bucket, name string) {
+	handle, err := x.objectXML(r, bucket, name)
+	if err != nil {
+		writeXMLError(w, err)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		err = x.read(w, r, handle)
+	case http.MethodPut:
+		err = x.writeXML(w, r, handle)
+	case http.MethodDelete:
+		if err = handle.Delete(r.Context()); err == nil {
+			w.WriteHeader(http.StatusNoContent)
+		}
+	default:
+		err = newError(http.StatusMethodNotAllowed, "Method Not Allowed")
+	}
+
+	if err != nil {
+		writeXMLError(w, err)
+	}
+}
+
+// read writes the content of the object to the response. Objects stored
+// with gzip content encoding are decompressed unless the client accepts gzip.
+func (x *StorageEmulator) read(w http.ResponseWriter, r *http.Request, handle storagev1.StorageObjectHandle) error {
+	attrs, err := handle.Attrs(r.Context())
+	if err != nil {
+		return err
+	}
+
+	compressed := strings.Contains(r.Header.Get("Accept-Encoding"), "gzip")
+	// decompressive transcoding serves the whole object
+	transcoded := attrs.ContentEncoding == "gzip" && !compressed
+
+	offset, length, ranged, err := parseRange(r.Header.Get("Range"))
+	if err != nil {
+		return err
+	}
+
+	if transcoded {
+		offset, length, ranged = 0, -1, false
+	}
+
+	// pin the generation, so that the content matches the attributes
+	reader, err := handle.Generation(attrs.Generation).ReadCompressed(compressed).NewRangeReader(r.Context(), offset, length)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	header := w.Header()
+	header.Set("Content-Type", attrs.ContentType)
+	header.Set("Last-Modified", attrs.Updated.UTC().Format(http.TimeFormat))
+	header.Set("ETag", strconv.Quote(attrs.Etag))
+	header.Set("Accept-Ranges", "bytes")
+	header.Set("X-Goog-Generation", strconv.FormatInt(attrs.Generation, 10))
+	header.Set("X-Goog-Metageneration", strconv.FormatInt(attrs.Metageneration, 10))
+	header.Set("X-Goog-Stored-Content-Length", strconv.FormatInt(attrs.Size, 10))
+	header.Set("X-Goog-Stored-Content-Encoding", identity(attrs.ContentEncoding))
+	header.Set("X-Goog-Storage-Class", attrs.StorageClass)
+	header.Add("X-Goog-Hash", "crc32c="+encodeUint32(attrs.CRC32C))
+
+	if len(attrs.MD5) > 0 {
+		header.Add("X-Goog-Hash", "md5="+base64.StdEncoding.EncodeToString(attrs.MD5))
+	}
+
+	for key, value := range map[string]string{
+		"Cache-Control":       attrs.CacheControl,
+		"Content-Language":    attrs.ContentLanguage,
+		"Content-Disposition": attrs.ContentDisposition,
+	} {
+		if value != "" {
+			header.Set(key, value)
+		}
+	}
+
+	if !transcoded && attrs.ContentEncoding != "" {
+		header.Set("Content-Encoding", attrs.ContentEncoding)
+	}
+
+	for key, value := range attrs.Metadata {
+		header.Set("X-Goog-Meta-"+key, value)
+	}
+
+	code := http.StatusOK
+	// describe the content
+	if remain := reader.Remain(); remain >= 0 {
+		header.Set("Content-Length", strconv.FormatInt(remain, 10))
+		// report the range
+		if ranged {
+			start := offset
+			if start < 0 {
+				start = max(attrs.Size+offset, 0)
+			}
+
+			header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, start+remain-1, attrs.Size))
+			code = http.StatusPartialContent
+		}
+	}
+
+	w.WriteHeader(code)
+	// write the content
+	if r.Method != http.MethodHead {
+		io.Copy(w, reader)
+	}
+
+	return nil
+}
+
+// parseRange parses a single byte range of a Range header into an offset and a length.
+func parseRange(value string) (int64, int64, bool, error) {
+	if value == "" {
+		return 0, -1, false, nil
+	}
+
+	spec, ok := strings.CutPrefix(value, "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+	}
+
+	// bytes=-N selects the last N bytes
+	if strings.HasPrefix(spec, "-") {
+		suffix, err := strconv.ParseInt(spec[1:], 10, 64)
+		if err != nil {
+			return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+		}
+
+		return -suffix, -1, true, nil
+	}
+
+	first, last, _ := strings.Cut(spec, "-")
+
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil {
+		return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+	}
+
+	if last == "" {
+		return start, -1, start > 0, nil
+	}
+
+	end, err := strconv.ParseInt(last, 10, 64)
+	if err != nil || end < start {
+		return 0, 0, false, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+	}
+
+	return start, end - start + 1, true, nil
+}
+
+// serveUpload serves the upload endpoint of the JSON API.
+func (x *StorageEmulator) serveUpload(w http.ResponseWriter, r *http.Request, bucket string) {
+	query := r.URL.Query()
+
+	if id := query.Get("upload_id"); id != "" {
+		switch r.Method {
+		case http.MethodPut, http.MethodPost:
+			x.resume(w, r, id)
+		case http.MethodDelete:
+			x.cancel(w, id)
+		default:
+			writeMethodNotAllowed(w)
+		}
+
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		writeMethodNotAllowed(w)
+		return
+	}
+
+	switch query.Get("uploadType") {
+	case "media":
+		x.write(w, r, bucket, &raw.Object{Name: query.Get("name")}, r.Header.Get("Content-Type"), r.Body)
+	case "multipart":
+		x.writeMultipart(w, r, bucket)
+	case "resumable":
+		x.start(w, r, bucket)
+	default:
+		writeError(w, newError(http.StatusBadRequest, "Unsupported uploadType: %q", query.Get("uploadType")))
+	}
+}
+
+// writeMultipart handles a multipart/related upload with the metadata in the
+// first part and the content in the second part.
+func (x *StorageEmulator) writeMultipart(w http.ResponseWriter, r *http.Request, bucket string) {
+	kind, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(kind, "multipart/") {
+		writeError(w, newError(http.StatusBadRequest, "Multipart uploads require a multipart Content-Type."))
+		return
+	}
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	part, err := reader.NextPart()
+	if err != nil {
+		writeError(w, newError(http.StatusBadRequest, "Missing metadata part: %v", err))
+		return
+	}
+
+	body := &raw.Object{}
+	// decode the metadata
+	if _, err := decode(&http.Request{Body: part}, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if part, err = reader.NextPart(); err != nil {
+		writeError(w, newError(http.StatusBadRequest, "Missing media part: %v", err))
+		return
+	}
+
+	x.write(w, r, bucket, body, part.Header.Get("Content-Type"), part)
+}
+
+// write uploads the content as the object described by the metadata and
+// responds with the resulting object.
+func (x *StorageEmulator) write(w http.ResponseWriter, r *http.Request, bucket string, body *raw.Object, kind string, content io.Reader) {
+	writer, err := x.writer(r.Context(), r, bucket, body, kind)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if _, err := io.Copy(writer, content); err != nil {
+		writer.CloseWithError(err)
+		writeError(w, err)
+		return
+	}
+
+	if err := writer.Close(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawObject(baseURL(r), writer.Attrs()))
+}
+
+// writer creates an object writer for an upload request.
+func (x *StorageEmulator) writer(ctx context.Context, r *http.Request, bucket string, body *raw.Object, kind string) (storagev1.StorageObjectWriter, error) {
+	query := r.URL.Query()
+
+	name := query.Get("name")
+	if name == "" {
+		name = body.Name
+	}
+
+	if name == "" {
+		return nil, newError(http.StatusBadRequest, "Required parameter: name")
+	}
+
+	attrs, err := fromRawObject(body)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := x.object(r, bucket, name)
+	if err != nil {
+		return nil, err
+	}
+
+	writer := handle.NewWriter(ctx)
+	// prepare the attributes
+	target := writer.ObjectAttrs()
+	attrs.Bucket, attrs.Name = target.Bucket, target.Name
+	*target = *attrs
+
+	if target.ContentType == "" {
+		target.ContentType = kind
+	}
+
+	if kms := query.Get("kmsKeyName"); kms != "" {
+		target.KMSKeyName = kms
+	}
+
+	if body.Crc32c != "" {
+		value, err := decodeUint32(body.Crc32c)
+		if err != nil {
+			return nil, newError(http.StatusBadRequest, "Invalid crc32c: %v", err)
+		}
+
+		writer.SetCRC32C(value)
+	}
+
+	return writer, nil
+}
+
+// start starts a resumable upload session.
+func (x *StorageEmulator) start(w http.ResponseWriter, r *http.Request, bucket string) {
+	body := &raw.Object{}
+	// decode the metadata
+	if _, err := decode(r, body); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// the session outlives the request that starts it
+	writer, err := x.writer(context.WithoutCancel(r.Context()), r, bucket, body, r.Header.Get("X-Upload-Content-Type"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	data := make([]byte, 16)
+	// generate the session id
+	if _, err := rand.Read(data); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	id := hex.EncodeToString(data)
+
+	x.mu.Lock()
+	x.uploads[id] = &upload{writer: writer}
+	x.mu.Unlock()
+
+	location := &url.URL{Path: "/upload/storage/v1/b/" + bucket + "/o"}
+	// keep the original parameters
+	query := r.URL.Query()
+	query.Set("upload_id", id)
+	location.RawQuery = query.Encode()
+
+	w.Header().Set("Location", baseURL(r)+location.String())
+	w.Header().Set("X-GUploader-UploadID", id)
+	w.WriteHeader(http.StatusOK)
+}
+
+// resume uploads a chunk of a resumable upload session.
+func (x *StorageEmulator) resume(w http.ResponseWriter, r *http.Request, id string) {
+	x.mu.Lock()
+	session, ok := x.uploads[id]
+	x.mu.Unlock()
+
+	if !ok {
+		writeError(w, newError(http.StatusNotFound, "No such upload session."))
+		return
+	}
+
+	session.mu.Lock()
+	defer session.mu.Unlock()
+
+	start, total, err := parseContentRange(r.Header.Get("Content-Range"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	if start > session.offset {
+		writeError(w, newError(http.StatusBadRequest, "Invalid request. The chunk starts at %d but the session has persisted %d bytes.", start, session.offset))
+		return
+	}
+
+	content := &requestBody{reader: r.Body}
+	// skip the bytes that were already persisted by a previous attempt
+	if start >= 0 && start < session.offset {
+		if _, err := io.CopyN(io.Discard, content, session.offset-start); err != nil && !errors.Is(err, io.EOF) {
+			writeError(w, err)
+			return
+		}
+	}
+
+	n, err := io.Copy(session.writer, content)
+	session.offset += n
+
+	if err != nil {
+		// the client resumes an interrupted chunk from the persisted offset
+		if content.err != nil {
+			writeError(w, newError(http.StatusServiceUnavailable, "The upload was interrupted after %d bytes: %v", session.offset, content.err))
+			return
+		}
+
+		x.abort(id, err)
+		writeError(w, err)
+		return
+	}
+
+	if total < 0 || session.offset < total {
+		if session.offset > 0 {
+			w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", session.offset-1))
+		}
+		// the client asked to not use 308 for incomplete uploads
+		if r.Header.Get("X-GUploader-No-308") == "yes" {
+			w.Header().Set("X-Http-Status-Code-Override", "308")
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusPermanentRedirect)
+		}
+
+		return
+	}
+
+	x.mu.Lock()
+	delete(x.uploads, id)
+	x.mu.Unlock()
+
+	if value, ok := parseHash(r.Header, "crc32c"); ok {
+		checksum, err := decodeUint32(value)
+		if err != nil {
+			session.writer.CloseWithError(err)
+			writeError(w, newError(http.StatusBadRequest, "Invalid crc32c: %v", err))
+			return
+		}
+
+		session.writer.SetCRC32C(checksum)
+	}
+
+	if err := session.writer.Close(); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toRawObject(baseURL(r), session.writer.Attrs()))
+}
+
+// cancel cancels a resumable upload session.
+func (x *StorageEmulator) cancel(w http.ResponseWriter, id string) {
+	if !x.abort(id, errors.New("storage: upload canceled")) {
+		writeError(w, newError(http.StatusNotFound, "No such upload session."))
+		return
+	}
+
+	// the upload API reports a canceled session with status 499
+	w.WriteHeader(499)
+}
+
+// abort discards an upload session and reports whether it existed.
+func (x *StorageEmulator) abort(id string, err error) bool {
+	x.mu.Lock()
+	session, ok := x.uploads[id]
+	delete(x.uploads, id)
+	x.mu.Unlock()
+
+	if ok {
+		session.writer.CloseWithError(err)
+	}
+
+	return ok
+}
+
+// parseContentRange parses the Content-Range header of a chunk. The start is
+// -1 when the chunk carries no content and the total is -1 when it is unknown.
+func parseContentRange(value string) (int64, int64, error) {
+	spec, ok := strings.CutPrefix(value, "bytes ")
+	if !ok {
+		return 0, 0, newError(http.StatusBadRequest, "Invalid Content-Range: %q", value)
+	}
+
+	span, size, ok := strings.Cut(spec, "/")
+	if !ok {
+		return 0, 0, newError(http.StatusBadRequest, "Invalid Content-Range: %q", value)
+	}
+
+	var (
+		start = int64(-1)
+		total = int64(-1)
+		err   error
+	)
+
+	if size != "*" {
+		if total, err = strconv.ParseInt(size, 10, 64); err != nil {
+			return 0, 0, newError(http.StatusBadRequest, "Invalid Content-Range: %q", value)
+		}
+	}
+
+	if span != "*" {
+		first, _, _ := strings.Cut(span, "-")
+		// parse the first byte
+		if start, err = strconv.ParseInt(first, 10, 64); err != nil {
+			return 0, 0, newError(http.StatusBadRequest, "Invalid Content-Range: %q", value)
+		}
+	}
+
+	return start, total, nil
+}
+
+// parseHash returns the value of the given algorithm in the X-Goog-Hash headers.
+func parseHash(header http.Header, algorithm string) (string, bool) {
+	for _, spec := range header.Values("X-Goog-Hash") {
+		for _, value := range strings.Split(spec, ",") {
+			if value, ok := strings.CutPrefix(strings.TrimSpace(value), algorithm+"="); ok {
+				return value, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// objectXML returns the object handle for an XML API request with the
+// generation, the preconditions and the encryption key of the request.
+func (x *StorageEmulator) objectXML(r *http.Request, bucket, name string) (storagev1.StorageObjectHandle, error) {
+	handle := x.client.Bucket(bucket).Object(name)
+	// bill the requester
+	if project := r.Header.Get("X-Goog-User-Project"); project != "" {
+		handle = x.client.Bucket(bucket).UserProject(project).Object(name)
+	}
+
+	query := r.URL.Query()
+	// apply the generation
+	if query.Has("generation") {
+		generation, err := parseInt(query, "generation")
+		if err != nil {
+			return nil, err
+		}
+
+		handle = handle.Generation(generation)
+	}
+
+	headers := url.Values{}
+	// the XML API passes the preconditions as headers
+	for key, name := range map[string]string{
+		"x-goog-if-generation-match":     "ifGenerationMatch",
+		"x-goog-if-metageneration-match": "ifMetagenerationMatch",
+	} {
+		if value := r.Header.Get(key); value != "" {
+			headers.Set(name, value)
+		}
+	}
+
+	cond, ok, err := conditions(headers, "if")
+	if err != nil {
+		return nil, err
+	}
+
+	if ok {
+		handle = handle.If(cond)
+	}
+
+	key, err := encryptionKey(r.Header, "x-goog-encryption-key")
+	if err != nil {
+		return nil, err
+	}
+
+	if key != nil {
+		handle = handle.Key(key)
+	}
+
+	return handle, nil
+}
+
+// writeXML handles an XML API object upload.
+func (x *StorageEmulator) writeXML(w http.ResponseWriter, r *http.Request, handle storagev1.StorageObjectHandle) error {
+	writer := handle.NewWriter(r.Context())
+	// prepare the attributes
+	attrs := writer.ObjectAttrs()
+	attrs.ContentType = r.Header.Get("Content-Type")
+	attrs.ContentEncoding = r.Header.Get("Content-Encoding")
+	attrs.ContentLanguage = r.Header.Get("Content-Language")
+	attrs.ContentDisposition = r.Header.Get("Content-Disposition")
+	attrs.CacheControl = r.Header.Get("Cache-Control")
+	attrs.StorageClass = r.Header.Get("X-Goog-Storage-Class")
+
+	for key, values := range r.Header {
+		if name, ok := strings.CutPrefix(key, "X-Goog-Meta-"); ok && len(values) > 0 {
+			if attrs.Metadata == nil {
+				attrs.Metadata = make(map[string]string)
+			}
+
+			attrs.Metadata[strings.ToLower(name)] = values[0]
+		}
+	}
+
+	if value, ok := parseHash(r.Header, "md5"); ok {
+		data, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return newError(http.StatusBadRequest, "Invalid md5: %v", err)
+		}
+
+		attrs.MD5 = data
+	}
+
+	if value, ok := parseHash(r.Header, "crc32c"); ok {
+		checksum, err := decodeUint32(value)
+		if err != nil {
+			return newError(http.StatusBadRequest, "Invalid crc32c: %v", err)
+		}
+
+		writer.SetCRC32C(checksum)
+	}
+
+	if _, err := io.Copy(writer, r.Body); err != nil {
+		writer.CloseWithError(err)
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+
+	result := writer.Attrs()
+	// describe the object
+	w.Header().Set("ETag", strconv.Quote(result.Etag))
+	w.Header().Set("X-Goog-Generation", strconv.FormatInt(result.Generation, 10))
+	w.Header().Set("X-Goog-Metageneration", strconv.FormatInt(result.Metageneration, 10))
+	w.Header().Add("X-Goog-Hash", "crc32c="+encodeUint32(result.CRC32C))
+
+	if len(result.MD5) > 0 {
+		w.Header().Add("X-Goog-Hash", "md5="+base64.StdEncoding.EncodeToString(result.MD5))
+	}
+
+	w.WriteHeader(http.StatusOK)
+	// done!
+	return nil
+}
+
+// writeXMLError writes an error in the format of the XML API.
+func writeXMLError(w http.ResponseWriter, err error) {
+	code, message := errorCode(err)
+	// a 304 response has no body
+	if code == http.StatusNotModified {
+		w.WriteHeader(code)
+		return
+	}
+
+	response := struct {
+		XMLName xml.Name `xml:"Error"`
+		Code    string   `xml:"Code"`
+		Message string   `xml:"Message"`
+	}{
+		Code:    xmlErrorCode(code, err),
+		Message: message,
+	}
+
+	data, _ := xml.Marshal(response)
+	// write the error
+	w.Header().Set("Content-Type", "application/xml; charset=UTF-8")
+	w.WriteHeader(code)
+	w.Write([]byte(xml.Header))
+	w.Write(data)
+}
+
+// xmlErrorCode returns the XML API error code for a status code.
+func xmlErrorCode(code int, err error) string {
+	switch {
+	case errors.Is(err, storage.ErrBucketNotExist):
+		return "NoSuchBucket"
+	case code == http.StatusNotFound:
+		return "NoSuchKey"
+	case code == http.StatusPreconditionFailed:
+		return "PreconditionFailed"
+	case code == http.StatusRequestedRangeNotSatisfiable:
+		return "InvalidRange"
+	case code == http.StatusForbidden:
+		return "AccessDenied"
+	case code == http.StatusConflict:
+		return "Conflict"
+	default:
+		return "InvalidArgument"
+	}
+}
+
+// identity returns the content encoding, defaulting to identity.
+func identity(encoding string) string {
+	if encoding == "" {
+		return "identity"
+	}
+
+	return encoding
+}
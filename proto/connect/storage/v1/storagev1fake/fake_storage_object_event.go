@@ -8,6 +8,16 @@ import (
 )
 
 type FakeStorageObjectEvent struct {
+	GetEnvelopeStub        func() *storagev1.StorageObjectEventEnvelope
+	getEnvelopeMutex       sync.RWMutex
+	getEnvelopeArgsForCall []struct {
+	}
+	getEnvelopeReturns struct {
+		result1 *storagev1.StorageObjectEventEnvelope
+	}
+	getEnvelopeReturnsOnCall map[int]struct {
+		result1 *storagev1.StorageObjectEventEnvelope
+	}
 	GetObjectStub        func() *storagev1.StorageObject
 	getObjectMutex       sync.RWMutex
 	getObjectArgsForCall []struct {
@@ -18,6 +28,11 @@ type FakeStorageObjectEvent struct {
 	getObjectReturnsOnCall map[int]struct {
 		result1 *storagev1.StorageObject
 	}
+	SetEnvelopeStub        func(*storagev1.StorageObjectEventEnvelope)
+	setEnvelopeMutex       sync.RWMutex
+	setEnvelopeArgsForCall []struct {
+		arg1 *storagev1.StorageObjectEventEnvelope
+	}
 	SetObjectStub        func(*storagev1.StorageObject)
 	setObjectMutex       sync.RWMutex
 	setObjectArgsForCall []struct {
@@ -27,6 +42,59 @@ type FakeStorageObjectEvent struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeStorageObjectEvent) GetEnvelope() *storagev1.StorageObjectEventEnvelope {
+	fake.getEnvelopeMutex.Lock()
+	ret, specificReturn := fake.getEnvelopeReturnsOnCall[len(fake.getEnvelopeArgsForCall)]
+	fake.getEnvelopeArgsForCall = append(fake.getEnvelopeArgsForCall, struct {
+	}{})
+	stub := fake.GetEnvelopeStub
+	fakeReturns := fake.getEnvelopeReturns
+	fake.recordInvocation("GetEnvelope", []interface{}{})
+	fake.getEnvelopeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorageObjectEvent) GetEnvelopeCallCount() int {
+	fake.getEnvelopeMutex.RLock()
+	defer fake.getEnvelopeMutex.RUnlock()
+	return len(fake.getEnvelopeArgsForCall)
+}
+
+func (fake *FakeStorageObjectEvent) GetEnvelopeCalls(stub func() *storagev1.StorageObjectEventEnvelope) {
+	fake.getEnvelopeMutex.Lock()
+	defer fake.getEnvelopeMutex.Unlock()
+	fake.GetEnvelopeStub = stub
+}
+
+func (fake *FakeStorageObjectEvent) GetEnvelopeReturns(result1 *storagev1.StorageObjectEventEnvelope) {
+	fake.getEnvelopeMutex.Lock()
+	defer fake.getEnvelopeMutex.Unlock()
+	fake.GetEnvelopeStub = nil
+	fake.getEnvelopeReturns = struct {
+		result1 *storagev1.StorageObjectEventEnvelope
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEvent) GetEnvelopeReturnsOnCall(i int, result1 *storagev1.StorageObjectEventEnvelope) {
+	fake.getEnvelopeMutex.Lock()
+	defer fake.getEnvelopeMutex.Unlock()
+	fake.GetEnvelopeStub = nil
+	if fake.getEnvelopeReturnsOnCall == nil {
+		fake.getEnvelopeReturnsOnCall = make(map[int]struct {
+			result1 *storagev1.StorageObjectEventEnvelope
+		})
+	}
+	fake.getEnvelopeReturnsOnCall[i] = struct {
+		result1 *storagev1.StorageObjectEventEnvelope
+	}{result1}
+}
+
 func (fake *FakeStorageObjectEvent) GetObject() *storagev1.StorageObject {
 	fake.getObjectMutex.Lock()
 	ret, specificReturn := fake.getObjectReturnsOnCall[len(fake.getObjectArgsForCall)]
@@ -80,6 +148,38 @@ func (fake *FakeStorageObjectEvent) GetObjectReturnsOnCall(i int, result1 *stora
 	}{result1}
 }
 
+func (fake *FakeStorageObjectEvent) SetEnvelope(arg1 *storagev1.StorageObjectEventEnvelope) {
+	fake.setEnvelopeMutex.Lock()
+	fake.setEnvelopeArgsForCall = append(fake.setEnvelopeArgsForCall, struct {
+		arg1 *storagev1.StorageObjectEventEnvelope
+	}{arg1})
+	stub := fake.SetEnvelopeStub
+	fake.recordInvocation("SetEnvelope", []interface{}{arg1})
+	fake.setEnvelopeMutex.Unlock()
+	if stub != nil {
+		fake.SetEnvelopeStub(arg1)
+	}
+}
+
+func (fake *FakeStorageObjectEvent) SetEnvelopeCallCount() int {
+	fake.setEnvelopeMutex.RLock()
+	defer fake.setEnvelopeMutex.RUnlock()
+	return len(fake.setEnvelopeArgsForCall)
+}
+
+func (fake *FakeStorageObjectEvent) SetEnvelopeCalls(stub func(*storagev1.StorageObjectEventEnvelope)) {
+	fake.setEnvelopeMutex.Lock()
+	defer fake.setEnvelopeMutex.Unlock()
+	fake.SetEnvelopeStub = stub
+}
+
+func (fake *FakeStorageObjectEvent) SetEnvelopeArgsForCall(i int) *storagev1.StorageObjectEventEnvelope {
+	fake.setEnvelopeMutex.RLock()
+	defer fake.setEnvelopeMutex.RUnlock()
+	argsForCall := fake.setEnvelopeArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeStorageObjectEvent) SetObject(arg1 *storagev1.StorageObject) {
 	fake.setObjectMutex.Lock()
 	fake.setObjectArgsForCall = append(fake.setObjectArgsForCall, struct {
@@ -115,8 +215,12 @@ func (fake *FakeStorageObjectEvent) SetObjectArgsForCall(i int) *storagev1.Stora
 func (fake *FakeStorageObjectEvent) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getEnvelopeMutex.RLock()
+	defer fake.getEnvelopeMutex.RUnlock()
 	fake.getObjectMutex.RLock()
 	defer fake.getObjectMutex.RUnlock()
+	fake.setEnvelopeMutex.RLock()
+	defer fake.setEnvelopeMutex.RUnlock()
 	fake.setObjectMutex.RLock()
 	defer fake.setObjectMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,342 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package storagev1fake
+
+import (
+	"context"
+	"sync"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+type FakeStorageObjectEventKindHandler struct {
+	HandleStorageObjectArchivedEventStub        func(context.Context, *storagev1.StorageObjectArchivedEvent) error
+	handleStorageObjectArchivedEventMutex       sync.RWMutex
+	handleStorageObjectArchivedEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectArchivedEvent
+	}
+	handleStorageObjectArchivedEventReturns struct {
+		result1 error
+	}
+	handleStorageObjectArchivedEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HandleStorageObjectDeletedEventStub        func(context.Context, *storagev1.StorageObjectDeletedEvent) error
+	handleStorageObjectDeletedEventMutex       sync.RWMutex
+	handleStorageObjectDeletedEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectDeletedEvent
+	}
+	handleStorageObjectDeletedEventReturns struct {
+		result1 error
+	}
+	handleStorageObjectDeletedEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HandleStorageObjectFinalizedEventStub        func(context.Context, *storagev1.StorageObjectFinalizedEvent) error
+	handleStorageObjectFinalizedEventMutex       sync.RWMutex
+	handleStorageObjectFinalizedEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectFinalizedEvent
+	}
+	handleStorageObjectFinalizedEventReturns struct {
+		result1 error
+	}
+	handleStorageObjectFinalizedEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	HandleStorageObjectMetadataUpdatedEventStub        func(context.Context, *storagev1.StorageObjectMetadataUpdatedEvent) error
+	handleStorageObjectMetadataUpdatedEventMutex       sync.RWMutex
+	handleStorageObjectMetadataUpdatedEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectMetadataUpdatedEvent
+	}
+	handleStorageObjectMetadataUpdatedEventReturns struct {
+		result1 error
+	}
+	handleStorageObjectMetadataUpdatedEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEvent(arg1 context.Context, arg2 *storagev1.StorageObjectArchivedEvent) error {
+	fake.handleStorageObjectArchivedEventMutex.Lock()
+	ret, specificReturn := fake.handleStorageObjectArchivedEventReturnsOnCall[len(fake.handleStorageObjectArchivedEventArgsForCall)]
+	fake.handleStorageObjectArchivedEventArgsForCall = append(fake.handleStorageObjectArchivedEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectArchivedEvent
+	}{arg1, arg2})
+	stub := fake.HandleStorageObjectArchivedEventStub
+	fakeReturns := fake.handleStorageObjectArchivedEventReturns
+	fake.recordInvocation("HandleStorageObjectArchivedEvent", []interface{}{arg1, arg2})
+	fake.handleStorageObjectArchivedEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEventCallCount() int {
+	fake.handleStorageObjectArchivedEventMutex.RLock()
+	defer fake.handleStorageObjectArchivedEventMutex.RUnlock()
+	return len(fake.handleStorageObjectArchivedEventArgsForCall)
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEventCalls(stub func(context.Context, *storagev1.StorageObjectArchivedEvent) error) {
+	fake.handleStorageObjectArchivedEventMutex.Lock()
+	defer fake.handleStorageObjectArchivedEventMutex.Unlock()
+	fake.HandleStorageObjectArchivedEventStub = stub
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEventArgsForCall(i int) (context.Context, *storagev1.StorageObjectArchivedEvent) {
+	fake.handleStorageObjectArchivedEventMutex.RLock()
+	defer fake.handleStorageObjectArchivedEventMutex.RUnlock()
+	argsForCall := fake.handleStorageObjectArchivedEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEventReturns(result1 error) {
+	fake.handleStorageObjectArchivedEventMutex.Lock()
+	defer fake.handleStorageObjectArchivedEventMutex.Unlock()
+	fake.HandleStorageObjectArchivedEventStub = nil
+	fake.handleStorageObjectArchivedEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectArchivedEventReturnsOnCall(i int, result1 error) {
+	fake.handleStorageObjectArchivedEventMutex.Lock()
+	defer fake.handleStorageObjectArchivedEventMutex.Unlock()
+	fake.HandleStorageObjectArchivedEventStub = nil
+	if fake.handleStorageObjectArchivedEventReturnsOnCall == nil {
+		fake.handleStorageObjectArchivedEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleStorageObjectArchivedEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEvent(arg1 context.Context, arg2 *storagev1.StorageObjectDeletedEvent) error {
+	fake.handleStorageObjectDeletedEventMutex.Lock()
+	ret, specificReturn := fake.handleStorageObjectDeletedEventReturnsOnCall[len(fake.handleStorageObjectDeletedEventArgsForCall)]
+	fake.handleStorageObjectDeletedEventArgsForCall = append(fake.handleStorageObjectDeletedEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectDeletedEvent
+	}{arg1, arg2})
+	stub := fake.HandleStorageObjectDeletedEventStub
+	fakeReturns := fake.handleStorageObjectDeletedEventReturns
+	fake.recordInvocation("HandleStorageObjectDeletedEvent", []interface{}{arg1, arg2})
+	fake.handleStorageObjectDeletedEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEventCallCount() int {
+	fake.handleStorageObjectDeletedEventMutex.RLock()
+	defer fake.handleStorageObjectDeletedEventMutex.RUnlock()
+	return len(fake.handleStorageObjectDeletedEventArgsForCall)
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEventCalls(stub func(context.Context, *storagev1.StorageObjectDeletedEvent) error) {
+	fake.handleStorageObjectDeletedEventMutex.Lock()
+	defer fake.handleStorageObjectDeletedEventMutex.Unlock()
+	fake.HandleStorageObjectDeletedEventStub = stub
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEventArgsForCall(i int) (context.Context, *storagev1.StorageObjectDeletedEvent) {
+	fake.handleStorageObjectDeletedEventMutex.RLock()
+	defer fake.handleStorageObjectDeletedEventMutex.RUnlock()
+	argsForCall := fake.handleStorageObjectDeletedEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEventReturns(result1 error) {
+	fake.handleStorageObjectDeletedEventMutex.Lock()
+	defer fake.handleStorageObjectDeletedEventMutex.Unlock()
+	fake.HandleStorageObjectDeletedEventStub = nil
+	fake.handleStorageObjectDeletedEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectDeletedEventReturnsOnCall(i int, result1 error) {
+	fake.handleStorageObjectDeletedEventMutex.Lock()
+	defer fake.handleStorageObjectDeletedEventMutex.Unlock()
+	fake.HandleStorageObjectDeletedEventStub = nil
+	if fake.handleStorageObjectDeletedEventReturnsOnCall == nil {
+		fake.handleStorageObjectDeletedEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleStorageObjectDeletedEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEvent(arg1 context.Context, arg2 *storagev1.StorageObjectFinalizedEvent) error {
+	fake.handleStorageObjectFinalizedEventMutex.Lock()
+	ret, specificReturn := fake.handleStorageObjectFinalizedEventReturnsOnCall[len(fake.handleStorageObjectFinalizedEventArgsForCall)]
+	fake.handleStorageObjectFinalizedEventArgsForCall = append(fake.handleStorageObjectFinalizedEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectFinalizedEvent
+	}{arg1, arg2})
+	stub := fake.HandleStorageObjectFinalizedEventStub
+	fakeReturns := fake.handleStorageObjectFinalizedEventReturns
+	fake.recordInvocation("HandleStorageObjectFinalizedEvent", []interface{}{arg1, arg2})
+	fake.handleStorageObjectFinalizedEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEventCallCount() int {
+	fake.handleStorageObjectFinalizedEventMutex.RLock()
+	defer fake.handleStorageObjectFinalizedEventMutex.RUnlock()
+	return len(fake.handleStorageObjectFinalizedEventArgsForCall)
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEventCalls(stub func(context.Context, *storagev1.StorageObjectFinalizedEvent) error) {
+	fake.handleStorageObjectFinalizedEventMutex.Lock()
+	defer fake.handleStorageObjectFinalizedEventMutex.Unlock()
+	fake.HandleStorageObjectFinalizedEventStub = stub
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEventArgsForCall(i int) (context.Context, *storagev1.StorageObjectFinalizedEvent) {
+	fake.handleStorageObjectFinalizedEventMutex.RLock()
+	defer fake.handleStorageObjectFinalizedEventMutex.RUnlock()
+	argsForCall := fake.handleStorageObjectFinalizedEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEventReturns(result1 error) {
+	fake.handleStorageObjectFinalizedEventMutex.Lock()
+	defer fake.handleStorageObjectFinalizedEventMutex.Unlock()
+	fake.HandleStorageObjectFinalizedEventStub = nil
+	fake.handleStorageObjectFinalizedEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectFinalizedEventReturnsOnCall(i int, result1 error) {
+	fake.handleStorageObjectFinalizedEventMutex.Lock()
+	defer fake.handleStorageObjectFinalizedEventMutex.Unlock()
+	fake.HandleStorageObjectFinalizedEventStub = nil
+	if fake.handleStorageObjectFinalizedEventReturnsOnCall == nil {
+		fake.handleStorageObjectFinalizedEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleStorageObjectFinalizedEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEvent(arg1 context.Context, arg2 *storagev1.StorageObjectMetadataUpdatedEvent) error {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.Lock()
+	ret, specificReturn := fake.handleStorageObjectMetadataUpdatedEventReturnsOnCall[len(fake.handleStorageObjectMetadataUpdatedEventArgsForCall)]
+	fake.handleStorageObjectMetadataUpdatedEventArgsForCall = append(fake.handleStorageObjectMetadataUpdatedEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 *storagev1.StorageObjectMetadataUpdatedEvent
+	}{arg1, arg2})
+	stub := fake.HandleStorageObjectMetadataUpdatedEventStub
+	fakeReturns := fake.handleStorageObjectMetadataUpdatedEventReturns
+	fake.recordInvocation("HandleStorageObjectMetadataUpdatedEvent", []interface{}{arg1, arg2})
+	fake.handleStorageObjectMetadataUpdatedEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEventCallCount() int {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.RLock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.RUnlock()
+	return len(fake.handleStorageObjectMetadataUpdatedEventArgsForCall)
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEventCalls(stub func(context.Context, *storagev1.StorageObjectMetadataUpdatedEvent) error) {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.Lock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.Unlock()
+	fake.HandleStorageObjectMetadataUpdatedEventStub = stub
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEventArgsForCall(i int) (context.Context, *storagev1.StorageObjectMetadataUpdatedEvent) {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.RLock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.RUnlock()
+	argsForCall := fake.handleStorageObjectMetadataUpdatedEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEventReturns(result1 error) {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.Lock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.Unlock()
+	fake.HandleStorageObjectMetadataUpdatedEventStub = nil
+	fake.handleStorageObjectMetadataUpdatedEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) HandleStorageObjectMetadataUpdatedEventReturnsOnCall(i int, result1 error) {
+	fake.handleStorageObjectMetadataUpdatedEventMutex.Lock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.Unlock()
+	fake.HandleStorageObjectMetadataUpdatedEventStub = nil
+	if fake.handleStorageObjectMetadataUpdatedEventReturnsOnCall == nil {
+		fake.handleStorageObjectMetadataUpdatedEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.handleStorageObjectMetadataUpdatedEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeStorageObjectEventKindHandler) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.handleStorageObjectArchivedEventMutex.RLock()
+	defer fake.handleStorageObjectArchivedEventMutex.RUnlock()
+	fake.handleStorageObjectDeletedEventMutex.RLock()
+	defer fake.handleStorageObjectDeletedEventMutex.RUnlock()
+	fake.handleStorageObjectFinalizedEventMutex.RLock()
+	defer fake.handleStorageObjectFinalizedEventMutex.RUnlock()
+	fake.handleStorageObjectMetadataUpdatedEventMutex.RLock()
+	defer fake.handleStorageObjectMetadataUpdatedEventMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStorageObjectEventKindHandler) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ storagev1.StorageObjectEventKindHandler = new(FakeStorageObjectEventKindHandler)
@@ -0,0 +1,83 @@
+package storagev1fs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sync"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageTransferCheckpoint = &StorageTransferCheckpoint{}
+
+// StorageTransferCheckpoint represents a checkpoint of a transfer that keeps
+// its progress in a file, so that a transfer resumes after a restart.
+type StorageTransferCheckpoint struct {
+	checkpoint[*storagev1.StorageTransferState]
+}
+
+// NewStorageTransferCheckpoint creates a new StorageTransferCheckpoint kept in
+// the given file.
+func NewStorageTransferCheckpoint(name string) (*StorageTransferCheckpoint, error) {
+	name, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageTransferCheckpoint{checkpoint: checkpoint[*storagev1.StorageTransferState]{name: name}}, nil
+}
+
+var _ storagev1.StorageObjectEventCheckpoint = &StorageObjectEventCheckpoint{}
+
+// StorageObjectEventCheckpoint represents a checkpoint of a backfill that
+// keeps the name of the last processed object in a file, so that a backfill
+// resumes after a restart.
+type StorageObjectEventCheckpoint struct {
+	checkpoint[string]
+}
+
+// NewStorageObjectEventCheckpoint creates a new StorageObjectEventCheckpoint
+// kept in the given file.
+func NewStorageObjectEventCheckpoint(name string) (*StorageObjectEventCheckpoint, error) {
+	name, err := filepath.Abs(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StorageObjectEventCheckpoint{checkpoint: checkpoint[string]{name: name}}, nil
+}
+
+// checkpoint is a checkpoint of a state that keeps the JSON encoding of the
+// state in a file. The file is replaced atomically, so that it is never read
+// partially.
+type checkpoint[T any] struct {
+	mu   sync.Mutex
+	name string
+}
+
+// Load returns the saved state, or the zero state when the file does not
+// exist.
+func (x *checkpoint[T]) Load(ctx context.Context) (T, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	var state T
+	// read the file
+	if err := readJSON(x.name, &state); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		var zero T
+		return zero, err
+	}
+
+	// done!
+	return state, nil
+}
+
+// Save saves the state.
+func (x *checkpoint[T]) Save(ctx context.Context, state T) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// done!
+	return writeJSON(x.name, state)
+}
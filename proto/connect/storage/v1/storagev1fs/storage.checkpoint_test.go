@@ -0,0 +1,80 @@
+package storagev1fs_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fs"
+)
+
+func TestStorageTransferCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "transfer", "checkpoint.json")
+
+	checkpoint, err := storagev1fs.NewStorageTransferCheckpoint(name)
+	if err != nil {
+		t.Fatalf("NewStorageTransferCheckpoint: %v", err)
+	}
+
+	if state, err := checkpoint.Load(ctx); state != nil || err != nil {
+		t.Fatalf("Load() = %v, %v, want nil", state, err)
+	}
+
+	if err := checkpoint.Save(ctx, &storagev1.StorageTransferState{After: "b", Done: []string{"c", "d"}}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// the state survives a restart
+	checkpoint, err = storagev1fs.NewStorageTransferCheckpoint(name)
+	if err != nil {
+		t.Fatalf("NewStorageTransferCheckpoint: %v", err)
+	}
+
+	state, err := checkpoint.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if state.After != "b" || !slices.Equal(state.Done, []string{"c", "d"}) {
+		t.Errorf("Load() = %+v, want b and [c d]", state)
+	}
+
+	if err := os.WriteFile(name, []byte("{"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if _, err := checkpoint.Load(ctx); err == nil {
+		t.Errorf("Load() of a corrupt file error = nil, want an error")
+	}
+}
+
+func TestStorageObjectEventCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	name := filepath.Join(t.TempDir(), "checkpoint.json")
+
+	checkpoint, err := storagev1fs.NewStorageObjectEventCheckpoint(name)
+	if err != nil {
+		t.Fatalf("NewStorageObjectEventCheckpoint: %v", err)
+	}
+
+	if name, err := checkpoint.Load(ctx); name != "" || err != nil {
+		t.Fatalf("Load() = %q, %v, want empty", name, err)
+	}
+
+	if err := checkpoint.Save(ctx, "logs/a"); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	checkpoint, err = storagev1fs.NewStorageObjectEventCheckpoint(name)
+	if err != nil {
+		t.Fatalf("NewStorageObjectEventCheckpoint: %v", err)
+	}
+
+	if name, err := checkpoint.Load(ctx); name != "logs/a" || err != nil {
+		t.Errorf("Load() = %q, %v, want logs/a", name, err)
+	}
+}
@@ -0,0 +1,349 @@
+package storagev1fs
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// lockTimeout is the age after which the lock of a key is left by a process
+// that has stopped, and is broken.
+const lockTimeout = time.Minute
+
+var _ storagev1.StorageObjectEventStore = &StorageObjectEventStore{}
+
+// StorageObjectEventStore represents a store of the handled storage object
+// events that keeps every key as a file in a directory, so that the keys
+// survive a restart and are shared by the processes that use the directory.
+// A key is claimed by creating its file, which fails when another process has
+// claimed it. The file of a claim records the token that Acquire returned for
+// it, so that a delivery whose lease has expired does not complete or release
+// the claim of another delivery, in this process or another one. The keys that are done expire after the TTL and are removed
+// by Prune.
+type StorageObjectEventStore struct {
+	mu  sync.Mutex
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+// eventRecord is the content of the file of a key.
+type eventRecord struct {
+	Key     string    `json:"key"`
+	Token   string    `json:"token,omitempty"`
+	Done    bool      `json:"done,omitempty"`
+	Expires time.Time `json:"expires,omitzero"`
+}
+
+// NewStorageObjectEventStore creates a new StorageObjectEventStore in the
+// given directory that keeps the keys that are done for the ttl. A zero ttl
+// keeps the keys forever.
+func NewStorageObjectEventStore(dir string, ttl time.Duration) (*StorageObjectEventStore, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	store := &StorageObjectEventStore{
+		dir: dir,
+		ttl: ttl,
+		now: time.Now,
+	}
+
+	return store, nil
+}
+
+// SetClock sets the clock used to expire the keys.
+func (x *StorageObjectEventStore) SetClock(fn func() time.Time) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the clock
+	x.now = fn
+}
+
+// Acquire implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Acquire(ctx context.Context, key string, lease time.Duration) (storagev1.StorageObjectEventState, string, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	name := x.path(key)
+	now := x.now()
+	claim := &eventRecord{Key: key, Token: rand.Text(), Expires: now.Add(lease)}
+
+	for {
+		// claim the key
+		switch err := x.create(name, claim); {
+		case err == nil:
+			return storagev1.StorageObjectEventStateNew, claim.Token, nil
+		case !errors.Is(err, fs.ErrExist):
+			return storagev1.StorageObjectEventStatePending, "", err
+		}
+
+		record, err := readRecord(name)
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			// the key has been released meanwhile
+			continue
+		case err != nil:
+			return storagev1.StorageObjectEventStatePending, "", err
+		}
+
+		if !record.expired(now) {
+			if record.Done {
+				return storagev1.StorageObjectEventStateDone, "", nil
+			}
+
+			return storagev1.StorageObjectEventStatePending, "", nil
+		}
+
+		// take the expired key over
+		switch ok, err := x.takeover(ctx, name, claim, now); {
+		case err != nil:
+			return storagev1.StorageObjectEventStatePending, "", err
+		case ok:
+			return storagev1.StorageObjectEventStateNew, claim.Token, nil
+		}
+	}
+}
+
+// takeover replaces the record of an expired key with the claim. It reports
+// false when the key is no longer expired, because another process has
+// released it or taken it over meanwhile.
+func (x *StorageObjectEventStore) takeover(ctx context.Context, name string, claim *eventRecord, now time.Time) (bool, error) {
+	unlock, err := x.lock(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	record, err := readRecord(name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return false, nil
+	case err != nil:
+		return false, err
+	case !record.expired(now):
+		return false, nil
+	}
+
+	if err := writeJSON(name, claim); err != nil {
+		return false, err
+	}
+
+	// done!
+	return true, nil
+}
+
+// Complete implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Complete(ctx context.Context, key, token string) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	name := x.path(key)
+
+	unlock, err := x.lock(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// the claim must still be ours
+	switch record, err := readRecord(name); {
+	case errors.Is(err, fs.ErrNotExist):
+		return storagev1.ErrStorageEventClaimLost
+	case err != nil:
+		return err
+	case record.Token != token:
+		return storagev1.ErrStorageEventClaimLost
+	}
+
+	record := &eventRecord{Key: key, Token: token, Done: true}
+	// expire the key after the ttl
+	if x.ttl > 0 {
+		record.Expires = x.now().Add(x.ttl)
+	}
+
+	// done!
+	return writeJSON(name, record)
+}
+
+// Release implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Release(ctx context.Context, key, token string) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	name := x.path(key)
+
+	unlock, err := x.lock(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	switch record, err := readRecord(name); {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		return err
+	case record.Token != token:
+		return nil
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// done!
+	return nil
+}
+
+// Prune removes the files of the expired keys.
+func (x *StorageObjectEventStore) Prune(ctx context.Context) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	entries, err := os.ReadDir(x.dir)
+	if err != nil {
+		return err
+	}
+
+	now := x.now()
+
+	for _, entry := range entries {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		if err := x.prune(ctx, filepath.Join(x.dir, entry.Name()), now); err != nil {
+			return err
+		}
+	}
+
+	// done!
+	return nil
+}
+
+// prune removes the file of a key when it has expired.
+func (x *StorageObjectEventStore) prune(ctx context.Context, name string, now time.Time) error {
+	unlock, err := x.lock(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	record, err := readRecord(name)
+	if err != nil || !record.expired(now) {
+		return nil
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	// done!
+	return nil
+}
+
+// lock takes the lock of the file of a key by creating the lock file, which
+// serializes the changes of an existing file between the processes. It waits
+// while another process holds the lock, and it breaks a lock that is older
+// than lockTimeout.
+func (x *StorageObjectEventStore) lock(ctx context.Context, name string) (func(), error) {
+	lock := filepath.Join(x.dir, "."+filepath.Base(name)+".lock")
+
+	for {
+		file, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o644)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(lock) }, nil
+		}
+
+		if !errors.Is(err, fs.ErrExist) {
+			return nil, err
+		}
+
+		if info, err := os.Stat(lock); err == nil && time.Since(info.ModTime()) > lockTimeout {
+			os.Remove(lock)
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+// path returns the file of a key, which is named after the hash of the key.
+func (x *StorageObjectEventStore) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(x.dir, hex.EncodeToString(sum[:]))
+}
+
+// create creates the file of a record. It fails with fs.ErrExist when the
+// file exists. The file is linked in place once it is written, so that it is
+// never read partially.
+func (x *StorageObjectEventStore) create(name string, record *eventRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(x.dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	// done!
+	return os.Link(file.Name(), name)
+}
+
+// expired reports whether the lease or the ttl of the record has expired.
+func (x *eventRecord) expired(now time.Time) bool {
+	return !x.Expires.IsZero() && !now.Before(x.Expires)
+}
+
+func readRecord(name string) (*eventRecord, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &eventRecord{}
+	if err := json.Unmarshal(data, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
@@ -0,0 +1,253 @@
+package storagev1fs_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fs"
+)
+
+func newEventStore(t *testing.T, dir string, ttl time.Duration, now *time.Time) *storagev1fs.StorageObjectEventStore {
+	t.Helper()
+
+	store, err := storagev1fs.NewStorageObjectEventStore(dir, ttl)
+	if err != nil {
+		t.Fatalf("NewStorageObjectEventStore: %v", err)
+	}
+
+	store.SetClock(func() time.Time { return *now })
+
+	return store
+}
+
+func acquire(t *testing.T, store storagev1.StorageObjectEventStore, key string, lease time.Duration) (storagev1.StorageObjectEventState, string) {
+	t.Helper()
+
+	state, token, err := store.Acquire(context.Background(), key, lease)
+	if err != nil {
+		t.Fatalf("Acquire(%q): %v", key, err)
+	}
+
+	if (state == storagev1.StorageObjectEventStateNew) != (token != "") {
+		t.Fatalf("Acquire(%q) = %v with token %q", key, state, token)
+	}
+
+	return state, token
+}
+
+func TestStorageObjectEventStore(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := newEventStore(t, dir, time.Hour, &now)
+
+	got, first := acquire(t, store, "a", time.Minute)
+	if got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	// another process shares the claims of the directory
+	other := newEventStore(t, dir, time.Hour, &now)
+	if got, _ := acquire(t, other, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() of a claimed key = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+
+	// the lease expires
+	now = now.Add(2 * time.Minute)
+	got, second := acquire(t, other, "a", time.Minute)
+	if got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() after the lease = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	if err := other.Complete(context.Background(), "a", second); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	// the done key survives a restart
+	store = newEventStore(t, dir, time.Hour, &now)
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateDone {
+		t.Errorf("Acquire() of a done key = %v, want %v", got, storagev1.StorageObjectEventStateDone)
+	}
+
+	// only the token of the claim releases it
+	if err := store.Release(context.Background(), "a", first); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateDone {
+		t.Errorf("Acquire() after the Release of another claim = %v, want %v", got, storagev1.StorageObjectEventStateDone)
+	}
+
+	if err := store.Release(context.Background(), "a", second); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() after Release = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	if err := store.Release(context.Background(), "unknown", first); err != nil {
+		t.Errorf("Release() of an unknown key error = %v, want nil", err)
+	}
+}
+
+func TestStorageObjectEventStoreClaimLost(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := newEventStore(t, dir, time.Hour, &now)
+	_, first := acquire(t, store, "a", time.Minute)
+
+	// another delivery of the same process takes the expired key over
+	now = now.Add(2 * time.Minute)
+	got, second := acquire(t, store, "a", time.Minute)
+	if got != storagev1.StorageObjectEventStateNew || second == first {
+		t.Fatalf("Acquire() after the lease = %v, %q, want a new claim", got, second)
+	}
+
+	// the late release of the first delivery leaves the claim in place
+	if err := store.Release(context.Background(), "a", first); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() after a stale Release = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+
+	if err := store.Complete(context.Background(), "a", first); !errors.Is(err, storagev1.ErrStorageEventClaimLost) {
+		t.Errorf("Complete() of a lost claim error = %v, want %v", err, storagev1.ErrStorageEventClaimLost)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() after a stale Complete = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+
+	if err := store.Complete(context.Background(), "a", second); err != nil {
+		t.Errorf("Complete() error = %v, want nil", err)
+	}
+
+	if err := store.Complete(context.Background(), "unknown", first); !errors.Is(err, storagev1.ErrStorageEventClaimLost) {
+		t.Errorf("Complete() of an unknown key error = %v, want %v", err, storagev1.ErrStorageEventClaimLost)
+	}
+}
+
+func TestStorageObjectEventStorePrune(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := newEventStore(t, dir, time.Hour, &now)
+
+	_, token := acquire(t, store, "a", time.Minute)
+	acquire(t, store, "b", time.Minute)
+
+	if err := store.Complete(context.Background(), "a", token); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	// a keeps its ttl while the lease of b expires
+	now = now.Add(30 * time.Minute)
+	if err := store.Prune(context.Background()); err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir: %v", err)
+	}
+
+	if len(entries) != 1 {
+		t.Errorf("files after Prune = %d, want 1", len(entries))
+	}
+
+	now = now.Add(time.Hour)
+	if err := store.Prune(context.Background()); err != nil {
+		t.Fatalf("Prune: %v", err)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("files after the ttl = %d, want 0", len(entries))
+	}
+}
+
+func TestStorageObjectEventStoreConcurrentAcquire(t *testing.T) {
+	dir := t.TempDir()
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		claims int
+	)
+
+	// every store stands for a process that shares the directory
+	for range 8 {
+		store, err := storagev1fs.NewStorageObjectEventStore(dir, 0)
+		if err != nil {
+			t.Fatalf("NewStorageObjectEventStore: %v", err)
+		}
+
+		wg.Go(func() {
+			state, _, err := store.Acquire(context.Background(), "a", time.Minute)
+			if err != nil {
+				t.Errorf("Acquire: %v", err)
+				return
+			}
+
+			if state == storagev1.StorageObjectEventStateNew {
+				mu.Lock()
+				claims++
+				mu.Unlock()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if claims != 1 {
+		t.Errorf("claims = %d, want 1", claims)
+	}
+}
+
+func TestStorageObjectEventStoreConcurrentTakeover(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	acquire(t, newEventStore(t, dir, 0, &now), "a", time.Minute)
+
+	// the lease expires before the processes race for the key
+	later := now.Add(2 * time.Minute)
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		claims int
+	)
+
+	for range 8 {
+		store := newEventStore(t, dir, 0, &later)
+
+		wg.Go(func() {
+			state, _, err := store.Acquire(context.Background(), "a", time.Minute)
+			if err != nil {
+				t.Errorf("Acquire: %v", err)
+				return
+			}
+
+			if state == storagev1.StorageObjectEventStateNew {
+				mu.Lock()
+				claims++
+				mu.Unlock()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if claims != 1 {
+		t.Errorf("claims = %d, want 1", claims)
+	}
+}
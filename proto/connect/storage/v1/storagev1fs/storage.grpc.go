@@ -0,0 +1,1754 @@
+package storagev1fs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// DefaultPageSize is the number of items an iterator fetches per page.
+const DefaultPageSize = 1000
+
+// ErrWriterClosed is returned when writing to a closed writer.
+var ErrWriterClosed = errors.New("storage: writer is closed")
+
+// ErrReaderClosed is returned when reading from a closed reader.
+var ErrReaderClosed = errors.New("storage: reader is closed")
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents a storage service client that keeps the
+// buckets as directories and the objects as files on the local filesystem.
+type StorageServiceClient struct {
+	store *store
+}
+
+// NewStorageServiceClient creates a new StorageServiceClient rooted at the given directory.
+func NewStorageServiceClient(root string) (*StorageServiceClient, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, MetadataDir), 0o755); err != nil {
+		return nil, err
+	}
+
+	client := &StorageServiceClient{
+		store: &store{root: root, now: time.Now},
+	}
+
+	return client, nil
+}
+
+// SetNotificationHandler sets the handler of the events that the notification
+// configs of the buckets publish. The events are delivered synchronously once
+// the change is done, and they are not redelivered when the handler fails.
+func (x *StorageServiceClient) SetNotificationHandler(handler storagev1.StorageObjectEventHandler) {
+	x.store.notifier.SetHandler(handler)
+}
+
+// SetNotificationErrorHandler sets the handler of the notifications that
+// cannot be delivered, such as the events that the notification handler
+// fails. They are logged with the default logger when it is not set.
+func (x *StorageServiceClient) SetNotificationErrorHandler(fn func(context.Context, error)) {
+	x.store.notifier.SetErrorHandler(fn)
+}
+
+// SetClock sets the clock used to stamp generations and times.
+func (x *StorageServiceClient) SetClock(fn func() time.Time) {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+	// set the clock
+	x.store.now = fn
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{store: x.store, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{ctx: ctx, store: x.store, projectID: projectID, page: page{size: DefaultPageSize}}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return nil
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents a filesystem storage bucket handle.
+type StorageBucketHandle struct {
+	store       *store
+	name        string
+	conds       *storage.BucketConditions
+	userProject string
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.name, kind: bucketACL}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	if n.ID != "" {
+		return nil, errors.New("storage: AddNotification: ID must not be set")
+	}
+
+	if n.TopicProjectID == "" {
+		return nil, errors.New("storage: AddNotification: missing TopicProjectID")
+	}
+
+	if n.TopicID == "" {
+		return nil, errors.New("storage: AddNotification: missing TopicID")
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	b.NotificationID++
+	// prepare the notification
+	result := cloneNotification(n)
+	result.ID = strconv.Itoa(b.NotificationID)
+
+	if result.PayloadFormat == "" {
+		result.PayloadFormat = storage.JSONPayload
+	}
+
+	b.Notifications[result.ID] = result
+	// store the bucket
+	if err := x.store.saveBucket(x.name, b); err != nil {
+		return nil, err
+	}
+
+	return cloneNotification(result), nil
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return nil, err
+	}
+
+	return b.Attrs, nil
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if projectID == "" {
+		return errors.New("storage: missing project ID")
+	}
+
+	if !bucketNamePattern.MatchString(x.name) {
+		return newError(http.StatusBadRequest, "Invalid bucket name: '%s'", x.name)
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	if _, err := os.Stat(x.store.bucketDir(x.name)); err == nil {
+		return newError(http.StatusConflict, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	if attrs == nil {
+		attrs = &storage.BucketAttrs{}
+	}
+
+	now := x.store.now().UTC()
+	// prepare the attributes
+	result := *attrs
+	result.Name = x.name
+	result.Created = now
+	result.Updated = now
+	result.MetaGeneration = 1
+	result.Etag = etag(1, 1)
+
+	if result.Location == "" {
+		result.Location = "US"
+		result.LocationType = "multi-region"
+	}
+
+	if result.StorageClass == "" {
+		result.StorageClass = "STANDARD"
+	}
+
+	if attrs.RetentionPolicy != nil {
+		result.RetentionPolicy = &storage.RetentionPolicy{
+			RetentionPeriod: attrs.RetentionPolicy.RetentionPeriod,
+			EffectiveTime:   now,
+		}
+	}
+
+	if err := os.MkdirAll(x.store.bucketDir(x.name), 0o755); err != nil {
+		return err
+	}
+
+	return x.store.saveBucket(x.name, &bucket{ProjectID: projectID, Attrs: &result})
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.name, kind: defaultObjectACL}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return err
+	}
+
+	empty, err := x.store.empty(x.name)
+	if err != nil {
+		return err
+	}
+
+	if !empty {
+		return newError(http.StatusConflict, "The bucket you tried to delete is not empty.")
+	}
+
+	if err := os.RemoveAll(x.store.metadataDir(x.name)); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(x.store.bucketDir(x.name))
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := b.Notifications[id]; !ok {
+		return newError(http.StatusNotFound, "Not Found")
+	}
+
+	delete(b.Notifications, id)
+	// store the bucket
+	return x.store.saveBucket(x.name, b)
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(cond storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	var metageneration int64
+	// the lock requires a metageneration precondition
+	if x.conds != nil {
+		metageneration = x.conds.MetagenerationMatch
+	}
+
+	if metageneration != b.Attrs.MetaGeneration {
+		return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+	}
+
+	if b.Attrs.RetentionPolicy == nil {
+		return newError(http.StatusBadRequest, "Bucket '%s' does not have a retention policy.", x.name)
+	}
+
+	b.Attrs.RetentionPolicy.IsLocked = true
+	b.Attrs.MetaGeneration++
+	b.Attrs.Etag = etag(1, b.Attrs.MetaGeneration)
+	// store the bucket
+	return x.store.saveBucket(x.name, b)
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.Notifications, nil
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{store: x.store, bucket: x.name, object: name, gen: -1}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	if query == nil {
+		query = &storage.Query{}
+	}
+
+	iterator := &StorageObjectIterator{
+		ctx:    ctx,
+		store:  x.store,
+		bucket: x.name,
+		query:  *query,
+		page:   page{size: DefaultPageSize},
+	}
+
+	return iterator
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return nil, err
+	}
+
+	result := cloneBucketAttrs(b.Attrs)
+	// apply the changes
+	if attrs.VersioningEnabled != nil {
+		result.VersioningEnabled = attrs.VersioningEnabled.(bool)
+	}
+
+	if attrs.RequesterPays != nil {
+		result.RequesterPays = attrs.RequesterPays.(bool)
+	}
+
+	if attrs.DefaultEventBasedHold != nil {
+		result.DefaultEventBasedHold = attrs.DefaultEventBasedHold.(bool)
+	}
+
+	if attrs.BucketPolicyOnly != nil {
+		result.BucketPolicyOnly = *attrs.BucketPolicyOnly
+	}
+
+	if attrs.UniformBucketLevelAccess != nil {
+		result.UniformBucketLevelAccess = *attrs.UniformBucketLevelAccess
+	}
+
+	if attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		result.PublicAccessPrevention = attrs.PublicAccessPrevention
+	}
+
+	if attrs.StorageClass != "" {
+		result.StorageClass = attrs.StorageClass
+	}
+
+	if attrs.RetentionPolicy != nil {
+		locked := result.RetentionPolicy != nil && result.RetentionPolicy.IsLocked
+		// a locked policy can only be extended
+		if locked && attrs.RetentionPolicy.RetentionPeriod < result.RetentionPolicy.RetentionPeriod {
+			return nil, newError(http.StatusForbidden, "Cannot reduce retention duration of a locked Retention Policy for bucket '%s'.", x.name)
+		}
+
+		if attrs.RetentionPolicy.RetentionPeriod == 0 {
+			result.RetentionPolicy = nil
+		} else {
+			result.RetentionPolicy = &storage.RetentionPolicy{
+				RetentionPeriod: attrs.RetentionPolicy.RetentionPeriod,
+				EffectiveTime:   x.store.now().UTC(),
+				IsLocked:        locked,
+			}
+		}
+	}
+
+	if attrs.CORS != nil {
+		result.CORS = attrs.CORS
+	}
+
+	if attrs.Encryption != nil {
+		result.Encryption = attrs.Encryption
+	}
+
+	if attrs.Lifecycle != nil {
+		result.Lifecycle = *attrs.Lifecycle
+	}
+
+	if attrs.Logging != nil {
+		result.Logging = attrs.Logging
+	}
+
+	if attrs.Website != nil {
+		result.Website = attrs.Website
+	}
+
+	set, unset, err := storagev1.StorageBucketLabelsToUpdate(&attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the labels
+	if len(set) > 0 || len(unset) > 0 {
+		if result.Labels == nil {
+			result.Labels = make(map[string]string)
+		}
+
+		for k, v := range set {
+			result.Labels[k] = v
+		}
+
+		for _, k := range unset {
+			delete(result.Labels, k)
+		}
+	}
+
+	result.MetaGeneration++
+	result.Updated = x.store.now().UTC()
+	result.Etag = etag(1, result.MetaGeneration)
+
+	updated := *b
+	updated.Attrs = result
+	// store the bucket
+	if err := x.store.saveBucket(x.name, &updated); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.userProject = projectID
+	// done!
+	return &handle
+}
+
+type page struct {
+	token string
+	size  int
+	items int
+	done  bool
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents a filesystem storage bucket iterator.
+type StorageBucketIterator struct {
+	ctx       context.Context
+	store     *store
+	projectID string
+	items     []*storage.BucketAttrs
+	page      page
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		if err := x.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageBucketIterator) fetch() error {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	entries, err := os.ReadDir(x.store.root)
+	if err != nil {
+		return err
+	}
+
+	var items []*storage.BucketAttrs
+	// collect the buckets
+	for _, entry := range entries {
+		if !entry.IsDir() || entry.Name() <= x.page.token {
+			continue
+		}
+
+		b, err := x.store.bucket(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		if b.ProjectID == x.projectID {
+			items = append(items, b.Attrs)
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	if len(items) > x.page.size {
+		items = items[:x.page.size]
+		x.page.token = items[len(items)-1].Name
+	} else {
+		x.page.token = ""
+		x.page.done = true
+	}
+
+	x.items = items
+	x.page.items = len(x.items)
+	// done!
+	return nil
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+type aclKind int
+
+const (
+	bucketACL aclKind = iota
+	defaultObjectACL
+	objectACL
+)
+
+// StorageACLHandle represents a filesystem storage ACL handle.
+type StorageACLHandle struct {
+	store  *store
+	bucket string
+	object *StorageObjectHandle
+	kind   aclKind
+}
+
+// modify loads the rules, applies fn and stores the rules if fn changed them.
+func (x *StorageACLHandle) modify(fn func(*[]storage.ACLRule) (bool, error)) error {
+	b, err := x.store.bucket(x.bucket)
+	if err != nil {
+		return err
+	}
+
+	switch x.kind {
+	case bucketACL, defaultObjectACL:
+		rules := &b.Attrs.ACL
+		if x.kind == defaultObjectACL {
+			rules = &b.Attrs.DefaultObjectACL
+		}
+
+		changed, err := fn(rules)
+		if err != nil || !changed {
+			return err
+		}
+
+		return x.store.saveBucket(x.bucket, b)
+	}
+
+	_, obj, err := x.store.object(x.object)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil {
+		return storage.ErrObjectNotExist
+	}
+
+	changed, err := fn(&obj.attrs.ACL)
+	if err != nil || !changed {
+		return err
+	}
+
+	return x.store.save(obj)
+}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	return x.modify(func(rules *[]storage.ACLRule) (bool, error) {
+		for index, rule := range *rules {
+			if rule.Entity == entity {
+				*rules = append((*rules)[:index:index], (*rules)[index+1:]...)
+				return true, nil
+			}
+		}
+
+		return false, newError(http.StatusNotFound, "Not Found")
+	})
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	collection := []storage.ACLRule{}
+	// collect the rules
+	err := x.modify(func(rules *[]storage.ACLRule) (bool, error) {
+		collection = append(collection, *rules...)
+		return false, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	return x.modify(func(rules *[]storage.ACLRule) (bool, error) {
+		for index, rule := range *rules {
+			if rule.Entity == entity {
+				(*rules)[index].Role = role
+				return true, nil
+			}
+		}
+
+		*rules = append(*rules, storage.ACLRule{Entity: entity, Role: role})
+		// done!
+		return true, nil
+	})
+}
+
+var _ storagev1.StorageObjectHandle = &StorageObjectHandle{}
+
+// StorageObjectHandle represents a filesystem storage object handle.
+type StorageObjectHandle struct {
+	store      *store
+	bucket     string
+	object     string
+	gen        int64
+	conds      *storage.Conditions
+	key        []byte
+	compressed bool
+}
+
+func (x *StorageObjectHandle) validate() error {
+	if x.bucket == "" {
+		return errors.New("storage: bucket name is empty")
+	}
+
+	if x.object == "" {
+		return errors.New("storage: object name is empty")
+	}
+
+	if x.object == "." || x.object == ".." {
+		return fmt.Errorf("storage: object name %q is not valid", x.object)
+	}
+
+	// the name has to map onto a relative path
+	for index, segment := range strings.Split(x.object, "/") {
+		last := index == strings.Count(x.object, "/")
+
+		if (segment == "" && !last) || segment == "." || segment == ".." || strings.ContainsAny(segment, "\x00\\") {
+			return newError(http.StatusBadRequest, "Object name %q cannot be stored by the filesystem backend.", x.object)
+		}
+	}
+
+	if len(x.key) > 0 && len(x.key) != 32 {
+		return errors.New("storage: customer-supplied encryption key must be 32 bytes")
+	}
+
+	return nil
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.bucket, object: x, kind: objectACL}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("Attrs", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	_, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, false); err != nil {
+		return nil, err
+	}
+
+	return obj.attrs, nil
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(handles ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	return &StorageObjectComposer{dst: x, srcs: handles}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(handle storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	return &StorageObjectCopier{dst: x, src: handle}
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	if err := x.validate(); err != nil {
+		return err
+	}
+
+	if err := validateConditions("Delete", x.conds); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	defer x.store.notifier.Flush(ctx)
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, obj, err := x.store.object(x)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil {
+		return storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, true); err != nil {
+		return err
+	}
+
+	if err := x.store.checkMutable(obj); err != nil {
+		return err
+	}
+
+	now := x.store.now().UTC()
+	kind := storagev1.StorageObjectEventType_OBJECT_DELETE
+
+	if obj.live {
+		kind = storagev1.RetiredStorageObjectEventType(b.Attrs)
+		err = x.store.archive(b, obj, now)
+	} else {
+		err = x.store.purge(obj)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	x.store.notifier.Notify(b.Notifications, &storagev1.StorageObjectChange{
+		Type:   kind,
+		Object: obj.attrs,
+		Time:   now,
+	})
+
+	return nil
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.gen = gen
+	// done!
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(cond storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(data []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.key = append([]byte(nil), data...)
+	// done!
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset int64, length int64) (storagev1.StorageObjectReader, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if offset < 0 && length >= 0 {
+		return nil, fmt.Errorf("storage: invalid offset %d < 0 requires negative length", offset)
+	}
+
+	if err := validateConditions("NewRangeReader", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	_, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, false); err != nil {
+		return nil, err
+	}
+
+	if err := checkKey(x.key, obj); err != nil {
+		return nil, err
+	}
+
+	reader := &StorageObjectReader{
+		ctx:             ctx,
+		size:            obj.attrs.Size,
+		remain:          -1,
+		contentType:     obj.attrs.ContentType,
+		contentEncoding: obj.attrs.ContentEncoding,
+		cacheControl:    obj.attrs.CacheControl,
+		generation:      obj.attrs.Generation,
+	}
+
+	if strings.HasSuffix(x.object, "/") {
+		reader.reader = bytes.NewReader(nil)
+		reader.remain = 0
+		// done!
+		return reader, nil
+	}
+
+	file, err := os.Open(obj.path)
+	if err != nil {
+		return nil, err
+	}
+
+	reader.closer = file
+	// serve gzip objects decompressed
+	if !x.compressed && obj.attrs.ContentEncoding == "gzip" {
+		stream, err := gzip.NewReader(file)
+		if err != nil {
+			file.Close()
+			return nil, err
+		}
+
+		reader.reader = stream
+		reader.contentEncoding = ""
+		// decompressed objects are served whole
+		return reader, nil
+	}
+
+	size := obj.attrs.Size
+	// resolve the range
+	switch {
+	case offset < 0:
+		offset = max(size+offset, 0)
+	case offset > size || (offset == size && size > 0):
+		file.Close()
+		return nil, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+	}
+
+	end := size
+	if length >= 0 && offset+length < size {
+		end = offset + length
+	}
+
+	reader.reader = io.NewSectionReader(file, offset, end-offset)
+	reader.remain = end - offset
+	// done!
+	return reader, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	writer := &StorageObjectWriter{
+		ctx:    ctx,
+		handle: x,
+		attrs: storage.ObjectAttrs{
+			Bucket: x.bucket,
+			Name:   x.object,
+		},
+		crc: crc32.New(crc32cTable),
+		md5: md5.New(),
+	}
+
+	return writer
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.compressed = compressed
+	// done!
+	return &handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, attrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("Update", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	defer x.store.notifier.Flush(ctx)
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, true); err != nil {
+		return nil, err
+	}
+
+	result := obj.attrs
+	// apply the changes
+	if attrs.EventBasedHold != nil {
+		result.EventBasedHold = attrs.EventBasedHold.(bool)
+	}
+
+	if attrs.TemporaryHold != nil {
+		result.TemporaryHold = attrs.TemporaryHold.(bool)
+	}
+
+	if attrs.ContentType != nil {
+		result.ContentType = attrs.ContentType.(string)
+	}
+
+	if attrs.ContentLanguage != nil {
+		result.ContentLanguage = attrs.ContentLanguage.(string)
+	}
+
+	if attrs.ContentEncoding != nil {
+		result.ContentEncoding = attrs.ContentEncoding.(string)
+	}
+
+	if attrs.ContentDisposition != nil {
+		result.ContentDisposition = attrs.ContentDisposition.(string)
+	}
+
+	if attrs.CacheControl != nil {
+		result.CacheControl = attrs.CacheControl.(string)
+	}
+
+	if !attrs.CustomTime.IsZero() {
+		if attrs.CustomTime.Before(result.CustomTime) {
+			return nil, newError(http.StatusBadRequest, "The custom time cannot be decreased.")
+		}
+
+		result.CustomTime = attrs.CustomTime
+	}
+
+	if attrs.Metadata != nil {
+		if len(attrs.Metadata) == 0 {
+			result.Metadata = nil
+		}
+
+		for k, v := range attrs.Metadata {
+			if v == "" {
+				delete(result.Metadata, k)
+				continue
+			}
+
+			if result.Metadata == nil {
+				result.Metadata = make(map[string]string)
+			}
+
+			result.Metadata[k] = v
+		}
+	}
+
+	if attrs.ACL != nil {
+		result.ACL = append([]storage.ACLRule{}, attrs.ACL...)
+	}
+
+	if attrs.Retention != nil {
+		retention := *attrs.Retention
+		result.Retention = &retention
+	}
+
+	// bump the metageneration
+	result.Metageneration++
+	result.Updated = x.store.now().UTC()
+	result.Etag = etag(result.Generation, result.Metageneration)
+	// store the attributes
+	if err := x.store.save(obj); err != nil {
+		return nil, err
+	}
+
+	x.store.notifier.Notify(b.Notifications, &storagev1.StorageObjectChange{
+		Type:   storagev1.StorageObjectEventType_OBJECT_METADATA_UPDATE,
+		Object: result,
+		Time:   result.Updated,
+	})
+
+	return cloneObjectAttrs(result), nil
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents a filesystem storage object iterator.
+type StorageObjectIterator struct {
+	ctx    context.Context
+	store  *store
+	bucket string
+	query  storage.Query
+	items  []*storage.ObjectAttrs
+	page   page
+	cursor *entry
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		if err := x.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageObjectIterator) fetch() error {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	if _, err := x.store.bucket(x.bucket); err != nil {
+		return err
+	}
+
+	items, err := x.store.list(x.bucket, &x.query)
+	if err != nil {
+		return err
+	}
+
+	if x.cursor != nil {
+		index := sort.Search(len(items), func(i int) bool {
+			return !before(items[i], x.cursor) && !same(items[i], x.cursor)
+		})
+
+		items = items[index:]
+	}
+
+	if len(items) > x.page.size {
+		items = items[:x.page.size]
+		x.cursor = items[len(items)-1]
+		x.page.token = base64.RawURLEncoding.EncodeToString([]byte(x.cursor.name + "\n" + strconv.FormatInt(x.cursor.attrs.Generation, 10)))
+	} else {
+		x.page.token = ""
+		x.page.done = true
+	}
+
+	for _, item := range items {
+		x.items = append(x.items, item.attrs)
+	}
+
+	x.page.items = len(x.items)
+	// done!
+	return nil
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents a filesystem storage object reader.
+type StorageObjectReader struct {
+	ctx             context.Context
+	reader          io.Reader
+	closer          io.Closer
+	size            int64
+	remain          int64
+	contentType     string
+	contentEncoding string
+	cacheControl    string
+	generation      int64
+	closed          bool
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.cacheControl
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+	// close the file
+	if x.closer != nil {
+		return x.closer.Close()
+	}
+
+	return nil
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.contentEncoding
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.contentType
+}
+
+// Generation returns the generation of the object.
+func (x *StorageObjectReader) Generation() int64 {
+	return x.generation
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrReaderClosed
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err := x.reader.Read(p)
+	// track the remaining bytes
+	if x.remain > 0 {
+		x.remain -= int64(n)
+	}
+
+	return n, err
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return x.remain
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.size
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents a filesystem storage object writer. The data
+// is staged in a temporary file that is renamed into place on Close.
+type StorageObjectWriter struct {
+	ctx       context.Context
+	handle    *StorageObjectHandle
+	attrs     storage.ObjectAttrs
+	file      *os.File
+	head      []byte
+	size      int64
+	crc       hash.Hash32
+	md5       hash.Hash
+	chunkSize int
+	progress  func(int64)
+	crc32c    bool
+	parts     int64
+	result    *storage.ObjectAttrs
+	closed    bool
+	err       error
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.result
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	if x.closed {
+		return x.err
+	}
+
+	x.closed = true
+	// commit the object
+	x.result, x.err = x.commit()
+	// remove the temporary file if it was not moved into place
+	x.discard()
+	// done!
+	return x.err
+}
+
+func (x *StorageObjectWriter) commit() (*storage.ObjectAttrs, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	if err := x.handle.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("NewWriter", x.handle.conds); err != nil {
+		return nil, err
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := x.open(); err != nil {
+		return nil, err
+	}
+
+	if err := x.file.Sync(); err != nil {
+		return nil, err
+	}
+
+	if err := x.file.Close(); err != nil {
+		return nil, err
+	}
+
+	// verify the checksums
+	if x.crc32c && x.crc.Sum32() != x.attrs.CRC32C {
+		return nil, newError(http.StatusBadRequest, "Provided CRC32C \"%d\" doesn't match calculated CRC32C \"%d\".", x.attrs.CRC32C, x.crc.Sum32())
+	}
+
+	sum := x.md5.Sum(nil)
+	// verify the hash
+	if len(x.attrs.MD5) > 0 && !bytes.Equal(sum, x.attrs.MD5) {
+		return nil, newError(http.StatusBadRequest, "Provided MD5 hash \"%s\" doesn't match calculated MD5 hash \"%s\".", base64.StdEncoding.EncodeToString(x.attrs.MD5), base64.StdEncoding.EncodeToString(sum))
+	}
+
+	attrs := cloneObjectAttrs(&x.attrs)
+	attrs.Size = x.size
+	attrs.CRC32C = x.crc.Sum32()
+	attrs.MD5 = sum
+	attrs.ComponentCount = x.parts
+	// composite objects carry no MD5 hash
+	if x.parts > 0 {
+		attrs.MD5 = nil
+	}
+
+	// detect the content type
+	if attrs.ContentType == "" {
+		attrs.ContentType = http.DetectContentType(x.head)
+	}
+
+	defer x.handle.store.notifier.Flush(x.ctx)
+	x.handle.store.mu.Lock()
+	defer x.handle.store.mu.Unlock()
+
+	return x.handle.store.insert(x.handle, x.file.Name(), attrs)
+}
+
+// open creates the temporary file on first use.
+func (x *StorageObjectWriter) open() error {
+	if x.file != nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(x.handle.store.tempDir(), 0o755); err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(x.handle.store.tempDir(), "upload-*")
+	if err != nil {
+		return err
+	}
+
+	x.file = file
+	// done!
+	return nil
+}
+
+func (x *StorageObjectWriter) discard() {
+	if x.file != nil {
+		x.file.Close()
+		os.Remove(x.file.Name())
+	}
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+	x.err = err
+	// remove the temporary file
+	x.discard()
+	// done!
+	return nil
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.attrs.CRC32C = v
+	x.crc32c = true
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.chunkSize = v
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.attrs.ContentType = v
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.progress = fn
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if x.err != nil {
+		return 0, x.err
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		x.err = err
+		return 0, err
+	}
+
+	if err := x.open(); err != nil {
+		x.err = err
+		return 0, err
+	}
+
+	n, err := x.file.Write(p)
+	// track the content
+	x.crc.Write(p[:n])
+	x.md5.Write(p[:n])
+	x.size += int64(n)
+
+	if len(x.head) < 512 {
+		x.head = append(x.head, p[:min(n, 512-len(x.head))]...)
+	}
+
+	if err != nil {
+		x.err = err
+		return n, err
+	}
+
+	if x.progress != nil {
+		x.progress(x.size)
+	}
+
+	return n, nil
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents a filesystem storage object copier.
+type StorageObjectCopier struct {
+	dst      *StorageObjectHandle
+	src      storagev1.StorageObjectHandle
+	attrs    storage.ObjectAttrs
+	token    string
+	kms      string
+	progress func(uint64, uint64)
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.dst.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("CopyTo destination", x.dst.conds); err != nil {
+		return nil, err
+	}
+
+	src, err := x.src.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := x.src.Generation(src.Generation).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	writer := x.dst.NewWriter(ctx).(*StorageObjectWriter)
+	defer writer.discard()
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		return nil, err
+	}
+
+	attrs := &storage.ObjectAttrs{
+		ContentType:        src.ContentType,
+		ContentLanguage:    src.ContentLanguage,
+		ContentEncoding:    src.ContentEncoding,
+		ContentDisposition: src.ContentDisposition,
+		CacheControl:       src.CacheControl,
+		Metadata:           src.Metadata,
+		CustomTime:         src.CustomTime,
+		StorageClass:       src.StorageClass,
+		KMSKeyName:         x.kms,
+	}
+
+	override(attrs, &x.attrs)
+	// the copy keeps the source checksums
+	writer.attrs = *attrs
+	writer.attrs.CRC32C = src.CRC32C
+	writer.crc32c = src.CRC32C != 0
+
+	result, err := writer.commit()
+	if err != nil {
+		return nil, err
+	}
+
+	if x.progress != nil {
+		x.progress(uint64(result.Size), uint64(result.Size))
+	}
+
+	return result, nil
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(name string) {
+	x.kms = name
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.progress = fn
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(token string) {
+	x.token = token
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents a filesystem storage object composer.
+type StorageObjectComposer struct {
+	dst   *StorageObjectHandle
+	srcs  []storagev1.StorageObjectHandle
+	attrs storage.ObjectAttrs
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.dst.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("ComposeFrom destination", x.dst.conds); err != nil {
+		return nil, err
+	}
+
+	if len(x.srcs) == 0 {
+		return nil, errors.New("storage: at least one source object must be specified")
+	}
+
+	if len(x.srcs) > 32 {
+		return nil, newError(http.StatusBadRequest, "The number of source components provided (%d) exceeds the maximum (32)", len(x.srcs))
+	}
+
+	writer := x.dst.NewWriter(ctx).(*StorageObjectWriter)
+	defer writer.discard()
+
+	var count int64
+	// concatenate the sources
+	for _, item := range x.srcs {
+		src, ok := item.(*StorageObjectHandle)
+		if !ok || src.store != x.dst.store || src.bucket != x.dst.bucket {
+			return nil, errors.New("storage: all source objects must be in bucket " + strconv.Quote(x.dst.bucket))
+		}
+
+		if src.conds != nil && (src.conds.GenerationNotMatch != 0 || src.conds.DoesNotExist || src.conds.MetagenerationMatch != 0 || src.conds.MetagenerationNotMatch != 0) {
+			return nil, errors.New("storage: compose: only GenerationMatch is allowed for source conditions")
+		}
+
+		attrs, err := src.Attrs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reader, err := src.Key(x.dst.key).Generation(attrs.Generation).ReadCompressed(true).NewReader(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.Copy(writer, reader)
+		reader.Close()
+
+		if err != nil {
+			return nil, err
+		}
+
+		count += max(attrs.ComponentCount, 1)
+	}
+
+	if count > 1024 {
+		return nil, newError(http.StatusBadRequest, "The resulting object would have %d components, which exceeds the maximum (1024)", count)
+	}
+
+	writer.attrs = x.attrs
+	writer.attrs.Bucket = x.dst.bucket
+	writer.attrs.Name = x.dst.object
+	writer.parts = count
+
+	return writer.commit()
+}
+
+var _ storagev1.StoragePageInfo = &StoragePageInfo{}
+
+// StoragePageInfo represents a filesystem storage page info.
+type StoragePageInfo struct {
+	page *page
+}
+
+// MaxSize implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) MaxSize() int {
+	return x.page.size
+}
+
+// Remaining implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Remaining() int {
+	return x.page.items
+}
+
+// Token implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Token() string {
+	return x.page.token
+}
+
+// override copies the non-zero writable attributes of src into dst.
+func override(dst, src *storage.ObjectAttrs) {
+	if src.ContentType != "" {
+		dst.ContentType = src.ContentType
+	}
+
+	if src.ContentLanguage != "" {
+		dst.ContentLanguage = src.ContentLanguage
+	}
+
+	if src.ContentEncoding != "" {
+		dst.ContentEncoding = src.ContentEncoding
+	}
+
+	if src.ContentDisposition != "" {
+		dst.ContentDisposition = src.ContentDisposition
+	}
+
+	if src.CacheControl != "" {
+		dst.CacheControl = src.CacheControl
+	}
+
+	if src.Metadata != nil {
+		dst.Metadata = src.Metadata
+	}
+
+	if !src.CustomTime.IsZero() {
+		dst.CustomTime = src.CustomTime
+	}
+
+	if src.StorageClass != "" {
+		dst.StorageClass = src.StorageClass
+	}
+
+	if src.KMSKeyName != "" {
+		dst.KMSKeyName = src.KMSKeyName
+	}
+
+	if src.ACL != nil {
+		dst.ACL = src.ACL
+	}
+
+	if src.EventBasedHold {
+		dst.EventBasedHold = true
+	}
+
+	if src.TemporaryHold {
+		dst.TemporaryHold = true
+	}
+}
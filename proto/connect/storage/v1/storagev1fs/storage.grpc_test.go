@@ -0,0 +1,443 @@
+package storagev1fs_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fs"
+)
+
+func newBucket(t *testing.T) storagev1.StorageBucketHandle {
+	t.Helper()
+
+	client, err := storagev1fs.NewStorageServiceClient(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	return bucket
+}
+
+func writeObject(t *testing.T, handle storagev1.StorageObjectHandle, data string) *storage.ObjectAttrs {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	return writer.Attrs()
+}
+
+func readObject(t *testing.T, handle storagev1.StorageObjectHandle, offset, length int64) string {
+	t.Helper()
+
+	reader, err := handle.NewRangeReader(context.Background(), offset, length)
+	if err != nil {
+		t.Fatalf("NewRangeReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	return string(data)
+}
+
+func listObjects(t *testing.T, bucket storagev1.StorageBucketHandle, query *storage.Query) []string {
+	t.Helper()
+
+	var names []string
+
+	iter := bucket.Objects(context.Background(), query)
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return names
+		}
+
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		names = append(names, attrs.Name+attrs.Prefix)
+	}
+}
+
+func TestStorageObjectHandle(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	attrs := writeObject(t, bucket.Object("a.txt"), "hello world")
+	if attrs.Size != 11 || attrs.Generation == 0 || attrs.CRC32C == 0 {
+		t.Errorf("Attrs() = %+v", attrs)
+	}
+
+	cases := []struct {
+		offset, length int64
+		want           string
+	}{
+		{0, -1, "hello world"},
+		{6, -1, "world"},
+		{0, 5, "hello"},
+		{-5, -1, "world"},
+		{6, 100, "world"},
+	}
+
+	for _, tc := range cases {
+		if got := readObject(t, bucket.Object("a.txt"), tc.offset, tc.length); got != tc.want {
+			t.Errorf("NewRangeReader(%d, %d) = %q, want %q", tc.offset, tc.length, got, tc.want)
+		}
+	}
+
+	if _, err := bucket.Object("missing").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs(missing) = %v, want ErrObjectNotExist", err)
+	}
+
+	// a generation that does not match fails the write
+	writer := bucket.Object("a.txt").If(storage.Conditions{GenerationMatch: attrs.Generation + 1}).NewWriter(ctx)
+	io.WriteString(writer, "other")
+	if err := writer.Close(); err == nil {
+		t.Error("Close with a generation that does not match: want an error")
+	}
+
+	if err := bucket.Object("a.txt").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := bucket.Object("a.txt").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs after Delete = %v, want ErrObjectNotExist", err)
+	}
+}
+
+func TestStorageBucketHandleObjects(t *testing.T) {
+	bucket := newBucket(t)
+	for _, name := range []string{"a/1.txt", "a/2.log", "a/b/3.txt", "c.txt"} {
+		writeObject(t, bucket.Object(name), name)
+	}
+
+	cases := []struct {
+		query *storage.Query
+		want  []string
+	}{
+		{nil, []string{"a/1.txt", "a/2.log", "a/b/3.txt", "c.txt"}},
+		{&storage.Query{Prefix: "a/"}, []string{"a/1.txt", "a/2.log", "a/b/3.txt"}},
+		{&storage.Query{Delimiter: "/"}, []string{"a/", "c.txt"}},
+		{&storage.Query{StartOffset: "a/2", EndOffset: "c"}, []string{"a/2.log", "a/b/3.txt"}},
+		{&storage.Query{MatchGlob: "**/*.txt"}, []string{"a/1.txt", "a/b/3.txt", "c.txt"}},
+		{&storage.Query{MatchGlob: "a/*.txt"}, []string{"a/1.txt"}},
+	}
+
+	for _, tc := range cases {
+		if got := listObjects(t, bucket, tc.query); !slices.Equal(got, tc.want) {
+			t.Errorf("Objects(%+v) = %v, want %v", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestStorageObjectComposer(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	writeObject(t, bucket.Object("a"), "hello ")
+	writeObject(t, bucket.Object("b"), "world")
+
+	attrs, err := bucket.Object("c").ComposerFrom(bucket.Object("a"), bucket.Object("b")).Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if attrs.ComponentCount != 2 {
+		t.Errorf("ComponentCount = %d, want 2", attrs.ComponentCount)
+	}
+
+	if got := readObject(t, bucket.Object("c"), 0, -1); got != "hello world" {
+		t.Errorf("composed object = %q", got)
+	}
+
+	srcs := make([]storagev1.StorageObjectHandle, 33)
+	for index := range srcs {
+		srcs[index] = bucket.Object("a")
+	}
+
+	if _, err := bucket.Object("d").ComposerFrom(srcs...).Run(ctx); err == nil {
+		t.Error("Run with 33 sources: want an error")
+	}
+}
+
+func TestStorageObjectCopier(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	writeObject(t, bucket.Object("a"), "data")
+
+	copier := bucket.Object("b").CopierFrom(bucket.Object("a"))
+	copier.ObjectAttrs().ContentType = "text/plain"
+
+	attrs, err := copier.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if attrs.ContentType != "text/plain" {
+		t.Errorf("ContentType = %q, want text/plain", attrs.ContentType)
+	}
+
+	if got := readObject(t, bucket.Object("b"), 0, -1); got != "data" {
+		t.Errorf("copy = %q", got)
+	}
+}
+
+func TestStorageObjectWriterOverwrite(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	client, err := storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(ctx, "project", &storage.BucketAttrs{VersioningEnabled: true}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	first := writeObject(t, bucket.Object("a"), "version 0")
+
+	var (
+		done = make(chan struct{})
+		errs = make(chan error, 1)
+	)
+
+	// read the file while the object is overwritten
+	go func() {
+		defer close(errs)
+
+		for {
+			select {
+			case <-done:
+				return
+			default:
+			}
+
+			data, err := os.ReadFile(filepath.Join(root, "bucket", "a"))
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			if !strings.HasPrefix(string(data), "version ") {
+				errs <- fmt.Errorf("data = %q, want a whole version", data)
+				return
+			}
+		}
+	}()
+
+	for index := 1; index <= 50; index++ {
+		writeObject(t, bucket.Object("a"), fmt.Sprintf("version %d", index))
+	}
+
+	close(done)
+
+	if err := <-errs; err != nil {
+		t.Errorf("ReadFile during the overwrites: %v", err)
+	}
+
+	if got := readObject(t, bucket.Object("a"), 0, -1); got != "version 50" {
+		t.Errorf("live object = %q, want version 50", got)
+	}
+
+	if got := readObject(t, bucket.Object("a").Generation(first.Generation), 0, -1); got != "version 0" {
+		t.Errorf("first generation = %q, want version 0", got)
+	}
+
+	if got := listObjects(t, bucket, &storage.Query{Versions: true}); len(got) != 51 {
+		t.Errorf("Objects(Versions) = %d versions, want 51", len(got))
+	}
+}
+
+func TestStorageServiceClientReopen(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	client, err := storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	if err := client.Bucket("bucket").Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	attrs := writeObject(t, client.Bucket("bucket").Object("dir/a.txt"), "kept")
+
+	// a new client reads the objects of the directory
+	client, err = storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	got, err := client.Bucket("bucket").Object("dir/a.txt").Attrs(ctx)
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	if got.Generation != attrs.Generation || got.CRC32C != attrs.CRC32C {
+		t.Errorf("Attrs() = %+v, want %+v", got, attrs)
+	}
+
+	if data := readObject(t, client.Bucket("bucket").Object("dir/a.txt"), 0, -1); data != "kept" {
+		t.Errorf("object = %q, want kept", data)
+	}
+}
+
+func TestStorageBucketHandleUpdateLabels(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	client, err := storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	if err := client.Bucket("bucket").Create(ctx, "project", &storage.BucketAttrs{Labels: map[string]string{"a": "1", "b": "2"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	var update storage.BucketAttrsToUpdate
+	update.SetLabel("c", "3")
+	update.DeleteLabel("a")
+
+	attrs, err := client.Bucket("bucket").Update(ctx, update)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	want := map[string]string{"b": "2", "c": "3"}
+	if !maps.Equal(attrs.Labels, want) {
+		t.Errorf("Update() labels = %v, want %v", attrs.Labels, want)
+	}
+
+	// a new client reads the labels of the bucket
+	client, err = storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	attrs, err = client.Bucket("bucket").Attrs(ctx)
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	if !maps.Equal(attrs.Labels, want) {
+		t.Errorf("Attrs() labels = %v, want %v", attrs.Labels, want)
+	}
+}
+
+func TestStorageBucketHandleDeleteTmp(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := storagev1fs.NewStorageServiceClient(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	for _, name := range []string{"bucket", "tmp"} {
+		if err := client.Bucket(name).Create(ctx, "project", nil); err != nil {
+			t.Fatalf("Create(%q): %v", name, err)
+		}
+	}
+
+	writer := client.Bucket("bucket").Object("a").NewWriter(ctx)
+	if _, err := io.WriteString(writer, "pending"); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	// the bucket named tmp does not hold the pending uploads
+	if err := client.Bucket("tmp").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if got := readObject(t, client.Bucket("bucket").Object("a"), 0, -1); got != "pending" {
+		t.Errorf("object = %q, want pending", got)
+	}
+}
+
+func TestStorageObjectWriterMetadataFailure(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	client, err := storagev1fs.NewStorageServiceClient(root)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, "bucket", "a"), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+
+	// an object without a sidecar is served from its data
+	if err := os.WriteFile(filepath.Join(root, "bucket", "a", "old"), []byte("old"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, storagev1fs.MetadataDir, "bucket", "objects"), 0o755); err != nil {
+		t.Fatalf("MkdirAll: %v", err)
+	}
+
+	// a file in place of the sidecar directory fails the metadata writes
+	if err := os.WriteFile(filepath.Join(root, storagev1fs.MetadataDir, "bucket", "objects", "a"), nil, 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	for _, name := range []string{"a/old", "a/new"} {
+		writer := bucket.Object(name).NewWriter(ctx)
+		if _, err := io.WriteString(writer, "replaced"); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+
+		if err := writer.Close(); err == nil {
+			t.Fatalf("Close(%q): want an error", name)
+		}
+	}
+
+	if got := readObject(t, bucket.Object("a/old"), 0, -1); got != "old" {
+		t.Errorf("object a/old = %q, want old", got)
+	}
+
+	if _, err := bucket.Object("a/new").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs(a/new) = %v, want ErrObjectNotExist", err)
+	}
+}
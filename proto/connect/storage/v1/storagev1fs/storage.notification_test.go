@@ -0,0 +1,86 @@
+package storagev1fs_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1fs"
+)
+
+func TestStorageServiceClientNotifications(t *testing.T) {
+	ctx := context.Background()
+
+	client, err := storagev1fs.NewStorageServiceClient(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	var (
+		events []string
+		errs   []error
+	)
+
+	bucket := client.Bucket("bucket")
+	// the handler uses the client
+	client.SetNotificationHandler(storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, event storagev1.StorageObjectEvent) error {
+		events = append(events, storagev1.StorageObjectEventTypeOf(event).String()+" "+event.GetObject().GetName())
+
+		if _, err := bucket.Object(event.GetObject().GetName()).Attrs(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return err
+		}
+
+		if event.GetObject().GetName() == "fail" {
+			return errors.New("boom")
+		}
+
+		return nil
+	}))
+
+	client.SetNotificationErrorHandler(func(_ context.Context, err error) {
+		errs = append(errs, err)
+	})
+
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := bucket.AddNotification(ctx, &storage.Notification{TopicProjectID: "project", TopicID: "topic"}); err != nil {
+		t.Fatalf("AddNotification: %v", err)
+	}
+
+	writeObject(t, bucket.Object("a"), "hello")
+	writeObject(t, bucket.Object("a"), "world")
+
+	if _, err := bucket.Object("a").Update(ctx, storage.ObjectAttrsToUpdate{ContentType: "text/plain"}); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	if err := bucket.Object("a").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	writeObject(t, bucket.Object("fail"), "hello")
+
+	want := []string{
+		"OBJECT_FINALIZE a",
+		"OBJECT_FINALIZE a",
+		"OBJECT_DELETE a",
+		"OBJECT_METADATA_UPDATE a",
+		"OBJECT_DELETE a",
+		"OBJECT_FINALIZE fail",
+	}
+
+	if !slices.Equal(events, want) {
+		t.Errorf("events = %v, want %v", events, want)
+	}
+
+	var nerr *storagev1.StorageObjectNotificationError
+	if len(errs) != 1 || !errors.As(errs[0], &nerr) || nerr.Change.Object.Name != "fail" {
+		t.Errorf("errors = %v, want the error of fail", errs)
+	}
+}
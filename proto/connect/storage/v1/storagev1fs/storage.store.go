@@ -0,0 +1,1081 @@
+package storagev1fs
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	googleapi "google.golang.org/api/googleapi"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// MetadataDir is the directory under the root that holds the sidecar files.
+const MetadataDir = ".storage"
+
+// crc32cTable is the Castagnoli table used by Cloud Storage.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// bucketNamePattern matches the valid bucket names.
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+
+// store lays out the buckets and objects under the root directory:
+//
+//	<root>/<bucket>/<object>                            object data
+//	<root>/.storage/<bucket>/bucket.json                bucket metadata
+//	<root>/.storage/<bucket>/objects/<object>.json      object metadata
+//	<root>/.storage/<bucket>/versions/<object>#<gen>    noncurrent object data
+//	<root>/.storage/<bucket>/versions/<object>#<gen>.json
+//	<root>/.tmp                                         pending uploads
+type store struct {
+	mu         sync.Mutex
+	root       string
+	generation int64
+	now        func() time.Time
+	notifier   storagev1.StorageObjectNotifier
+}
+
+// bucket is the content of the bucket sidecar file.
+type bucket struct {
+	ProjectID      string                           `json:"projectId"`
+	Attrs          *storage.BucketAttrs             `json:"attrs"`
+	Notifications  map[string]*storage.Notification `json:"notifications,omitempty"`
+	NotificationID int                              `json:"notificationId,omitempty"`
+}
+
+type object struct {
+	attrs *storage.ObjectAttrs
+	path  string
+	live  bool
+}
+
+type entry struct {
+	name  string
+	attrs *storage.ObjectAttrs
+}
+
+// next returns the next generation number. Like Cloud Storage generations are
+// derived from the wall clock in microseconds, but they are strictly increasing.
+func (x *store) next() int64 {
+	x.generation = max(x.generation+1, x.now().UnixMicro())
+	return x.generation
+}
+
+func (x *store) bucketDir(name string) string {
+	return filepath.Join(x.root, name)
+}
+
+func (x *store) metadataDir(name string) string {
+	return filepath.Join(x.root, MetadataDir, name)
+}
+
+func (x *store) tempDir() string {
+	// the bucket names cannot start with a dot
+	return filepath.Join(x.root, ".tmp")
+}
+
+func (x *store) dataPath(bucket, name string) string {
+	return filepath.Join(x.bucketDir(bucket), filepath.FromSlash(name))
+}
+
+func (x *store) metadataPath(bucket, name string) string {
+	return filepath.Join(x.metadataDir(bucket), "objects", filepath.FromSlash(name)+".json")
+}
+
+func (x *store) versionPath(bucket, name string, generation int64) string {
+	return filepath.Join(x.metadataDir(bucket), "versions", filepath.FromSlash(name)+"#"+strconv.FormatInt(generation, 10))
+}
+
+// bucket reads the bucket metadata. A directory without a sidecar file is
+// served as a bucket with default attributes.
+func (x *store) bucket(name string) (*bucket, error) {
+	if name == "" {
+		return nil, errors.New("storage: bucket name is empty")
+	}
+
+	if !bucketNamePattern.MatchString(name) {
+		return nil, storage.ErrBucketNotExist
+	}
+
+	b := &bucket{}
+	// read the metadata
+	switch err := readJSON(filepath.Join(x.metadataDir(name), "bucket.json"), b); {
+	case err == nil:
+	case errors.Is(err, fs.ErrNotExist):
+		info, err := os.Stat(x.bucketDir(name))
+		if err != nil || !info.IsDir() {
+			return nil, storage.ErrBucketNotExist
+		}
+
+		b.Attrs = &storage.BucketAttrs{
+			Name:           name,
+			Location:       "US",
+			LocationType:   "multi-region",
+			StorageClass:   "STANDARD",
+			MetaGeneration: 1,
+			Created:        info.ModTime().UTC(),
+			Updated:        info.ModTime().UTC(),
+			Etag:           etag(1, 1),
+		}
+	default:
+		return nil, err
+	}
+
+	if b.Notifications == nil {
+		b.Notifications = make(map[string]*storage.Notification)
+	}
+
+	return b, nil
+}
+
+func (x *store) saveBucket(name string, b *bucket) error {
+	return writeJSON(filepath.Join(x.metadataDir(name), "bucket.json"), b)
+}
+
+// object reads the object addressed by the handle. It returns the live
+// version unless the handle is pinned to a generation.
+func (x *store) object(h *StorageObjectHandle) (*bucket, *object, error) {
+	b, err := x.bucket(h.bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	obj, err := x.live(h.bucket, h.object)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if h.gen < 0 || (obj != nil && obj.attrs.Generation == h.gen) {
+		return b, obj, nil
+	}
+
+	attrs := &storage.ObjectAttrs{}
+	// read the noncurrent version
+	switch err := readJSON(x.versionPath(h.bucket, h.object, h.gen)+".json", attrs); {
+	case err == nil:
+		return b, &object{attrs: attrs, path: x.versionPath(h.bucket, h.object, h.gen)}, nil
+	case errors.Is(err, fs.ErrNotExist):
+		return b, nil, nil
+	default:
+		return nil, nil, err
+	}
+}
+
+// live reads the live version of an object. A file without a sidecar file is
+// served as an object whose attributes are derived from the file itself.
+func (x *store) live(bucket, name string) (*object, error) {
+	obj := &object{
+		attrs: &storage.ObjectAttrs{},
+		path:  x.dataPath(bucket, name),
+		live:  true,
+	}
+
+	switch err := readJSON(x.metadataPath(bucket, name), obj.attrs); {
+	case err == nil:
+		return obj, nil
+	case !errors.Is(err, fs.ErrNotExist) && !isNotDir(err):
+		return nil, err
+	}
+
+	if strings.HasSuffix(name, "/") {
+		return nil, nil
+	}
+
+	info, err := os.Stat(obj.path)
+	if err != nil || !info.Mode().IsRegular() {
+		return nil, nil
+	}
+
+	return x.synthesize(bucket, name, obj.path, info)
+}
+
+func (x *store) synthesize(bucket, name, path string, info fs.FileInfo) (*object, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var (
+		crc = crc32.New(crc32cTable)
+		sum = md5.New()
+	)
+
+	if _, err := io.Copy(io.MultiWriter(crc, sum), file); err != nil {
+		return nil, err
+	}
+
+	var (
+		modified   = info.ModTime().UTC()
+		generation = modified.UnixMicro()
+	)
+
+	attrs := &storage.ObjectAttrs{
+		Bucket:         bucket,
+		Name:           name,
+		ContentType:    mime.TypeByExtension(filepath.Ext(name)),
+		Size:           info.Size(),
+		CRC32C:         crc.Sum32(),
+		MD5:            sum.Sum(nil),
+		Generation:     generation,
+		Metageneration: 1,
+		StorageClass:   "STANDARD",
+		Created:        modified,
+		Updated:        modified,
+		Finalized:      modified,
+		Etag:           etag(generation, 1),
+		MediaLink:      mediaLink(bucket, name, generation),
+	}
+
+	if attrs.ContentType == "" {
+		attrs.ContentType = "application/octet-stream"
+	}
+
+	return &object{attrs: attrs, path: path, live: true}, nil
+}
+
+// insert moves the pending upload at temp into place as the new live version
+// of the object addressed by the handle. The caller must hold the lock.
+func (x *store) insert(h *StorageObjectHandle, temp string, attrs *storage.ObjectAttrs) (*storage.ObjectAttrs, error) {
+	b, err := x.bucket(h.bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := x.live(h.bucket, h.object)
+	if err != nil {
+		return nil, err
+	}
+	// check the preconditions against the live version
+	if err := checkConditions(h.conds, prev, true); err != nil {
+		return nil, err
+	}
+
+	if err := x.checkMutable(prev); err != nil {
+		return nil, err
+	}
+
+	if strings.HasSuffix(h.object, "/") && attrs.Size > 0 {
+		return nil, newError(http.StatusBadRequest, "Object '%s' ends with '/' and can only hold empty content in the filesystem backend.", h.object)
+	}
+
+	now := x.now().UTC()
+
+	result := cloneObjectAttrs(attrs)
+	result.Bucket = h.bucket
+	result.Name = h.object
+	result.Generation = x.next()
+	result.Metageneration = 1
+	result.Created = now
+	result.Updated = now
+	result.Finalized = now
+	result.Deleted = time.Time{}
+	result.Etag = etag(result.Generation, result.Metageneration)
+	result.MediaLink = mediaLink(h.bucket, h.object, result.Generation)
+	result.Owner = ""
+	result.Prefix = ""
+
+	if result.StorageClass == "" {
+		result.StorageClass = b.Attrs.StorageClass
+	}
+
+	if result.ACL == nil {
+		result.ACL = append([]storage.ACLRule(nil), b.Attrs.DefaultObjectACL...)
+	}
+
+	if b.Attrs.DefaultEventBasedHold {
+		result.EventBasedHold = true
+	}
+
+	if policy := b.Attrs.RetentionPolicy; policy != nil && policy.RetentionPeriod > 0 {
+		result.RetentionExpirationTime = now.Add(policy.RetentionPeriod)
+	}
+
+	if len(h.key) > 0 {
+		sum := sha256.Sum256(h.key)
+		result.CustomerKeySHA256 = base64.StdEncoding.EncodeToString(sum[:])
+	} else {
+		result.CustomerKeySHA256 = ""
+	}
+
+	kept, err := x.keep(b, prev, now)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := x.metadataPath(h.bucket, h.object)
+	// stage the metadata, so that it replaces the live sidecar right after
+	// the data
+	staged, err := stageJSON(metadata, result)
+	if err != nil {
+		x.discard(h.bucket, kept)
+		return nil, err
+	}
+
+	backup, err := x.backup(h.object, prev)
+	if err != nil {
+		os.Remove(staged)
+		x.discard(h.bucket, kept)
+		return nil, err
+	}
+
+	target := x.dataPath(h.bucket, h.object)
+	// move the data over the live version, so that the object never goes
+	// missing while it is replaced
+	if err := x.place(h.object, temp, target); err != nil {
+		os.Remove(staged)
+		os.Remove(backup)
+		x.discard(h.bucket, kept)
+		return nil, err
+	}
+
+	// the live files are replaced in place, so the replaced version leaves
+	// nothing behind to purge
+	if err := os.Rename(staged, metadata); err != nil {
+		os.Remove(staged)
+		x.restore(h.object, prev, backup, target)
+		x.discard(h.bucket, kept)
+		return nil, err
+	}
+
+	if backup != "" {
+		os.Remove(backup)
+	}
+
+	finalized := &storagev1.StorageObjectChange{
+		Type:   storagev1.StorageObjectEventType_OBJECT_FINALIZE,
+		Object: result,
+		Time:   now,
+	}
+
+	if prev != nil {
+		finalized.OverwroteGeneration = prev.attrs.Generation
+	}
+
+	x.notifier.Notify(b.Notifications, finalized)
+	// notify the replaced version
+	if prev != nil {
+		x.notifier.Notify(b.Notifications, &storagev1.StorageObjectChange{
+			Type:                    storagev1.RetiredStorageObjectEventType(b.Attrs),
+			Object:                  prev.attrs,
+			Time:                    now,
+			OverwrittenByGeneration: result.Generation,
+		})
+	}
+
+	return cloneObjectAttrs(result), nil
+}
+
+// archive retires the live version of an object. The version is kept as a
+// noncurrent version when the bucket has versioning enabled.
+func (x *store) archive(b *bucket, obj *object, now time.Time) error {
+	if obj == nil {
+		return nil
+	}
+
+	if _, err := x.keep(b, obj, now); err != nil {
+		return err
+	}
+
+	return x.purge(obj)
+}
+
+// keep links the live version of an object into the noncurrent versions when
+// the bucket has versioning enabled, and leaves the live version in place. It
+// returns the files it created.
+func (x *store) keep(b *bucket, obj *object, now time.Time) ([]string, error) {
+	if obj == nil || !b.Attrs.VersioningEnabled {
+		return nil, nil
+	}
+
+	attrs := cloneObjectAttrs(obj.attrs)
+	attrs.Deleted = now
+
+	target := x.versionPath(attrs.Bucket, attrs.Name, attrs.Generation)
+	// link the data into the versions
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return nil, err
+	}
+
+	if strings.HasSuffix(attrs.Name, "/") {
+		if err := os.WriteFile(target, nil, 0o644); err != nil {
+			return nil, err
+		}
+	} else if err := link(obj.path, target); err != nil {
+		return nil, err
+	}
+
+	if err := writeJSON(target+".json", attrs); err != nil {
+		os.Remove(target)
+		return nil, err
+	}
+
+	return []string{target, target + ".json"}, nil
+}
+
+// discard removes the files of a noncurrent version that keep created for a
+// replacement that failed.
+func (x *store) discard(bucket string, names []string) {
+	for _, name := range names {
+		os.Remove(name)
+	}
+
+	if len(names) > 0 {
+		x.prune(filepath.Join(x.metadataDir(bucket), "versions"), filepath.Dir(names[0]))
+	}
+}
+
+// backup links the live data of an object into the pending uploads, so that a
+// failed replacement can restore it. The name is empty when there is no data
+// to restore.
+func (x *store) backup(name string, obj *object) (string, error) {
+	if obj == nil || strings.HasSuffix(name, "/") {
+		return "", nil
+	}
+
+	if err := os.MkdirAll(x.tempDir(), 0o755); err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp(x.tempDir(), "backup-*")
+	if err != nil {
+		return "", err
+	}
+
+	file.Close()
+	// link the data in place of the reserved name
+	os.Remove(file.Name())
+
+	switch err := link(obj.path, file.Name()); {
+	case err == nil:
+		return file.Name(), nil
+	case errors.Is(err, fs.ErrNotExist):
+		return "", nil
+	default:
+		return "", err
+	}
+}
+
+// restore reverts the data path of an object that place replaced to the
+// backup of the live data, or removes it when the object did not exist.
+func (x *store) restore(name string, obj *object, backup, target string) {
+	switch {
+	case backup != "":
+		os.Rename(backup, target)
+	case obj == nil:
+		// a directory is only removed when it is empty
+		os.Remove(target)
+	}
+}
+
+// place moves the pending upload at temp to the data path of an object.
+func (x *store) place(name, temp, target string) error {
+	if strings.HasSuffix(name, "/") {
+		if err := os.MkdirAll(target, 0o755); err != nil {
+			return conflict(name, err)
+		}
+
+		_ = os.Remove(temp)
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return conflict(name, err)
+	}
+
+	if err := os.Rename(temp, target); err != nil {
+		return conflict(name, err)
+	}
+
+	return nil
+}
+
+// purge removes the data and metadata files of an object version.
+func (x *store) purge(obj *object) error {
+	var (
+		data     = obj.path
+		metadata = obj.path + ".json"
+	)
+
+	if obj.live {
+		metadata = x.metadataPath(obj.attrs.Bucket, obj.attrs.Name)
+	}
+
+	if obj.live && strings.HasSuffix(obj.attrs.Name, "/") {
+		// the directory is only removed when it is empty
+		data = ""
+	}
+
+	for _, name := range []string{data, metadata} {
+		if name == "" {
+			continue
+		}
+
+		if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+
+	if obj.live {
+		dir := filepath.Dir(obj.path)
+		// the directory of a folder object is pruned itself
+		if strings.HasSuffix(obj.attrs.Name, "/") {
+			dir = obj.path
+		}
+
+		x.prune(x.bucketDir(obj.attrs.Bucket), dir)
+		x.prune(filepath.Join(x.metadataDir(obj.attrs.Bucket), "objects"), filepath.Dir(metadata))
+	} else {
+		x.prune(filepath.Join(x.metadataDir(obj.attrs.Bucket), "versions"), filepath.Dir(data))
+	}
+
+	return nil
+}
+
+// prune removes the empty directories between dir and root. Directories that
+// back an object whose name ends with '/' are kept.
+func (x *store) prune(root, dir string) {
+	for dir != root && strings.HasPrefix(dir, root) {
+		if rel, err := filepath.Rel(x.root, dir); err == nil {
+			if parts := strings.SplitN(filepath.ToSlash(rel), "/", 2); len(parts) == 2 && parts[0] != MetadataDir {
+				if _, err := os.Stat(x.metadataPath(parts[0], parts[1]+"/")); err == nil {
+					return
+				}
+			}
+		}
+
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+// checkMutable returns an error if the object may not be deleted or replaced.
+func (x *store) checkMutable(obj *object) error {
+	if obj == nil {
+		return nil
+	}
+
+	switch {
+	case obj.attrs.TemporaryHold:
+		return newError(http.StatusForbidden, "Object '%s/%s' is under active Temporary hold and cannot be deleted, overwritten or archived until hold is removed.", obj.attrs.Bucket, obj.attrs.Name)
+	case obj.attrs.EventBasedHold:
+		return newError(http.StatusForbidden, "Object '%s/%s' is under active Event-Based hold and cannot be deleted, overwritten or archived until hold is removed.", obj.attrs.Bucket, obj.attrs.Name)
+	case !obj.attrs.RetentionExpirationTime.IsZero() && x.now().Before(obj.attrs.RetentionExpirationTime):
+		return newError(http.StatusForbidden, "Object '%s/%s' is subject to bucket's retention policy or object retention and cannot be deleted or overwritten until %s.", obj.attrs.Bucket, obj.attrs.Name, obj.attrs.RetentionExpirationTime.Format(time.RFC3339))
+	}
+
+	if retention := obj.attrs.Retention; retention != nil && x.now().Before(retention.RetainUntil) {
+		return newError(http.StatusForbidden, "Object '%s/%s' is subject to object retention and cannot be deleted or overwritten until %s.", obj.attrs.Bucket, obj.attrs.Name, retention.RetainUntil.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// save rewrites the metadata of an object version.
+func (x *store) save(obj *object) error {
+	if obj.live {
+		return writeJSON(x.metadataPath(obj.attrs.Bucket, obj.attrs.Name), obj.attrs)
+	}
+
+	return writeJSON(obj.path+".json", obj.attrs)
+}
+
+// empty reports whether the bucket holds no objects.
+func (x *store) empty(name string) (bool, error) {
+	for _, dir := range []string{x.bucketDir(name), filepath.Join(x.metadataDir(name), "versions")} {
+		found := false
+		// look for any file
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			switch {
+			case err != nil:
+				return err
+			case path != dir:
+				found = true
+				return filepath.SkipAll
+			default:
+				return nil
+			}
+		})
+
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return false, err
+		}
+
+		if found {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// list returns the sorted list entries of a bucket for the given query.
+func (x *store) list(bucket string, query *storage.Query) ([]*entry, error) {
+	var glob *storagev1.StorageObjectGlob
+
+	if query.MatchGlob != "" {
+		var err error
+		// compile the glob
+		if glob, err = storagev1.ParseStorageObjectGlob(query.MatchGlob); err != nil {
+			return nil, newError(http.StatusBadRequest, "%v", err)
+		}
+	}
+
+	var (
+		items    []*entry
+		prefixes = make(map[string]bool)
+	)
+
+	match := func(name string) bool {
+		switch {
+		case !strings.HasPrefix(name, query.Prefix):
+			return false
+		case query.StartOffset != "" && name < query.StartOffset:
+			return false
+		case query.EndOffset != "" && name >= query.EndOffset:
+			return false
+		case glob != nil && !glob.Match(name):
+			return false
+		default:
+			return true
+		}
+	}
+
+	collect := func(attrs *storage.ObjectAttrs) {
+		name := attrs.Name
+		// apply the delimiter
+		if query.Delimiter != "" {
+			if index := strings.Index(name[len(query.Prefix):], query.Delimiter); index >= 0 {
+				prefix := name[:len(query.Prefix)+index+len(query.Delimiter)]
+				// synthesize the prefix
+				if !prefixes[prefix] {
+					prefixes[prefix] = true
+					items = append(items, &entry{name: prefix, attrs: &storage.ObjectAttrs{Prefix: prefix}})
+				}
+
+				if !query.IncludeTrailingDelimiter || prefix != name {
+					return
+				}
+			}
+		}
+
+		items = append(items, &entry{name: name, attrs: attrs})
+	}
+
+	// only walk the directory that can contain the prefix
+	start := path.Dir(query.Prefix + "x")
+	if start == "." {
+		start = ""
+	}
+
+	root := x.bucketDir(bucket)
+	// walk the live objects
+	err := filepath.WalkDir(filepath.Join(root, filepath.FromSlash(start)), func(file string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) || isNotDir(err) {
+				return nil
+			}
+
+			return err
+		}
+
+		rel, err := filepath.Rel(root, file)
+		if err != nil || rel == "." {
+			return err
+		}
+
+		name := filepath.ToSlash(rel)
+		if d.IsDir() {
+			name += "/"
+		} else if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if !match(name) {
+			return nil
+		}
+
+		obj, err := x.live(bucket, name)
+		if err != nil || obj == nil {
+			return err
+		}
+
+		collect(obj.attrs)
+		// done!
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if query.Versions {
+		root := filepath.Join(x.metadataDir(bucket), "versions")
+		// walk the noncurrent versions
+		err := filepath.WalkDir(root, func(file string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+
+				return err
+			}
+
+			if d.IsDir() || strings.HasSuffix(file, ".json") {
+				return nil
+			}
+
+			rel, err := filepath.Rel(root, file)
+			if err != nil {
+				return err
+			}
+
+			rel = filepath.ToSlash(rel)
+			// split the generation
+			index := strings.LastIndex(rel, "#")
+			if index < 0 || !match(rel[:index]) {
+				return nil
+			}
+
+			attrs := &storage.ObjectAttrs{}
+			if err := readJSON(file+".json", attrs); err != nil {
+				return err
+			}
+
+			collect(attrs)
+			// done!
+			return nil
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return before(items[i], items[j])
+	})
+
+	return items, nil
+}
+
+func before(a, b *entry) bool {
+	if a.name != b.name {
+		return a.name < b.name
+	}
+	// prefixes come first
+	if a.attrs.Prefix != b.attrs.Prefix {
+		return a.attrs.Prefix != ""
+	}
+
+	return a.attrs.Generation < b.attrs.Generation
+}
+
+func same(a, b *entry) bool {
+	return a.name == b.name && a.attrs.Prefix == b.attrs.Prefix && a.attrs.Generation == b.attrs.Generation
+}
+
+func checkConditions(conds *storage.Conditions, obj *object, write bool) error {
+	if conds == nil {
+		return nil
+	}
+
+	if obj == nil {
+		if !write || conds.DoesNotExist {
+			return nil
+		}
+
+		if conds.GenerationMatch != 0 || conds.MetagenerationMatch != 0 {
+			return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+		}
+
+		return nil
+	}
+
+	switch {
+	case conds.DoesNotExist:
+	case conds.GenerationMatch != 0 && obj.attrs.Generation != conds.GenerationMatch:
+	case conds.GenerationNotMatch != 0 && obj.attrs.Generation == conds.GenerationNotMatch:
+	case conds.MetagenerationMatch != 0 && obj.attrs.Metageneration != conds.MetagenerationMatch:
+	case conds.MetagenerationNotMatch != 0 && obj.attrs.Metageneration == conds.MetagenerationNotMatch:
+	default:
+		return nil
+	}
+
+	if !write && conds.GenerationNotMatch != 0 && obj.attrs.Generation == conds.GenerationNotMatch {
+		return newError(http.StatusNotModified, "Not Modified")
+	}
+
+	return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+}
+
+func checkBucketConditions(conds *storage.BucketConditions, b *bucket) error {
+	if conds == nil {
+		return nil
+	}
+
+	switch {
+	case conds.MetagenerationMatch != 0 && b.Attrs.MetaGeneration != conds.MetagenerationMatch:
+	case conds.MetagenerationNotMatch != 0 && b.Attrs.MetaGeneration == conds.MetagenerationNotMatch:
+	default:
+		return nil
+	}
+
+	return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+}
+
+func validateConditions(method string, conds *storage.Conditions) error {
+	if conds == nil {
+		return nil
+	}
+
+	if *conds == (storage.Conditions{}) {
+		return fmt.Errorf("storage: %s: empty conditions", method)
+	}
+
+	generation := 0
+	// count the generation conditions
+	for _, ok := range []bool{conds.GenerationMatch != 0, conds.GenerationNotMatch != 0, conds.DoesNotExist} {
+		if ok {
+			generation++
+		}
+	}
+
+	if generation > 1 {
+		return fmt.Errorf("storage: %s: multiple conditions specified for generation", method)
+	}
+
+	if conds.MetagenerationMatch != 0 && conds.MetagenerationNotMatch != 0 {
+		return fmt.Errorf("storage: %s: multiple conditions specified for metageneration", method)
+	}
+
+	return nil
+}
+
+// checkKey verifies the customer-supplied encryption key of a read.
+func checkKey(key []byte, obj *object) error {
+	if obj.attrs.CustomerKeySHA256 == "" {
+		if len(key) > 0 {
+			return newError(http.StatusBadRequest, "The target object is not encrypted by a customer-supplied encryption key.")
+		}
+
+		return nil
+	}
+
+	if len(key) == 0 {
+		return newError(http.StatusBadRequest, "The target object is encrypted by a customer-supplied encryption key.")
+	}
+
+	sum := sha256.Sum256(key)
+	// compare the key hashes
+	if base64.StdEncoding.EncodeToString(sum[:]) != obj.attrs.CustomerKeySHA256 {
+		return newError(http.StatusBadRequest, "The provided encryption key is incorrect.")
+	}
+
+	return nil
+}
+
+func readJSON(name string, v any) error {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// writeJSON atomically replaces the file with the JSON encoding of v.
+func writeJSON(name string, v any) error {
+	staged, err := stageJSON(name, v)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Rename(staged, name); err != nil {
+		os.Remove(staged)
+		return err
+	}
+
+	return nil
+}
+
+// stageJSON writes the JSON encoding of v to a temporary file next to the
+// file and returns its name. Renaming it over the file replaces the file
+// atomically.
+func stageJSON(name string, v any) (string, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o755); err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(name), ".tmp-*")
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(file.Name())
+		return "", err
+	}
+
+	return file.Name(), nil
+}
+
+// link makes the file available under the target name as well. The file is
+// copied when the filesystem does not support hard links.
+func link(name, target string) error {
+	if err := os.Link(name, target); err == nil {
+		return nil
+	}
+
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(target)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		os.Remove(target)
+		return err
+	}
+
+	if err := dst.Close(); err != nil {
+		os.Remove(target)
+		return err
+	}
+
+	return nil
+}
+
+// isNotDir reports whether a path component is a file.
+func isNotDir(err error) bool {
+	return errors.Is(err, syscall.ENOTDIR)
+}
+
+func conflict(name string, err error) error {
+	return newError(http.StatusConflict, "Object '%s' conflicts with an existing file or directory: %v", name, err)
+}
+
+func newError(code int, format string, args ...any) error {
+	return &googleapi.Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func etag(generation, metageneration int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(generation, 10) + "/" + strconv.FormatInt(metageneration, 10)))
+}
+
+func mediaLink(bucket, name string, generation int64) string {
+	return fmt.Sprintf("https://storage.googleapis.com/download/storage/v1/b/%s/o/%s?generation=%d&alt=media", bucket, escape(name), generation)
+}
+
+func escape(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "%", "%25"), "/", "%2F")
+}
+
+func cloneObjectAttrs(attrs *storage.ObjectAttrs) *storage.ObjectAttrs {
+	if attrs == nil {
+		return &storage.ObjectAttrs{}
+	}
+
+	result := *attrs
+
+	if attrs.ACL != nil {
+		result.ACL = append([]storage.ACLRule(nil), attrs.ACL...)
+	}
+
+	if attrs.MD5 != nil {
+		result.MD5 = append([]byte(nil), attrs.MD5...)
+	}
+
+	if attrs.Metadata != nil {
+		result.Metadata = make(map[string]string, len(attrs.Metadata))
+		// copy the metadata
+		for k, v := range attrs.Metadata {
+			result.Metadata[k] = v
+		}
+	}
+
+	if attrs.Retention != nil {
+		retention := *attrs.Retention
+		result.Retention = &retention
+	}
+
+	return &result
+}
+
+func cloneBucketAttrs(attrs *storage.BucketAttrs) *storage.BucketAttrs {
+	result := *attrs
+	result.ACL = append([]storage.ACLRule(nil), attrs.ACL...)
+	result.DefaultObjectACL = append([]storage.ACLRule(nil), attrs.DefaultObjectACL...)
+
+	if attrs.Labels != nil {
+		result.Labels = make(map[string]string, len(attrs.Labels))
+		// copy the labels
+		for k, v := range attrs.Labels {
+			result.Labels[k] = v
+		}
+	}
+
+	if attrs.RetentionPolicy != nil {
+		policy := *attrs.RetentionPolicy
+		result.RetentionPolicy = &policy
+	}
+
+	return &result
+}
+
+func cloneNotification(n *storage.Notification) *storage.Notification {
+	result := *n
+	result.EventTypes = append([]string(nil), n.EventTypes...)
+
+	if n.CustomAttributes != nil {
+		result.CustomAttributes = make(map[string]string, len(n.CustomAttributes))
+		// copy the attributes
+		for k, v := range n.CustomAttributes {
+			result.CustomAttributes[k] = v
+		}
+	}
+
+	return &result
+}
@@ -0,0 +1,83 @@
+package storagev1mem
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageTransferCheckpoint = &StorageTransferCheckpoint{}
+
+// StorageTransferCheckpoint represents an in-memory checkpoint of a transfer.
+type StorageTransferCheckpoint struct {
+	checkpoint[*storagev1.StorageTransferState]
+}
+
+// NewStorageTransferCheckpoint creates a new in-memory
+// StorageTransferCheckpoint.
+func NewStorageTransferCheckpoint() *StorageTransferCheckpoint {
+	return &StorageTransferCheckpoint{
+		checkpoint: checkpoint[*storagev1.StorageTransferState]{clone: cloneTransferState},
+	}
+}
+
+func cloneTransferState(state *storagev1.StorageTransferState) *storagev1.StorageTransferState {
+	if state == nil {
+		return nil
+	}
+
+	return &storagev1.StorageTransferState{
+		After: state.After,
+		Done:  slices.Clone(state.Done),
+	}
+}
+
+var _ storagev1.StorageObjectEventCheckpoint = &StorageObjectEventCheckpoint{}
+
+// StorageObjectEventCheckpoint represents an in-memory checkpoint of a
+// backfill.
+type StorageObjectEventCheckpoint struct {
+	checkpoint[string]
+}
+
+// NewStorageObjectEventCheckpoint creates a new in-memory
+// StorageObjectEventCheckpoint.
+func NewStorageObjectEventCheckpoint() *StorageObjectEventCheckpoint {
+	return &StorageObjectEventCheckpoint{}
+}
+
+// checkpoint is an in-memory checkpoint of a state. The state is cloned when
+// it is saved and when it is loaded, so that the caller cannot change it.
+type checkpoint[T any] struct {
+	mu    sync.Mutex
+	state T
+	clone func(T) T
+}
+
+// Load returns the saved state, or the zero state when none is saved.
+func (x *checkpoint[T]) Load(ctx context.Context) (T, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// done!
+	return x.copy(x.state), nil
+}
+
+// Save saves the state.
+func (x *checkpoint[T]) Save(ctx context.Context, state T) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the state
+	x.state = x.copy(state)
+	// done!
+	return nil
+}
+
+func (x *checkpoint[T]) copy(state T) T {
+	if x.clone == nil {
+		return state
+	}
+
+	return x.clone(state)
+}
@@ -0,0 +1,59 @@
+package storagev1mem_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+func TestStorageTransferCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	checkpoint := storagev1mem.NewStorageTransferCheckpoint()
+
+	if state, err := checkpoint.Load(ctx); state != nil || err != nil {
+		t.Fatalf("Load() = %v, %v, want nil", state, err)
+	}
+
+	state := &storagev1.StorageTransferState{After: "b", Done: []string{"c", "d"}}
+	if err := checkpoint.Save(ctx, state); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	// the checkpoint keeps its own copy
+	state.Done[0] = "x"
+
+	got, err := checkpoint.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if got.After != "b" || !slices.Equal(got.Done, []string{"c", "d"}) {
+		t.Errorf("Load() = %+v, want b and [c d]", got)
+	}
+
+	got.Done[1] = "y"
+
+	if again, _ := checkpoint.Load(ctx); again.Done[1] != "d" {
+		t.Errorf("Load() after a change of the loaded state = %+v, want [c d]", again)
+	}
+}
+
+func TestStorageObjectEventCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	checkpoint := storagev1mem.NewStorageObjectEventCheckpoint()
+
+	if name, err := checkpoint.Load(ctx); name != "" || err != nil {
+		t.Fatalf("Load() = %q, %v, want empty", name, err)
+	}
+
+	if err := checkpoint.Save(ctx, "logs/a"); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	if name, err := checkpoint.Load(ctx); name != "logs/a" || err != nil {
+		t.Errorf("Load() = %q, %v, want logs/a", name, err)
+	}
+}
@@ -0,0 +1,167 @@
+package storagev1mem
+
+import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"sync"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageObjectEventStore = &StorageObjectEventStore{}
+
+// StorageObjectEventStore represents an in-memory store of the handled storage
+// object events. The keys that are done expire after the TTL, and the least
+// recently used keys are evicted when the store is full. The keys that are
+// claimed are not evicted until their lease expires, so the store may exceed
+// its size while more keys are being handled. A claim is completed or released
+// only with the token that Acquire returned for it, so that a delivery whose
+// lease has expired does not complete or release the claim of another
+// delivery.
+type StorageObjectEventStore struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	entries map[string]*list.Element
+	order   *list.List
+	now     func() time.Time
+}
+
+type eventEntry struct {
+	key     string
+	token   string
+	state   storagev1.StorageObjectEventState
+	expires time.Time
+}
+
+// NewStorageObjectEventStore creates a new in-memory StorageObjectEventStore
+// that keeps the keys that are done for the ttl and at most size keys. A zero
+// ttl keeps the keys until they are evicted, and a zero size does not bound
+// the store.
+func NewStorageObjectEventStore(ttl time.Duration, size int) *StorageObjectEventStore {
+	return &StorageObjectEventStore{
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*list.Element),
+		order:   list.New(),
+		now:     time.Now,
+	}
+}
+
+// SetClock sets the clock used to expire the keys.
+func (x *StorageObjectEventStore) SetClock(fn func() time.Time) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// set the clock
+	x.now = fn
+}
+
+// Acquire implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Acquire(ctx context.Context, key string, lease time.Duration) (storagev1.StorageObjectEventState, string, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	now := x.now()
+
+	if elem, ok := x.entries[key]; ok {
+		entry := elem.Value.(*eventEntry)
+		// the expired keys are unknown
+		if entry.expires.IsZero() || now.Before(entry.expires) {
+			x.order.MoveToFront(elem)
+			return entry.state, "", nil
+		}
+
+		x.remove(elem)
+	}
+
+	token := rand.Text()
+	// claim the key
+	x.entries[key] = x.order.PushFront(&eventEntry{
+		key:     key,
+		token:   token,
+		state:   storagev1.StorageObjectEventStatePending,
+		expires: now.Add(lease),
+	})
+	x.evict(now)
+
+	// done!
+	return storagev1.StorageObjectEventStateNew, token, nil
+}
+
+// Complete implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Complete(ctx context.Context, key, token string) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	elem, ok := x.entries[key]
+	if !ok {
+		// the expired claim has been evicted
+		elem = x.order.PushFront(&eventEntry{key: key, token: token})
+		x.entries[key] = elem
+	}
+
+	entry := elem.Value.(*eventEntry)
+	// the claim must still be ours
+	if entry.token != token {
+		return storagev1.ErrStorageEventClaimLost
+	}
+
+	entry.state = storagev1.StorageObjectEventStateDone
+	entry.expires = time.Time{}
+	// expire the key after the ttl
+	if x.ttl > 0 {
+		entry.expires = x.now().Add(x.ttl)
+	}
+
+	x.order.MoveToFront(elem)
+	x.evict(x.now())
+
+	// done!
+	return nil
+}
+
+// Release implements storagev1.StorageObjectEventStore.
+func (x *StorageObjectEventStore) Release(ctx context.Context, key, token string) error {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	// the claim of another delivery is left in place
+	if elem, ok := x.entries[key]; ok && elem.Value.(*eventEntry).token == token {
+		x.remove(elem)
+	}
+
+	// done!
+	return nil
+}
+
+// Len returns the number of the keys in the store, including the expired keys
+// that have not been removed yet.
+func (x *StorageObjectEventStore) Len() int {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	// done!
+	return x.order.Len()
+}
+
+// evict removes the least recently used keys that are done or expired while
+// the store is full.
+func (x *StorageObjectEventStore) evict(now time.Time) {
+	for elem := x.order.Back(); elem != nil && x.size > 0 && x.order.Len() > x.size; {
+		prev := elem.Prev()
+
+		entry := elem.Value.(*eventEntry)
+		// keep the claimed keys
+		if entry.state == storagev1.StorageObjectEventStateDone || !now.Before(entry.expires) {
+			x.remove(elem)
+		}
+
+		elem = prev
+	}
+}
+
+func (x *StorageObjectEventStore) remove(elem *list.Element) {
+	x.order.Remove(elem)
+	delete(x.entries, elem.Value.(*eventEntry).key)
+}
@@ -0,0 +1,165 @@
+package storagev1mem_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+func acquire(t *testing.T, store storagev1.StorageObjectEventStore, key string, lease time.Duration) (storagev1.StorageObjectEventState, string) {
+	t.Helper()
+
+	state, token, err := store.Acquire(context.Background(), key, lease)
+	if err != nil {
+		t.Fatalf("Acquire(%q): %v", key, err)
+	}
+
+	if (state == storagev1.StorageObjectEventStateNew) != (token != "") {
+		t.Fatalf("Acquire(%q) = %v with token %q", key, state, token)
+	}
+
+	return state, token
+}
+
+func TestStorageObjectEventStore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := storagev1mem.NewStorageObjectEventStore(time.Hour, 0)
+	store.SetClock(func() time.Time { return now })
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() of a claimed key = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+
+	// the lease expires
+	now = now.Add(2 * time.Minute)
+	got, token := acquire(t, store, "a", time.Minute)
+	if got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() after the lease = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	if err := store.Complete(context.Background(), "a", token); err != nil {
+		t.Fatalf("Complete: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateDone {
+		t.Errorf("Acquire() of a done key = %v, want %v", got, storagev1.StorageObjectEventStateDone)
+	}
+
+	// the done key expires after the ttl
+	now = now.Add(2 * time.Hour)
+	if got, token = acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() after the ttl = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+
+	if err := store.Release(context.Background(), "a", token); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire() after Release = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+}
+
+func TestStorageObjectEventStoreEviction(t *testing.T) {
+	store := storagev1mem.NewStorageObjectEventStore(0, 2)
+
+	for _, key := range []string{"a", "b"} {
+		_, token := acquire(t, store, key, time.Minute)
+
+		if err := store.Complete(context.Background(), key, token); err != nil {
+			t.Fatalf("Complete: %v", err)
+		}
+	}
+
+	// use a, so that b is the least recently used key
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateDone {
+		t.Errorf("Acquire(a) = %v, want %v", got, storagev1.StorageObjectEventStateDone)
+	}
+
+	acquire(t, store, "c", time.Minute)
+
+	if got := store.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateDone {
+		t.Errorf("Acquire(a) = %v, want %v", got, storagev1.StorageObjectEventStateDone)
+	}
+
+	if got, _ := acquire(t, store, "b", time.Minute); got != storagev1.StorageObjectEventStateNew {
+		t.Errorf("Acquire(b) after eviction = %v, want %v", got, storagev1.StorageObjectEventStateNew)
+	}
+}
+
+func TestStorageObjectEventStoreEvictionPending(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := storagev1mem.NewStorageObjectEventStore(0, 2)
+	store.SetClock(func() time.Time { return now })
+
+	for _, key := range []string{"a", "b", "c"} {
+		acquire(t, store, key, time.Minute)
+	}
+
+	// the claimed keys are kept beyond the size
+	if got := store.Len(); got != 3 {
+		t.Errorf("Len() = %d, want 3", got)
+	}
+
+	for _, key := range []string{"a", "b", "c"} {
+		if got, _ := acquire(t, store, key, time.Minute); got != storagev1.StorageObjectEventStatePending {
+			t.Errorf("Acquire(%s) = %v, want %v", key, got, storagev1.StorageObjectEventStatePending)
+		}
+	}
+
+	// the claimed keys are evicted once their lease expires
+	now = now.Add(2 * time.Minute)
+	acquire(t, store, "d", time.Minute)
+
+	if got := store.Len(); got != 2 {
+		t.Errorf("Len() after the lease = %d, want 2", got)
+	}
+}
+
+func TestStorageObjectEventStoreClaimLost(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	store := storagev1mem.NewStorageObjectEventStore(0, 0)
+	store.SetClock(func() time.Time { return now })
+
+	_, first := acquire(t, store, "a", time.Minute)
+
+	// the lease of the first delivery expires and another delivery takes the
+	// key over
+	now = now.Add(2 * time.Minute)
+	if got, second := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStateNew || second == first {
+		t.Fatalf("Acquire() after the lease = %v, %q, want a new claim", got, second)
+	}
+
+	// the late release of the first delivery leaves the claim in place
+	if err := store.Release(context.Background(), "a", first); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() after a stale Release = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+
+	// the late completion of the first delivery fails
+	if err := store.Complete(context.Background(), "a", first); !errors.Is(err, storagev1.ErrStorageEventClaimLost) {
+		t.Errorf("Complete() with a stale token = %v, want ErrStorageEventClaimLost", err)
+	}
+
+	if got, _ := acquire(t, store, "a", time.Minute); got != storagev1.StorageObjectEventStatePending {
+		t.Errorf("Acquire() after a stale Complete = %v, want %v", got, storagev1.StorageObjectEventStatePending)
+	}
+}
@@ -0,0 +1,1657 @@
+package storagev1mem
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// DefaultPageSize is the number of items an iterator fetches per page.
+const DefaultPageSize = 1000
+
+// ErrWriterClosed is returned when writing to a closed writer.
+var ErrWriterClosed = errors.New("storage: writer is closed")
+
+// ErrReaderClosed is returned when reading from a closed reader.
+var ErrReaderClosed = errors.New("storage: reader is closed")
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents an in-memory storage service client.
+type StorageServiceClient struct {
+	store *store
+}
+
+// NewStorageServiceClient creates a new in-memory StorageServiceClient.
+func NewStorageServiceClient() *StorageServiceClient {
+	return &StorageServiceClient{store: newStore()}
+}
+
+// SetNotificationHandler sets the handler of the events that the notification
+// configs of the buckets publish. The events are delivered synchronously once
+// the change is done, and they are not redelivered when the handler fails.
+func (x *StorageServiceClient) SetNotificationHandler(handler storagev1.StorageObjectEventHandler) {
+	x.store.notifier.SetHandler(handler)
+}
+
+// SetNotificationErrorHandler sets the handler of the notifications that
+// cannot be delivered, such as the events that the notification handler
+// fails. They are logged with the default logger when it is not set.
+func (x *StorageServiceClient) SetNotificationErrorHandler(fn func(context.Context, error)) {
+	x.store.notifier.SetErrorHandler(fn)
+}
+
+// SetClock sets the clock used to stamp generations and times.
+func (x *StorageServiceClient) SetClock(fn func() time.Time) {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+	// set the clock
+	x.store.now = fn
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{store: x.store, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{ctx: ctx, store: x.store, projectID: projectID, page: page{size: DefaultPageSize}}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return nil
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents an in-memory storage bucket handle.
+type StorageBucketHandle struct {
+	store       *store
+	name        string
+	conds       *storage.BucketConditions
+	userProject string
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.name, kind: bucketACL}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	if n.ID != "" {
+		return nil, errors.New("storage: AddNotification: ID must not be set")
+	}
+
+	if n.TopicProjectID == "" {
+		return nil, errors.New("storage: AddNotification: missing TopicProjectID")
+	}
+
+	if n.TopicID == "" {
+		return nil, errors.New("storage: AddNotification: missing TopicID")
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	b.notificationID++
+	// prepare the notification
+	result := cloneNotification(n)
+	result.ID = strconv.Itoa(b.notificationID)
+
+	if result.PayloadFormat == "" {
+		result.PayloadFormat = storage.JSONPayload
+	}
+
+	b.notifications[result.ID] = result
+	// done!
+	return cloneNotification(result), nil
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return nil, err
+	}
+
+	return cloneBucketAttrs(b.attrs), nil
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if projectID == "" {
+		return errors.New("storage: missing project ID")
+	}
+
+	if !bucketNamePattern.MatchString(x.name) {
+		return newError(http.StatusBadRequest, "Invalid bucket name: '%s'", x.name)
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	if _, ok := x.store.buckets[x.name]; ok {
+		return newError(http.StatusConflict, "Your previous request to create the named bucket succeeded and you already own it.")
+	}
+
+	if attrs == nil {
+		attrs = &storage.BucketAttrs{}
+	}
+
+	now := x.store.now().UTC()
+	// prepare the attributes
+	result := cloneBucketAttrs(attrs)
+	result.Name = x.name
+	result.Created = now
+	result.Updated = now
+	result.MetaGeneration = 1
+	result.Etag = etag(1, 1)
+
+	if result.Location == "" {
+		result.Location = "US"
+		result.LocationType = "multi-region"
+	}
+
+	if result.StorageClass == "" {
+		result.StorageClass = "STANDARD"
+	}
+
+	if policy := result.RetentionPolicy; policy != nil {
+		policy.EffectiveTime = now
+		policy.IsLocked = false
+	}
+
+	x.store.buckets[x.name] = &bucket{
+		projectID:     projectID,
+		attrs:         result,
+		objects:       make(map[string]*object),
+		versions:      make(map[string][]*object),
+		notifications: make(map[string]*storage.Notification),
+	}
+
+	return nil
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.name, kind: defaultObjectACL}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return err
+	}
+
+	if len(b.objects) > 0 || len(b.versions) > 0 {
+		return newError(http.StatusConflict, "The bucket you tried to delete is not empty.")
+	}
+
+	delete(x.store.buckets, x.name)
+	// done!
+	return nil
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := b.notifications[id]; !ok {
+		return newError(http.StatusNotFound, "Not Found")
+	}
+
+	delete(b.notifications, id)
+	// done!
+	return nil
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(cond storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return err
+	}
+
+	var metageneration int64
+	// the lock requires a metageneration precondition
+	if x.conds != nil {
+		metageneration = x.conds.MetagenerationMatch
+	}
+
+	if metageneration != b.attrs.MetaGeneration {
+		return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+	}
+
+	if b.attrs.RetentionPolicy == nil {
+		return newError(http.StatusBadRequest, "Bucket '%s' does not have a retention policy.", x.name)
+	}
+
+	b.attrs.RetentionPolicy.IsLocked = true
+	b.attrs.MetaGeneration++
+	b.attrs.Etag = etag(1, b.attrs.MetaGeneration)
+	// done!
+	return nil
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	dictionary := make(map[string]*storage.Notification)
+	// copy the dictionary
+	for k, v := range b.notifications {
+		dictionary[k] = cloneNotification(v)
+	}
+	// done!
+	return dictionary, nil
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{store: x.store, bucket: x.name, object: name, gen: -1}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	if query == nil {
+		query = &storage.Query{}
+	}
+
+	iterator := &StorageObjectIterator{
+		ctx:    ctx,
+		store:  x.store,
+		bucket: x.name,
+		query:  *query,
+		page:   page{size: DefaultPageSize},
+	}
+
+	return iterator
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkBucketConditions(x.conds, b); err != nil {
+		return nil, err
+	}
+
+	result := cloneBucketAttrs(b.attrs)
+	// apply the changes
+	if attrs.VersioningEnabled != nil {
+		result.VersioningEnabled = attrs.VersioningEnabled.(bool)
+	}
+
+	if attrs.RequesterPays != nil {
+		result.RequesterPays = attrs.RequesterPays.(bool)
+	}
+
+	if attrs.DefaultEventBasedHold != nil {
+		result.DefaultEventBasedHold = attrs.DefaultEventBasedHold.(bool)
+	}
+
+	if attrs.BucketPolicyOnly != nil {
+		result.BucketPolicyOnly = *attrs.BucketPolicyOnly
+	}
+
+	if attrs.UniformBucketLevelAccess != nil {
+		result.UniformBucketLevelAccess = *attrs.UniformBucketLevelAccess
+	}
+
+	if attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		result.PublicAccessPrevention = attrs.PublicAccessPrevention
+	}
+
+	if attrs.StorageClass != "" {
+		result.StorageClass = attrs.StorageClass
+	}
+
+	if attrs.RetentionPolicy != nil {
+		if policy := b.attrs.RetentionPolicy; policy != nil && policy.IsLocked {
+			if attrs.RetentionPolicy.RetentionPeriod < policy.RetentionPeriod {
+				return nil, newError(http.StatusForbidden, "Cannot reduce retention duration of a locked Retention Policy for bucket '%s'.", x.name)
+			}
+		}
+
+		if attrs.RetentionPolicy.RetentionPeriod == 0 {
+			result.RetentionPolicy = nil
+		} else {
+			result.RetentionPolicy = &storage.RetentionPolicy{
+				RetentionPeriod: attrs.RetentionPolicy.RetentionPeriod,
+				EffectiveTime:   x.store.now().UTC(),
+			}
+
+			if b.attrs.RetentionPolicy != nil {
+				result.RetentionPolicy.IsLocked = b.attrs.RetentionPolicy.IsLocked
+			}
+		}
+	}
+
+	if attrs.CORS != nil {
+		result.CORS = attrs.CORS
+	}
+
+	if attrs.Encryption != nil {
+		result.Encryption = attrs.Encryption
+	}
+
+	if attrs.Lifecycle != nil {
+		result.Lifecycle = *attrs.Lifecycle
+	}
+
+	if attrs.Logging != nil {
+		result.Logging = attrs.Logging
+	}
+
+	if attrs.Website != nil {
+		result.Website = attrs.Website
+	}
+
+	set, unset, err := storagev1.StorageBucketLabelsToUpdate(&attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	// apply the labels
+	if len(set) > 0 || len(unset) > 0 {
+		if result.Labels == nil {
+			result.Labels = make(map[string]string)
+		}
+
+		for k, v := range set {
+			result.Labels[k] = v
+		}
+
+		for _, k := range unset {
+			delete(result.Labels, k)
+		}
+	}
+
+	result.MetaGeneration++
+	result.Updated = x.store.now().UTC()
+	result.Etag = etag(1, result.MetaGeneration)
+	// store the attributes
+	b.attrs = result
+	// done!
+	return cloneBucketAttrs(result), nil
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.userProject = projectID
+	// done!
+	return &handle
+}
+
+type page struct {
+	token string
+	size  int
+	items int
+	done  bool
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents an in-memory storage bucket iterator.
+type StorageBucketIterator struct {
+	ctx       context.Context
+	store     *store
+	projectID string
+	items     []*storage.BucketAttrs
+	page      page
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		x.fetch()
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageBucketIterator) fetch() {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	var names []string
+	// collect the bucket names
+	for name, b := range x.store.buckets {
+		if b.projectID == x.projectID && name > x.page.token {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	if len(names) > x.page.size {
+		names = names[:x.page.size]
+		x.page.token = names[len(names)-1]
+	} else {
+		x.page.token = ""
+		x.page.done = true
+	}
+
+	for _, name := range names {
+		x.items = append(x.items, cloneBucketAttrs(x.store.buckets[name].attrs))
+	}
+
+	x.page.items = len(x.items)
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+type aclKind int
+
+const (
+	bucketACL aclKind = iota
+	defaultObjectACL
+	objectACL
+)
+
+// StorageACLHandle represents an in-memory storage ACL handle.
+type StorageACLHandle struct {
+	store  *store
+	bucket string
+	object *StorageObjectHandle
+	kind   aclKind
+}
+
+func (x *StorageACLHandle) rules() (*[]storage.ACLRule, error) {
+	b, err := x.store.bucket(x.bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x.kind {
+	case bucketACL:
+		return &b.attrs.ACL, nil
+	case defaultObjectACL:
+		return &b.attrs.DefaultObjectACL, nil
+	}
+
+	_, obj, err := x.store.object(x.object)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	return &obj.attrs.ACL, nil
+}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	rules, err := x.rules()
+	if err != nil {
+		return err
+	}
+
+	for index, rule := range *rules {
+		if rule.Entity == entity {
+			*rules = append((*rules)[:index:index], (*rules)[index+1:]...)
+			return nil
+		}
+	}
+
+	return newError(http.StatusNotFound, "Not Found")
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	rules, err := x.rules()
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]storage.ACLRule{}, *rules...), nil
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	rules, err := x.rules()
+	if err != nil {
+		return err
+	}
+
+	for index, rule := range *rules {
+		if rule.Entity == entity {
+			(*rules)[index].Role = role
+			return nil
+		}
+	}
+
+	*rules = append(*rules, storage.ACLRule{Entity: entity, Role: role})
+	// done!
+	return nil
+}
+
+var _ storagev1.StorageObjectHandle = &StorageObjectHandle{}
+
+// StorageObjectHandle represents an in-memory storage object handle.
+type StorageObjectHandle struct {
+	store      *store
+	bucket     string
+	object     string
+	gen        int64
+	conds      *storage.Conditions
+	key        []byte
+	compressed bool
+}
+
+func (x *StorageObjectHandle) validate() error {
+	if x.bucket == "" {
+		return errors.New("storage: bucket name is empty")
+	}
+
+	if x.object == "" {
+		return errors.New("storage: object name is empty")
+	}
+
+	if x.object == "." || x.object == ".." {
+		return fmt.Errorf("storage: object name %q is not valid", x.object)
+	}
+
+	if len(x.key) > 0 && len(x.key) != 32 {
+		return errors.New("storage: customer-supplied encryption key must be 32 bytes")
+	}
+
+	return nil
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{store: x.store, bucket: x.bucket, object: x, kind: objectACL}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("Attrs", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	_, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, false); err != nil {
+		return nil, err
+	}
+
+	return cloneObjectAttrs(obj.attrs), nil
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(handles ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	return &StorageObjectComposer{dst: x, srcs: handles}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(handle storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	return &StorageObjectCopier{dst: x, src: handle}
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	if err := x.validate(); err != nil {
+		return err
+	}
+
+	if err := validateConditions("Delete", x.conds); err != nil {
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	defer x.store.notifier.Flush(ctx)
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, obj, err := x.store.object(x)
+	if err != nil {
+		return err
+	}
+
+	if obj == nil {
+		return storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, true); err != nil {
+		return err
+	}
+
+	if err := x.store.checkMutable(obj); err != nil {
+		return err
+	}
+
+	now := x.store.now().UTC()
+	kind := storagev1.StorageObjectEventType_OBJECT_DELETE
+
+	if b.objects[x.object] == obj {
+		kind = storagev1.RetiredStorageObjectEventType(b.attrs)
+		x.store.archive(b, obj, now)
+	} else {
+		x.store.remove(b, obj)
+	}
+
+	x.store.notifier.Notify(b.notifications, &storagev1.StorageObjectChange{
+		Type:   kind,
+		Object: obj.attrs,
+		Time:   now,
+	})
+
+	return nil
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.gen = gen
+	// done!
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(cond storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(data []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.key = append([]byte(nil), data...)
+	// done!
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset int64, length int64) (storagev1.StorageObjectReader, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if offset < 0 && length >= 0 {
+		return nil, fmt.Errorf("storage: invalid offset %d < 0 requires negative length", offset)
+	}
+
+	if err := validateConditions("NewRangeReader", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	_, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, false); err != nil {
+		return nil, err
+	}
+
+	if err := checkKey(x.key, obj); err != nil {
+		return nil, err
+	}
+
+	data, decompressed, err := content(obj, x.compressed)
+	if err != nil {
+		return nil, err
+	}
+
+	size := int64(len(data))
+	// decompressed objects are served whole
+	if !decompressed {
+		switch {
+		case offset < 0:
+			offset = max(size+offset, 0)
+		case offset > size || (offset == size && size > 0):
+			return nil, newError(http.StatusRequestedRangeNotSatisfiable, "The requested range cannot be satisfied.")
+		}
+
+		end := size
+		if length >= 0 && offset+length < size {
+			end = offset + length
+		}
+
+		data = data[offset:end]
+	}
+
+	reader := &StorageObjectReader{
+		ctx:             ctx,
+		reader:          bytes.NewReader(data),
+		size:            size,
+		contentType:     obj.attrs.ContentType,
+		contentEncoding: obj.attrs.ContentEncoding,
+		cacheControl:    obj.attrs.CacheControl,
+		generation:      obj.attrs.Generation,
+	}
+
+	if decompressed {
+		reader.contentEncoding = ""
+	}
+
+	return reader, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	writer := &StorageObjectWriter{
+		ctx:    ctx,
+		handle: x,
+		attrs: storage.ObjectAttrs{
+			Bucket: x.bucket,
+			Name:   x.object,
+		},
+	}
+
+	return writer
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.compressed = compressed
+	// done!
+	return &handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, attrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	if err := x.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("Update", x.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	defer x.store.notifier.Flush(ctx)
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, obj, err := x.store.object(x)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(x.conds, obj, true); err != nil {
+		return nil, err
+	}
+
+	result := cloneObjectAttrs(obj.attrs)
+	// apply the changes
+	if attrs.EventBasedHold != nil {
+		result.EventBasedHold = attrs.EventBasedHold.(bool)
+	}
+
+	if attrs.TemporaryHold != nil {
+		result.TemporaryHold = attrs.TemporaryHold.(bool)
+	}
+
+	if attrs.ContentType != nil {
+		result.ContentType = attrs.ContentType.(string)
+	}
+
+	if attrs.ContentLanguage != nil {
+		result.ContentLanguage = attrs.ContentLanguage.(string)
+	}
+
+	if attrs.ContentEncoding != nil {
+		result.ContentEncoding = attrs.ContentEncoding.(string)
+	}
+
+	if attrs.ContentDisposition != nil {
+		result.ContentDisposition = attrs.ContentDisposition.(string)
+	}
+
+	if attrs.CacheControl != nil {
+		result.CacheControl = attrs.CacheControl.(string)
+	}
+
+	if !attrs.CustomTime.IsZero() {
+		if attrs.CustomTime.Before(result.CustomTime) {
+			return nil, newError(http.StatusBadRequest, "The custom time cannot be decreased.")
+		}
+
+		result.CustomTime = attrs.CustomTime
+	}
+
+	if attrs.Metadata != nil {
+		if len(attrs.Metadata) == 0 {
+			result.Metadata = nil
+		}
+
+		for k, v := range attrs.Metadata {
+			if v == "" {
+				delete(result.Metadata, k)
+				continue
+			}
+
+			if result.Metadata == nil {
+				result.Metadata = make(map[string]string)
+			}
+
+			result.Metadata[k] = v
+		}
+	}
+
+	if attrs.ACL != nil {
+		result.ACL = append([]storage.ACLRule{}, attrs.ACL...)
+	}
+
+	if attrs.Retention != nil {
+		retention := *attrs.Retention
+		result.Retention = &retention
+	}
+
+	now := x.store.now().UTC()
+	// bump the metageneration
+	result.Metageneration++
+	result.Updated = now
+	result.Etag = etag(result.Generation, result.Metageneration)
+	// store the attributes
+	obj.attrs = result
+
+	x.store.notifier.Notify(b.notifications, &storagev1.StorageObjectChange{
+		Type:   storagev1.StorageObjectEventType_OBJECT_METADATA_UPDATE,
+		Object: result,
+		Time:   now,
+	})
+	// done!
+	return cloneObjectAttrs(result), nil
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents an in-memory storage object iterator.
+type StorageObjectIterator struct {
+	ctx    context.Context
+	store  *store
+	bucket string
+	query  storage.Query
+	items  []*storage.ObjectAttrs
+	page   page
+	cursor *entry
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		if err := x.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageObjectIterator) fetch() error {
+	x.store.mu.Lock()
+	defer x.store.mu.Unlock()
+
+	b, err := x.store.bucket(x.bucket)
+	if err != nil {
+		return err
+	}
+
+	items, err := x.store.list(b, &x.query)
+	if err != nil {
+		return err
+	}
+
+	if x.cursor != nil {
+		index := sort.Search(len(items), func(i int) bool {
+			return !before(items[i], x.cursor) && !same(items[i], x.cursor)
+		})
+
+		items = items[index:]
+	}
+
+	if len(items) > x.page.size {
+		items = items[:x.page.size]
+		x.cursor = items[len(items)-1]
+		x.page.token = token(x.cursor)
+	} else {
+		x.page.token = ""
+		x.page.done = true
+	}
+
+	for _, item := range items {
+		x.items = append(x.items, cloneObjectAttrs(item.attrs))
+	}
+
+	x.page.items = len(x.items)
+	// done!
+	return nil
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+func before(a, b *entry) bool {
+	if a.name != b.name {
+		return a.name < b.name
+	}
+
+	if a.attrs.Prefix != b.attrs.Prefix {
+		return a.attrs.Prefix != ""
+	}
+
+	return a.attrs.Generation < b.attrs.Generation
+}
+
+func same(a, b *entry) bool {
+	return a.name == b.name && a.attrs.Prefix == b.attrs.Prefix && a.attrs.Generation == b.attrs.Generation
+}
+
+func token(e *entry) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(e.name + "\n" + strconv.FormatInt(e.attrs.Generation, 10)))
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents an in-memory storage object reader.
+type StorageObjectReader struct {
+	ctx             context.Context
+	reader          *bytes.Reader
+	size            int64
+	contentType     string
+	contentEncoding string
+	cacheControl    string
+	generation      int64
+	closed          bool
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.cacheControl
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	x.closed = true
+	return nil
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.contentEncoding
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.contentType
+}
+
+// Generation returns the generation of the object.
+func (x *StorageObjectReader) Generation() int64 {
+	return x.generation
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrReaderClosed
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	return x.reader.Read(p)
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return int64(x.reader.Len())
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.size
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents an in-memory storage object writer. The data
+// is buffered and becomes visible atomically when the writer is closed.
+type StorageObjectWriter struct {
+	ctx       context.Context
+	handle    *StorageObjectHandle
+	attrs     storage.ObjectAttrs
+	buffer    bytes.Buffer
+	chunkSize int
+	progress  func(int64)
+	crc32c    bool
+	result    *storage.ObjectAttrs
+	closed    bool
+	err       error
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.result
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	if x.closed {
+		return x.err
+	}
+
+	x.closed = true
+	// commit the object
+	x.result, x.err = x.commit()
+	// done!
+	return x.err
+}
+
+func (x *StorageObjectWriter) commit() (*storage.ObjectAttrs, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	if err := x.handle.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("NewWriter", x.handle.conds); err != nil {
+		return nil, err
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data := x.buffer.Bytes()
+	// verify the checksums
+	if x.crc32c && crc32.Checksum(data, crc32cTable) != x.attrs.CRC32C {
+		return nil, newError(http.StatusBadRequest, "Provided CRC32C \"%d\" doesn't match calculated CRC32C \"%d\".", x.attrs.CRC32C, crc32.Checksum(data, crc32cTable))
+	}
+
+	if len(x.attrs.MD5) > 0 {
+		if err := checkMD5(data, x.attrs.MD5); err != nil {
+			return nil, err
+		}
+	}
+
+	attrs := cloneObjectAttrs(&x.attrs)
+	// detect the content type
+	if attrs.ContentType == "" {
+		attrs.ContentType = http.DetectContentType(data)
+	}
+
+	attrs.ComponentCount = 0
+
+	defer x.handle.store.notifier.Flush(x.ctx)
+	x.handle.store.mu.Lock()
+	defer x.handle.store.mu.Unlock()
+
+	return x.handle.store.insert(x.handle, bytes.Clone(data), attrs)
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+	x.err = err
+	// done!
+	return nil
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.attrs.CRC32C = v
+	x.crc32c = true
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.chunkSize = v
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.attrs.ContentType = v
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.progress = fn
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if err := x.ctx.Err(); err != nil {
+		x.err = err
+		return 0, err
+	}
+
+	n, _ := x.buffer.Write(p)
+	// report the progress
+	if x.progress != nil {
+		x.progress(int64(x.buffer.Len()))
+	}
+
+	return n, nil
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents an in-memory storage object copier.
+type StorageObjectCopier struct {
+	dst      *StorageObjectHandle
+	src      storagev1.StorageObjectHandle
+	attrs    storage.ObjectAttrs
+	token    string
+	kms      string
+	progress func(uint64, uint64)
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.dst.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("CopyTo destination", x.dst.conds); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	src, ok := x.src.(*StorageObjectHandle)
+	// copy between stores by streaming
+	if !ok || src.store != x.dst.store {
+		return x.stream(ctx)
+	}
+
+	if err := src.validate(); err != nil {
+		return nil, err
+	}
+
+	defer x.dst.store.notifier.Flush(ctx)
+	x.dst.store.mu.Lock()
+	defer x.dst.store.mu.Unlock()
+
+	_, obj, err := x.dst.store.object(src)
+	if err != nil {
+		return nil, err
+	}
+
+	if obj == nil {
+		return nil, storage.ErrObjectNotExist
+	}
+
+	if err := checkConditions(src.conds, obj, false); err != nil {
+		return nil, err
+	}
+
+	if err := checkKey(src.key, obj); err != nil {
+		return nil, err
+	}
+
+	return x.commit(obj.attrs, obj.data)
+}
+
+func (x *StorageObjectCopier) stream(ctx context.Context) (*storage.ObjectAttrs, error) {
+	attrs, err := x.src.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := x.src.Generation(attrs.Generation).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	defer x.dst.store.notifier.Flush(ctx)
+	x.dst.store.mu.Lock()
+	defer x.dst.store.mu.Unlock()
+
+	return x.commit(attrs, data)
+}
+
+// commit writes the copy. The caller must hold the lock.
+func (x *StorageObjectCopier) commit(src *storage.ObjectAttrs, data []byte) (*storage.ObjectAttrs, error) {
+	attrs := &storage.ObjectAttrs{
+		ContentType:        src.ContentType,
+		ContentLanguage:    src.ContentLanguage,
+		ContentEncoding:    src.ContentEncoding,
+		ContentDisposition: src.ContentDisposition,
+		CacheControl:       src.CacheControl,
+		Metadata:           src.Metadata,
+		CustomTime:         src.CustomTime,
+		StorageClass:       src.StorageClass,
+		ComponentCount:     src.ComponentCount,
+		KMSKeyName:         x.kms,
+	}
+
+	override(attrs, &x.attrs)
+	// write the object
+	result, err := x.dst.store.insert(x.dst, bytes.Clone(data), attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.progress != nil {
+		x.progress(uint64(len(data)), uint64(len(data)))
+	}
+
+	return result, nil
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(name string) {
+	x.kms = name
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.progress = fn
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(token string) {
+	x.token = token
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents an in-memory storage object composer.
+type StorageObjectComposer struct {
+	dst   *StorageObjectHandle
+	srcs  []storagev1.StorageObjectHandle
+	attrs storage.ObjectAttrs
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	if err := x.dst.validate(); err != nil {
+		return nil, err
+	}
+
+	if err := validateConditions("ComposeFrom destination", x.dst.conds); err != nil {
+		return nil, err
+	}
+
+	if len(x.srcs) == 0 {
+		return nil, errors.New("storage: at least one source object must be specified")
+	}
+
+	if len(x.srcs) > 32 {
+		return nil, newError(http.StatusBadRequest, "The number of source components provided (%d) exceeds the maximum (32)", len(x.srcs))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	defer x.dst.store.notifier.Flush(ctx)
+	x.dst.store.mu.Lock()
+	defer x.dst.store.mu.Unlock()
+
+	var (
+		data  []byte
+		count int64
+	)
+
+	for _, item := range x.srcs {
+		src, ok := item.(*StorageObjectHandle)
+		if !ok || src.store != x.dst.store || src.bucket != x.dst.bucket {
+			return nil, errors.New("storage: all source objects must be in bucket " + strconv.Quote(x.dst.bucket))
+		}
+
+		if err := src.validate(); err != nil {
+			return nil, err
+		}
+
+		if src.conds != nil && (src.conds.GenerationNotMatch != 0 || src.conds.DoesNotExist || src.conds.MetagenerationMatch != 0 || src.conds.MetagenerationNotMatch != 0) {
+			return nil, errors.New("storage: compose: only GenerationMatch is allowed for source conditions")
+		}
+
+		_, obj, err := x.dst.store.object(src)
+		if err != nil {
+			return nil, err
+		}
+
+		if obj == nil {
+			return nil, storage.ErrObjectNotExist
+		}
+
+		if err := checkConditions(src.conds, obj, false); err != nil {
+			return nil, err
+		}
+
+		if err := checkKey(x.dst.key, obj); err != nil {
+			return nil, err
+		}
+
+		data = append(data, obj.data...)
+		count += max(obj.attrs.ComponentCount, 1)
+	}
+
+	if count > 1024 {
+		return nil, newError(http.StatusBadRequest, "The resulting object would have %d components, which exceeds the maximum (1024)", count)
+	}
+
+	attrs := cloneObjectAttrs(&x.attrs)
+	attrs.ComponentCount = count
+	// write the object
+	return x.dst.store.insert(x.dst, data, attrs)
+}
+
+var _ storagev1.StoragePageInfo = &StoragePageInfo{}
+
+// StoragePageInfo represents an in-memory storage page info.
+type StoragePageInfo struct {
+	page *page
+}
+
+// MaxSize implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) MaxSize() int {
+	return x.page.size
+}
+
+// Remaining implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Remaining() int {
+	return x.page.items
+}
+
+// Token implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Token() string {
+	return x.page.token
+}
+
+// override copies the non-zero writable attributes of src into dst.
+func override(dst, src *storage.ObjectAttrs) {
+	if src.ContentType != "" {
+		dst.ContentType = src.ContentType
+	}
+
+	if src.ContentLanguage != "" {
+		dst.ContentLanguage = src.ContentLanguage
+	}
+
+	if src.ContentEncoding != "" {
+		dst.ContentEncoding = src.ContentEncoding
+	}
+
+	if src.ContentDisposition != "" {
+		dst.ContentDisposition = src.ContentDisposition
+	}
+
+	if src.CacheControl != "" {
+		dst.CacheControl = src.CacheControl
+	}
+
+	if src.Metadata != nil {
+		dst.Metadata = src.Metadata
+	}
+
+	if !src.CustomTime.IsZero() {
+		dst.CustomTime = src.CustomTime
+	}
+
+	if src.StorageClass != "" {
+		dst.StorageClass = src.StorageClass
+	}
+
+	if src.KMSKeyName != "" {
+		dst.KMSKeyName = src.KMSKeyName
+	}
+
+	if src.ACL != nil {
+		dst.ACL = src.ACL
+	}
+
+	if src.EventBasedHold {
+		dst.EventBasedHold = true
+	}
+
+	if src.TemporaryHold {
+		dst.TemporaryHold = true
+	}
+}
+
+func checkMD5(data, sum []byte) error {
+	actual := md5.Sum(data)
+	// compare the hashes
+	if !bytes.Equal(actual[:], sum) {
+		return newError(http.StatusBadRequest, "Provided MD5 hash \"%s\" doesn't match calculated MD5 hash \"%s\".", base64.StdEncoding.EncodeToString(sum), base64.StdEncoding.EncodeToString(actual[:]))
+	}
+
+	return nil
+}
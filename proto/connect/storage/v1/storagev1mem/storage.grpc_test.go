@@ -0,0 +1,228 @@
+package storagev1mem_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+)
+
+func newBucket(t *testing.T) storagev1.StorageBucketHandle {
+	t.Helper()
+
+	bucket := storagev1mem.NewStorageServiceClient().Bucket("bucket")
+	if err := bucket.Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	return bucket
+}
+
+func writeObject(t *testing.T, handle storagev1.StorageObjectHandle, data string) *storage.ObjectAttrs {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	return writer.Attrs()
+}
+
+func readObject(t *testing.T, handle storagev1.StorageObjectHandle, offset, length int64) string {
+	t.Helper()
+
+	reader, err := handle.NewRangeReader(context.Background(), offset, length)
+	if err != nil {
+		t.Fatalf("NewRangeReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	return string(data)
+}
+
+func listObjects(t *testing.T, bucket storagev1.StorageBucketHandle, query *storage.Query) []string {
+	t.Helper()
+
+	var names []string
+
+	iter := bucket.Objects(context.Background(), query)
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return names
+		}
+
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		names = append(names, attrs.Name+attrs.Prefix)
+	}
+}
+
+func TestStorageObjectHandle(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	attrs := writeObject(t, bucket.Object("a.txt"), "hello world")
+	if attrs.Size != 11 || attrs.Generation == 0 || attrs.CRC32C == 0 {
+		t.Errorf("Attrs() = %+v", attrs)
+	}
+
+	cases := []struct {
+		offset, length int64
+		want           string
+	}{
+		{0, -1, "hello world"},
+		{6, -1, "world"},
+		{0, 5, "hello"},
+		{-5, -1, "world"},
+		{6, 100, "world"},
+	}
+
+	for _, tc := range cases {
+		if got := readObject(t, bucket.Object("a.txt"), tc.offset, tc.length); got != tc.want {
+			t.Errorf("NewRangeReader(%d, %d) = %q, want %q", tc.offset, tc.length, got, tc.want)
+		}
+	}
+
+	if _, err := bucket.Object("missing").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs(missing) = %v, want ErrObjectNotExist", err)
+	}
+
+	// a generation that does not match fails the write
+	writer := bucket.Object("a.txt").If(storage.Conditions{GenerationMatch: attrs.Generation + 1}).NewWriter(ctx)
+	io.WriteString(writer, "other")
+	if err := writer.Close(); err == nil {
+		t.Error("Close with a generation that does not match: want an error")
+	}
+
+	if err := bucket.Object("a.txt").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	if _, err := bucket.Object("a.txt").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs after Delete = %v, want ErrObjectNotExist", err)
+	}
+}
+
+func TestStorageBucketHandleObjects(t *testing.T) {
+	bucket := newBucket(t)
+	for _, name := range []string{"a/1.txt", "a/2.log", "a/b/3.txt", "c.txt"} {
+		writeObject(t, bucket.Object(name), name)
+	}
+
+	cases := []struct {
+		query *storage.Query
+		want  []string
+	}{
+		{nil, []string{"a/1.txt", "a/2.log", "a/b/3.txt", "c.txt"}},
+		{&storage.Query{Prefix: "a/"}, []string{"a/1.txt", "a/2.log", "a/b/3.txt"}},
+		{&storage.Query{Delimiter: "/"}, []string{"a/", "c.txt"}},
+		{&storage.Query{StartOffset: "a/2", EndOffset: "c"}, []string{"a/2.log", "a/b/3.txt"}},
+		{&storage.Query{MatchGlob: "**/*.txt"}, []string{"a/1.txt", "a/b/3.txt", "c.txt"}},
+		{&storage.Query{MatchGlob: "a/*.txt"}, []string{"a/1.txt"}},
+	}
+
+	for _, tc := range cases {
+		if got := listObjects(t, bucket, tc.query); !slices.Equal(got, tc.want) {
+			t.Errorf("Objects(%+v) = %v, want %v", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestStorageObjectComposer(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	writeObject(t, bucket.Object("a"), "hello ")
+	writeObject(t, bucket.Object("b"), "world")
+
+	attrs, err := bucket.Object("c").ComposerFrom(bucket.Object("a"), bucket.Object("b")).Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if attrs.ComponentCount != 2 {
+		t.Errorf("ComponentCount = %d, want 2", attrs.ComponentCount)
+	}
+
+	if got := readObject(t, bucket.Object("c"), 0, -1); got != "hello world" {
+		t.Errorf("composed object = %q", got)
+	}
+
+	srcs := make([]storagev1.StorageObjectHandle, 33)
+	for index := range srcs {
+		srcs[index] = bucket.Object("a")
+	}
+
+	if _, err := bucket.Object("d").ComposerFrom(srcs...).Run(ctx); err == nil {
+		t.Error("Run with 33 sources: want an error")
+	}
+}
+
+func TestStorageObjectCopier(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	writeObject(t, bucket.Object("a"), "data")
+
+	copier := bucket.Object("b").CopierFrom(bucket.Object("a"))
+	copier.ObjectAttrs().ContentType = "text/plain"
+
+	attrs, err := copier.Run(ctx)
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	if attrs.ContentType != "text/plain" {
+		t.Errorf("ContentType = %q, want text/plain", attrs.ContentType)
+	}
+
+	if got := readObject(t, bucket.Object("b"), 0, -1); got != "data" {
+		t.Errorf("copy = %q", got)
+	}
+}
+
+func TestStorageBucketHandleUpdateLabels(t *testing.T) {
+	ctx := context.Background()
+	bucket := newBucket(t)
+
+	update := storage.BucketAttrsToUpdate{}
+	update.SetLabel("env", "prod")
+	update.SetLabel("team", "storage")
+
+	if _, err := bucket.Update(ctx, update); err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	update = storage.BucketAttrsToUpdate{}
+	update.DeleteLabel("team")
+
+	attrs, err := bucket.Update(ctx, update)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	if want := map[string]string{"env": "prod"}; !maps.Equal(attrs.Labels, want) {
+		t.Errorf("Labels = %v, want %v", attrs.Labels, want)
+	}
+}
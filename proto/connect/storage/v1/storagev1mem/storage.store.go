@@ -0,0 +1,550 @@
+package storagev1mem
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	googleapi "google.golang.org/api/googleapi"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// crc32cTable is the Castagnoli table used by Cloud Storage.
+var crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+
+// bucketNamePattern matches the valid bucket names.
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{1,220}[a-z0-9]$`)
+
+type store struct {
+	mu         sync.Mutex
+	buckets    map[string]*bucket
+	generation int64
+	now        func() time.Time
+	notifier   storagev1.StorageObjectNotifier
+}
+
+type bucket struct {
+	projectID      string
+	attrs          *storage.BucketAttrs
+	objects        map[string]*object
+	versions       map[string][]*object
+	notifications  map[string]*storage.Notification
+	notificationID int
+}
+
+type object struct {
+	attrs *storage.ObjectAttrs
+	data  []byte
+}
+
+type entry struct {
+	name  string
+	attrs *storage.ObjectAttrs
+}
+
+func newStore() *store {
+	return &store{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// next returns the next generation number. Like Cloud Storage generations are
+// derived from the wall clock in microseconds, but they are strictly increasing.
+func (x *store) next() int64 {
+	x.generation = max(x.generation+1, x.now().UnixMicro())
+	return x.generation
+}
+
+func (x *store) bucket(name string) (*bucket, error) {
+	if name == "" {
+		return nil, fmt.Errorf("storage: bucket name is empty")
+	}
+
+	if b, ok := x.buckets[name]; ok {
+		return b, nil
+	}
+
+	return nil, storage.ErrBucketNotExist
+}
+
+// object returns the object addressed by the handle. It returns the live
+// version unless the handle is pinned to a generation.
+func (x *store) object(h *StorageObjectHandle) (*bucket, *object, error) {
+	b, err := x.bucket(h.bucket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if h.gen < 0 {
+		return b, b.objects[h.object], nil
+	}
+
+	if obj := b.objects[h.object]; obj != nil && obj.attrs.Generation == h.gen {
+		return b, obj, nil
+	}
+
+	for _, obj := range b.versions[h.object] {
+		if obj.attrs.Generation == h.gen {
+			return b, obj, nil
+		}
+	}
+
+	return b, nil, nil
+}
+
+// insert stores data as the new live version of the object addressed by the
+// handle. The caller must hold the lock.
+func (x *store) insert(h *StorageObjectHandle, data []byte, attrs *storage.ObjectAttrs) (*storage.ObjectAttrs, error) {
+	b, err := x.bucket(h.bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	prev := b.objects[h.object]
+	// check the preconditions against the live version
+	if err := checkConditions(h.conds, prev, true); err != nil {
+		return nil, err
+	}
+
+	if err := x.checkMutable(prev); err != nil {
+		return nil, err
+	}
+
+	now := x.now().UTC()
+
+	result := cloneObjectAttrs(attrs)
+	result.Bucket = h.bucket
+	result.Name = h.object
+	result.Size = int64(len(data))
+	result.CRC32C = crc32.Checksum(data, crc32cTable)
+	result.Generation = x.next()
+	result.Metageneration = 1
+	result.Created = now
+	result.Updated = now
+	result.Finalized = now
+	result.Deleted = time.Time{}
+	result.Etag = etag(result.Generation, result.Metageneration)
+	result.MediaLink = mediaLink(h.bucket, h.object, result.Generation)
+	result.Owner = ""
+	result.Prefix = ""
+
+	if result.ComponentCount == 0 {
+		sum := md5.Sum(data)
+		result.MD5 = sum[:]
+	} else {
+		result.MD5 = nil
+	}
+
+	if result.StorageClass == "" {
+		result.StorageClass = b.attrs.StorageClass
+	}
+
+	if result.ACL == nil {
+		result.ACL = append([]storage.ACLRule(nil), b.attrs.DefaultObjectACL...)
+	}
+
+	if b.attrs.DefaultEventBasedHold {
+		result.EventBasedHold = true
+	}
+
+	if policy := b.attrs.RetentionPolicy; policy != nil && policy.RetentionPeriod > 0 {
+		result.RetentionExpirationTime = now.Add(policy.RetentionPeriod)
+	}
+
+	if len(h.key) > 0 {
+		sum := sha256.Sum256(h.key)
+		result.CustomerKeySHA256 = base64.StdEncoding.EncodeToString(sum[:])
+	} else {
+		result.CustomerKeySHA256 = ""
+	}
+
+	x.archive(b, prev, now)
+	// store the object
+	b.objects[h.object] = &object{attrs: result, data: data}
+
+	finalized := &storagev1.StorageObjectChange{
+		Type:   storagev1.StorageObjectEventType_OBJECT_FINALIZE,
+		Object: result,
+		Time:   now,
+	}
+
+	if prev != nil {
+		finalized.OverwroteGeneration = prev.attrs.Generation
+	}
+
+	x.notifier.Notify(b.notifications, finalized)
+	// notify the replaced version
+	if prev != nil {
+		x.notifier.Notify(b.notifications, &storagev1.StorageObjectChange{
+			Type:                    storagev1.RetiredStorageObjectEventType(b.attrs),
+			Object:                  prev.attrs,
+			Time:                    now,
+			OverwrittenByGeneration: result.Generation,
+		})
+	}
+
+	return cloneObjectAttrs(result), nil
+}
+
+// archive retires the live version of an object. The version is kept as a
+// noncurrent version when the bucket has versioning enabled.
+func (x *store) archive(b *bucket, obj *object, now time.Time) {
+	if obj == nil {
+		return
+	}
+
+	delete(b.objects, obj.attrs.Name)
+
+	if b.attrs.VersioningEnabled {
+		obj.attrs.Deleted = now
+		b.versions[obj.attrs.Name] = append(b.versions[obj.attrs.Name], obj)
+	}
+}
+
+// remove permanently deletes a noncurrent version.
+func (x *store) remove(b *bucket, obj *object) {
+	versions := b.versions[obj.attrs.Name]
+
+	for index, item := range versions {
+		if item == obj {
+			versions = append(versions[:index], versions[index+1:]...)
+			break
+		}
+	}
+
+	if len(versions) == 0 {
+		delete(b.versions, obj.attrs.Name)
+	} else {
+		b.versions[obj.attrs.Name] = versions
+	}
+}
+
+// checkMutable returns an error if the object may not be deleted or replaced.
+func (x *store) checkMutable(obj *object) error {
+	if obj == nil {
+		return nil
+	}
+
+	switch {
+	case obj.attrs.TemporaryHold:
+		return newError(http.StatusForbidden, "Object '%s/%s' is under active Temporary hold and cannot be deleted, overwritten or archived until hold is removed.", obj.attrs.Bucket, obj.attrs.Name)
+	case obj.attrs.EventBasedHold:
+		return newError(http.StatusForbidden, "Object '%s/%s' is under active Event-Based hold and cannot be deleted, overwritten or archived until hold is removed.", obj.attrs.Bucket, obj.attrs.Name)
+	case !obj.attrs.RetentionExpirationTime.IsZero() && x.now().Before(obj.attrs.RetentionExpirationTime):
+		return newError(http.StatusForbidden, "Object '%s/%s' is subject to bucket's retention policy or object retention and cannot be deleted or overwritten until %s.", obj.attrs.Bucket, obj.attrs.Name, obj.attrs.RetentionExpirationTime.Format(time.RFC3339))
+	}
+
+	if retention := obj.attrs.Retention; retention != nil && x.now().Before(retention.RetainUntil) {
+		return newError(http.StatusForbidden, "Object '%s/%s' is subject to object retention and cannot be deleted or overwritten until %s.", obj.attrs.Bucket, obj.attrs.Name, retention.RetainUntil.Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// list returns the sorted list entries of a bucket for the given query.
+func (x *store) list(b *bucket, query *storage.Query) ([]*entry, error) {
+	var glob *storagev1.StorageObjectGlob
+
+	if query.MatchGlob != "" {
+		var err error
+		// compile the glob
+		if glob, err = storagev1.ParseStorageObjectGlob(query.MatchGlob); err != nil {
+			return nil, newError(http.StatusBadRequest, "%v", err)
+		}
+	}
+
+	var (
+		items    []*entry
+		prefixes = make(map[string]bool)
+	)
+
+	match := func(name string) bool {
+		switch {
+		case !strings.HasPrefix(name, query.Prefix):
+			return false
+		case query.StartOffset != "" && name < query.StartOffset:
+			return false
+		case query.EndOffset != "" && name >= query.EndOffset:
+			return false
+		case glob != nil && !glob.Match(name):
+			return false
+		default:
+			return true
+		}
+	}
+
+	collect := func(obj *object) {
+		name := obj.attrs.Name
+		// apply the delimiter
+		if query.Delimiter != "" {
+			if index := strings.Index(name[len(query.Prefix):], query.Delimiter); index >= 0 {
+				prefix := name[:len(query.Prefix)+index+len(query.Delimiter)]
+				// synthesize the prefix
+				if !prefixes[prefix] {
+					prefixes[prefix] = true
+					items = append(items, &entry{name: prefix, attrs: &storage.ObjectAttrs{Prefix: prefix}})
+				}
+
+				if !query.IncludeTrailingDelimiter || prefix != name {
+					return
+				}
+			}
+		}
+
+		items = append(items, &entry{name: name, attrs: obj.attrs})
+	}
+
+	for name, obj := range b.objects {
+		if match(name) {
+			collect(obj)
+		}
+	}
+
+	if query.Versions {
+		for name, versions := range b.versions {
+			if match(name) {
+				for _, obj := range versions {
+					collect(obj)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].name != items[j].name {
+			return items[i].name < items[j].name
+		}
+		// prefixes come first
+		if items[i].attrs.Prefix != items[j].attrs.Prefix {
+			return items[i].attrs.Prefix != ""
+		}
+
+		return items[i].attrs.Generation < items[j].attrs.Generation
+	})
+
+	return items, nil
+}
+
+func checkConditions(conds *storage.Conditions, obj *object, write bool) error {
+	if conds == nil {
+		return nil
+	}
+
+	if obj == nil {
+		if !write || conds.DoesNotExist {
+			return nil
+		}
+
+		if conds.GenerationMatch != 0 || conds.MetagenerationMatch != 0 {
+			return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+		}
+
+		return nil
+	}
+
+	switch {
+	case conds.DoesNotExist:
+	case conds.GenerationMatch != 0 && obj.attrs.Generation != conds.GenerationMatch:
+	case conds.GenerationNotMatch != 0 && obj.attrs.Generation == conds.GenerationNotMatch:
+	case conds.MetagenerationMatch != 0 && obj.attrs.Metageneration != conds.MetagenerationMatch:
+	case conds.MetagenerationNotMatch != 0 && obj.attrs.Metageneration == conds.MetagenerationNotMatch:
+	default:
+		return nil
+	}
+
+	if !write && conds.GenerationNotMatch != 0 && obj.attrs.Generation == conds.GenerationNotMatch {
+		return newError(http.StatusNotModified, "Not Modified")
+	}
+
+	return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+}
+
+func checkBucketConditions(conds *storage.BucketConditions, b *bucket) error {
+	if conds == nil {
+		return nil
+	}
+
+	switch {
+	case conds.MetagenerationMatch != 0 && b.attrs.MetaGeneration != conds.MetagenerationMatch:
+	case conds.MetagenerationNotMatch != 0 && b.attrs.MetaGeneration == conds.MetagenerationNotMatch:
+	default:
+		return nil
+	}
+
+	return newError(http.StatusPreconditionFailed, "At least one of the pre-conditions you specified did not hold.")
+}
+
+func validateConditions(method string, conds *storage.Conditions) error {
+	if conds == nil {
+		return nil
+	}
+
+	if *conds == (storage.Conditions{}) {
+		return fmt.Errorf("storage: %s: empty conditions", method)
+	}
+
+	generation := 0
+	// count the generation conditions
+	for _, ok := range []bool{conds.GenerationMatch != 0, conds.GenerationNotMatch != 0, conds.DoesNotExist} {
+		if ok {
+			generation++
+		}
+	}
+
+	if generation > 1 {
+		return fmt.Errorf("storage: %s: multiple conditions specified for generation", method)
+	}
+
+	if conds.MetagenerationMatch != 0 && conds.MetagenerationNotMatch != 0 {
+		return fmt.Errorf("storage: %s: multiple conditions specified for metageneration", method)
+	}
+
+	return nil
+}
+
+// checkKey verifies the customer-supplied encryption key of a read.
+func checkKey(key []byte, obj *object) error {
+	if obj.attrs.CustomerKeySHA256 == "" {
+		if len(key) > 0 {
+			return newError(http.StatusBadRequest, "The target object is not encrypted by a customer-supplied encryption key.")
+		}
+
+		return nil
+	}
+
+	if len(key) == 0 {
+		return newError(http.StatusBadRequest, "The target object is encrypted by a customer-supplied encryption key.")
+	}
+
+	sum := sha256.Sum256(key)
+	// compare the key hashes
+	if base64.StdEncoding.EncodeToString(sum[:]) != obj.attrs.CustomerKeySHA256 {
+		return newError(http.StatusBadRequest, "The provided encryption key is incorrect.")
+	}
+
+	return nil
+}
+
+// content returns the object data as served to a reader. Objects stored with
+// gzip content encoding are decompressed unless the handle reads compressed.
+func content(obj *object, compressed bool) ([]byte, bool, error) {
+	if compressed || obj.attrs.ContentEncoding != "gzip" {
+		return obj.data, false, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(obj.data))
+	if err != nil {
+		return nil, false, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return data, true, nil
+}
+
+func newError(code int, format string, args ...any) error {
+	return &googleapi.Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func etag(generation, metageneration int64) string {
+	return base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(generation, 10) + "/" + strconv.FormatInt(metageneration, 10)))
+}
+
+func mediaLink(bucket, name string, generation int64) string {
+	return fmt.Sprintf("https://storage.googleapis.com/download/storage/v1/b/%s/o/%s?generation=%d&alt=media", bucket, escape(name), generation)
+}
+
+func escape(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "%", "%25"), "/", "%2F")
+}
+
+func cloneObjectAttrs(attrs *storage.ObjectAttrs) *storage.ObjectAttrs {
+	if attrs == nil {
+		return &storage.ObjectAttrs{}
+	}
+
+	result := *attrs
+	result.ACL = append([]storage.ACLRule(nil), attrs.ACL...)
+	result.MD5 = append([]byte(nil), attrs.MD5...)
+
+	if attrs.ACL == nil {
+		result.ACL = nil
+	}
+
+	if attrs.MD5 == nil {
+		result.MD5 = nil
+	}
+
+	if attrs.Metadata != nil {
+		result.Metadata = make(map[string]string, len(attrs.Metadata))
+		// copy the metadata
+		for k, v := range attrs.Metadata {
+			result.Metadata[k] = v
+		}
+	}
+
+	if attrs.Retention != nil {
+		retention := *attrs.Retention
+		result.Retention = &retention
+	}
+
+	return &result
+}
+
+func cloneBucketAttrs(attrs *storage.BucketAttrs) *storage.BucketAttrs {
+	result := *attrs
+	result.ACL = append([]storage.ACLRule(nil), attrs.ACL...)
+	result.DefaultObjectACL = append([]storage.ACLRule(nil), attrs.DefaultObjectACL...)
+
+	if attrs.Labels != nil {
+		result.Labels = make(map[string]string, len(attrs.Labels))
+		// copy the labels
+		for k, v := range attrs.Labels {
+			result.Labels[k] = v
+		}
+	}
+
+	if attrs.RetentionPolicy != nil {
+		policy := *attrs.RetentionPolicy
+		result.RetentionPolicy = &policy
+	}
+
+	return &result
+}
+
+func cloneNotification(n *storage.Notification) *storage.Notification {
+	result := *n
+	result.EventTypes = append([]string(nil), n.EventTypes...)
+
+	if n.CustomAttributes != nil {
+		result.CustomAttributes = make(map[string]string, len(n.CustomAttributes))
+		// copy the attributes
+		for k, v := range n.CustomAttributes {
+			result.CustomAttributes[k] = v
+		}
+	}
+
+	return &result
+}
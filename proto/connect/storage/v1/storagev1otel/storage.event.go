@@ -0,0 +1,100 @@
+package storagev1otel
+
+import (
+	"context"
+	"strings"
+
+	otel "go.opentelemetry.io/otel"
+	codes "go.opentelemetry.io/otel/codes"
+	propagation "go.opentelemetry.io/otel/propagation"
+	trace "go.opentelemetry.io/otel/trace"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// StorageObjectEventTracing returns a middleware that handles every event in
+// a consumer span named storage.event. The span continues the trace context
+// carried in the attributes of the message of the event, as the Pub/Sub
+// clients and the CloudEvents distributed tracing extension propagate it. A
+// nil tracer provider or propagator uses the global one.
+func StorageObjectEventTracing(tp trace.TracerProvider, propagator propagation.TextMapPropagator) storagev1.StorageObjectEventMiddleware {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	if propagator == nil {
+		propagator = otel.GetTextMapPropagator()
+	}
+
+	tracer := tp.Tracer(ScopeName)
+
+	return func(next storagev1.StorageObjectEventHandler) storagev1.StorageObjectEventHandler {
+		return storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, event storagev1.StorageObjectEvent) error {
+			// continue the trace of the message
+			if attrs := storagev1.StorageObjectEventAttributesFromContext(ctx); attrs != nil {
+				ctx = propagator.Extract(ctx, attributeCarrier(attrs))
+			}
+
+			object := event.GetObject()
+			// start the span
+			ctx, span := tracer.Start(ctx, "storage.event",
+				trace.WithSpanKind(trace.SpanKindConsumer),
+				trace.WithAttributes(
+					AttributeEventType.String(storagev1.StorageObjectEventTypeOf(event).String()),
+					AttributeBucket.String(object.GetBucket()),
+					AttributeObject.String(object.GetName()),
+					AttributeGeneration.Int64(object.GetGeneration()),
+				),
+			)
+			defer span.End()
+
+			if id := event.GetEnvelope().GetMessageId(); id != "" {
+				span.SetAttributes(AttributeMessageID.String(id))
+			}
+
+			// handle the event
+			if err := next.HandleStorageObjectEvent(ctx, event); err != nil {
+				span.RecordError(err)
+				span.SetAttributes(AttributeErrorType.String(errorType(err)))
+				span.SetStatus(codes.Error, err.Error())
+				return err
+			}
+
+			// done!
+			return nil
+		})
+	}
+}
+
+// googclientPrefix is the prefix of the attributes that the Pub/Sub clients
+// propagate the trace context in.
+const googclientPrefix = "googclient_"
+
+// attributeCarrier adapts the attributes of a message to a
+// propagation.TextMapCarrier. It reads the keys with the prefix of the Pub/Sub
+// clients first.
+type attributeCarrier map[string]string
+
+// Get implements propagation.TextMapCarrier.
+func (x attributeCarrier) Get(key string) string {
+	if v, ok := x[googclientPrefix+key]; ok {
+		return v
+	}
+
+	return x[key]
+}
+
+// Set implements propagation.TextMapCarrier.
+func (x attributeCarrier) Set(key, value string) {
+	x[key] = value
+}
+
+// Keys implements propagation.TextMapCarrier.
+func (x attributeCarrier) Keys() []string {
+	keys := make([]string, 0, len(x))
+	for key := range x {
+		keys = append(keys, strings.TrimPrefix(key, googclientPrefix))
+	}
+
+	return keys
+}
@@ -0,0 +1,106 @@
+package storagev1otel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	codes "go.opentelemetry.io/otel/codes"
+	propagation "go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	tracetest "go.opentelemetry.io/otel/sdk/trace/tracetest"
+	trace "go.opentelemetry.io/otel/trace"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1otel"
+)
+
+const (
+	traceID     = "0af7651916cd43dd8448eb211c80319c"
+	traceParent = "00-" + traceID + "-b7ad6b7169203331-01"
+)
+
+func TestStorageObjectEventTracing(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs map[string]string
+		err   error
+	}{
+		{"CloudEvent", map[string]string{"traceparent": traceParent}, nil},
+		{"Pubsub", map[string]string{"googclient_traceparent": traceParent}, nil},
+		{"Failure", map[string]string{"traceparent": traceParent}, errors.New("boom")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+			var inner trace.SpanContext
+
+			handler := storagev1.ChainStorageObjectEventMiddleware(
+				storagev1.StorageObjectEventHandlerFunc(func(ctx context.Context, _ storagev1.StorageObjectEvent) error {
+					inner = trace.SpanContextFromContext(ctx)
+					return tc.err
+				}),
+				storagev1otel.StorageObjectEventTracing(tp, propagation.TraceContext{}),
+			)
+
+			event := &storagev1.StorageObjectFinalizedEvent{
+				Object:   &storagev1.StorageObject{Bucket: "bucket", Name: "a", Generation: 7},
+				Envelope: &storagev1.StorageObjectEventEnvelope{MessageId: "42"},
+			}
+
+			ctx := storagev1.WithStorageObjectEventAttributes(context.Background(), tc.attrs)
+			if err := handler.HandleStorageObjectEvent(ctx, event); !errors.Is(err, tc.err) {
+				t.Fatalf("HandleStorageObjectEvent() error = %v, want %v", err, tc.err)
+			}
+
+			spans := recorder.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("spans = %d, want 1", len(spans))
+			}
+
+			span := spans[0]
+			if span.Name() != "storage.event" || span.SpanKind() != trace.SpanKindConsumer {
+				t.Errorf("span = %s %v, want storage.event %v", span.Name(), span.SpanKind(), trace.SpanKindConsumer)
+			}
+
+			if got := span.SpanContext().TraceID().String(); got != traceID {
+				t.Errorf("span trace ID = %s, want %s", got, traceID)
+			}
+
+			if got := span.Parent().SpanID().String(); got != "b7ad6b7169203331" {
+				t.Errorf("span parent = %s, want b7ad6b7169203331", got)
+			}
+
+			if inner.SpanID() != span.SpanContext().SpanID() {
+				t.Errorf("handler span = %s, want %s", inner.SpanID(), span.SpanContext().SpanID())
+			}
+
+			for _, attr := range []struct {
+				name string
+				ok   bool
+			}{
+				{"event type", hasAttribute(span.Attributes(), storagev1otel.AttributeEventType.String("OBJECT_FINALIZE"))},
+				{"bucket", hasAttribute(span.Attributes(), storagev1otel.AttributeBucket.String("bucket"))},
+				{"object", hasAttribute(span.Attributes(), storagev1otel.AttributeObject.String("a"))},
+				{"generation", hasAttribute(span.Attributes(), storagev1otel.AttributeGeneration.Int64(7))},
+				{"message ID", hasAttribute(span.Attributes(), storagev1otel.AttributeMessageID.String("42"))},
+			} {
+				if !attr.ok {
+					t.Errorf("attributes = %v, want the %s", span.Attributes(), attr.name)
+				}
+			}
+
+			want := codes.Unset
+			if tc.err != nil {
+				want = codes.Error
+			}
+
+			if span.Status().Code != want {
+				t.Errorf("status = %v, want %v", span.Status(), want)
+			}
+		})
+	}
+}
@@ -0,0 +1,682 @@
+// Package storagev1otel decorates any storagev1.StorageServiceClient with
+// OpenTelemetry spans and metrics:
+//
+//	client := storagev1otel.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+//	client.SetTracerProvider(tp)
+//	client.SetMeterProvider(mp)
+//
+// Every operation emits a client span named after the operation, such as
+// storage.object.attrs, that carries the bucket, the object and the
+// generation. A reader or a writer spans from its creation to its close, and
+// an iterator emits a span for every Next call that fetches a page. The
+// operations record the following metrics, by operation and bucket:
+//
+//   - storage.client.operation.duration, the duration of the operations;
+//   - storage.client.read.size, the bytes read by a reader;
+//   - storage.client.write.size, the bytes written by a writer;
+//   - storage.client.errors, the failed operations by error.type.
+//
+// StorageObjectEventTracing traces the handlers of the storage object events
+// in the trace of their messages:
+//
+//	handler = storagev1.ChainStorageObjectEventMiddleware(handler,
+//		storagev1otel.StorageObjectEventTracing(tp, propagation.TraceContext{}),
+//	)
+package storagev1otel
+
+import (
+	"context"
+	"io"
+
+	storage "cloud.google.com/go/storage"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents a storage service client that instruments
+// the operations of another client.
+type StorageServiceClient struct {
+	client storagev1.StorageServiceClient
+	config *config
+}
+
+// NewStorageServiceClient creates a new StorageServiceClient that instruments
+// the given client with the global tracer and meter providers.
+func NewStorageServiceClient(client storagev1.StorageServiceClient) *StorageServiceClient {
+	x := &config{
+		tp: otel.GetTracerProvider(),
+		mp: otel.GetMeterProvider(),
+	}
+
+	x.telemetry = newTelemetry(x.tp, x.mp)
+	return &StorageServiceClient{client: client, config: x}
+}
+
+// SetTracerProvider sets the tracer provider of the spans.
+func (x *StorageServiceClient) SetTracerProvider(tp trace.TracerProvider) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// rebuild the telemetry
+	x.config.tp = tp
+	x.config.telemetry = newTelemetry(x.config.tp, x.config.mp)
+}
+
+// SetMeterProvider sets the meter provider of the metrics.
+func (x *StorageServiceClient) SetMeterProvider(mp metric.MeterProvider) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// rebuild the telemetry
+	x.config.mp = mp
+	x.config.telemetry = newTelemetry(x.config.tp, x.config.mp)
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{handle: x.client.Bucket(name), config: x.config, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{ctx: ctx, config: x.config, iter: x.client.Buckets(ctx, projectID)}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return x.client.Close()
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents a storage bucket handle that instruments the
+// operations of another handle.
+type StorageBucketHandle struct {
+	handle storagev1.StorageBucketHandle
+	config *config
+	name   string
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config, scope: "bucket.acl", bucket: x.name}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	ctx, op := x.config.get().start(ctx, "notification.add", x.name)
+
+	notification, err := x.handle.AddNotification(ctx, n)
+	op.end(err)
+	return notification, err
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	ctx, op := x.config.get().start(ctx, "bucket.attrs", x.name)
+
+	attrs, err := x.handle.Attrs(ctx)
+	op.end(err)
+	return attrs, err
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	ctx, op := x.config.get().start(ctx, "bucket.create", x.name)
+
+	err := x.handle.Create(ctx, projectID, attrs)
+	op.end(err)
+	return err
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.DefaultObjectACL(), config: x.config, scope: "bucket.default_object_acl", bucket: x.name}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	ctx, op := x.config.get().start(ctx, "bucket.delete", x.name)
+
+	err := x.handle.Delete(ctx)
+	op.end(err)
+	return err
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	ctx, op := x.config.get().start(ctx, "notification.delete", x.name)
+
+	err := x.handle.DeleteNotification(ctx, id)
+	op.end(err)
+	return err
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(conds storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	ctx, op := x.config.get().start(ctx, "bucket.lock", x.name)
+
+	err := x.handle.LockRetentionPolicy(ctx)
+	op.end(err)
+	return err
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	ctx, op := x.config.get().start(ctx, "notification.list", x.name)
+
+	notifications, err := x.handle.Notifications(ctx)
+	op.end(err)
+	return notifications, err
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{handle: x.handle.Object(name), config: x.config, bucket: x.name, name: name}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	return &StorageObjectIterator{ctx: ctx, config: x.config, bucket: x.name, iter: x.handle.Objects(ctx, query)}
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, uattrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	ctx, op := x.config.get().start(ctx, "bucket.update", x.name)
+
+	attrs, err := x.handle.Update(ctx, uattrs)
+	op.end(err)
+	return attrs, err
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.UserProject(projectID)
+	return &handle
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents a storage bucket iterator that emits a
+// span for every page that it fetches.
+type StorageBucketIterator struct {
+	ctx    context.Context
+	config *config
+	iter   storagev1.StorageBucketIterator
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	// an iterator fetches a page when the current one is exhausted
+	if x.iter.PageInfo().Remaining() > 0 {
+		return x.iter.Next()
+	}
+
+	_, op := x.config.get().start(x.ctx, "bucket.list", "")
+
+	attrs, err := x.iter.Next()
+	op.end(err)
+	return attrs, err
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents a storage object iterator that emits a
+// span for every page that it fetches.
+type StorageObjectIterator struct {
+	ctx    context.Context
+	config *config
+	bucket string
+	iter   storagev1.StorageObjectIterator
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	// an iterator fetches a page when the current one is exhausted
+	if x.iter.PageInfo().Remaining() > 0 {
+		return x.iter.Next()
+	}
+
+	_, op := x.config.get().start(x.ctx, "object.list", x.bucket)
+
+	attrs, err := x.iter.Next()
+	op.end(err)
+	return attrs, err
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+var (
+	_ storagev1.StorageObjectHandle        = &StorageObjectHandle{}
+	_ storagev1.StorageObjectHandleWrapper = &StorageObjectHandle{}
+)
+
+// StorageObjectHandle represents a storage object handle that instruments the
+// operations of another handle.
+type StorageObjectHandle struct {
+	handle     storagev1.StorageObjectHandle
+	config     *config
+	bucket     string
+	name       string
+	generation int64
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config, scope: "object.acl", bucket: x.bucket, attrs: x.attributes()}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	ctx, op := x.config.get().start(ctx, "object.attrs", x.bucket, x.attributes()...)
+
+	attrs, err := x.handle.Attrs(ctx)
+	op.object(attrs)
+	op.end(err)
+	return attrs, err
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(srcs ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	handles := make([]storagev1.StorageObjectHandle, len(srcs))
+	for i, src := range srcs {
+		handles[i] = unwrap(src)
+	}
+
+	return &StorageObjectComposer{composer: x.handle.ComposerFrom(handles...), handle: x}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(src storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	copier := &StorageObjectCopier{copier: x.handle.CopierFrom(unwrap(src)), handle: x}
+	// the source is known when it is instrumented as well
+	if v, ok := src.(*StorageObjectHandle); ok {
+		copier.attrs = []attribute.KeyValue{
+			AttributeSourceBucket.String(v.bucket),
+			AttributeSourceObject.String(v.name),
+		}
+	}
+
+	return copier
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	ctx, op := x.config.get().start(ctx, "object.delete", x.bucket, x.attributes()...)
+
+	err := x.handle.Delete(ctx)
+	op.end(err)
+	return err
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Generation(gen)
+	handle.generation = gen
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(conds storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(key []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Key(key)
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset, length int64) (storagev1.StorageObjectReader, error) {
+	ctx, op := x.config.get().start(ctx, "object.read", x.bucket, x.attributes()...)
+
+	reader, err := x.handle.NewRangeReader(ctx, offset, length)
+	if err != nil {
+		op.end(err)
+		return nil, err
+	}
+
+	return &StorageObjectReader{reader: reader, op: op}, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	ctx, op := x.config.get().start(ctx, "object.write", x.bucket, x.attributes()...)
+	// done!
+	return &StorageObjectWriter{writer: x.handle.NewWriter(ctx), op: op}
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.ReadCompressed(compressed)
+	return &handle
+}
+
+// Unwrap implements storagev1.StorageObjectHandleWrapper.
+func (x *StorageObjectHandle) Unwrap() storagev1.StorageObjectHandle {
+	return x.handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, uattrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	ctx, op := x.config.get().start(ctx, "object.update", x.bucket, x.attributes()...)
+
+	attrs, err := x.handle.Update(ctx, uattrs)
+	op.object(attrs)
+	op.end(err)
+	return attrs, err
+}
+
+// attributes returns the span attributes of the object.
+func (x *StorageObjectHandle) attributes() []attribute.KeyValue {
+	attrs := []attribute.KeyValue{AttributeObject.String(x.name)}
+	if x.generation != 0 {
+		attrs = append(attrs, AttributeGeneration.Int64(x.generation))
+	}
+
+	return attrs
+}
+
+// unwrap returns the instrumented handle of a StorageObjectHandle, so that
+// the backends can recognize their own handles.
+func unwrap(handle storagev1.StorageObjectHandle) storagev1.StorageObjectHandle {
+	if v, ok := handle.(*StorageObjectHandle); ok {
+		return v.handle
+	}
+
+	return handle
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents a storage object reader whose span ends when
+// it is closed.
+type StorageObjectReader struct {
+	reader storagev1.StorageObjectReader
+	op     *operation
+	read   int64
+	err    error
+	closed bool
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.reader.CacheControl()
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	err := x.reader.Close()
+	if x.closed {
+		return err
+	}
+
+	x.closed = true
+	// record the content read
+	x.op.telemetry.read.Record(x.op.ctx, x.read, metric.WithAttributes(x.op.attrs...))
+
+	if x.err == nil {
+		x.err = err
+	}
+
+	x.op.end(x.err)
+	return err
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.reader.ContentEncoding()
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.reader.ContentType()
+}
+
+// Generation returns the generation of the object.
+func (x *StorageObjectReader) Generation() int64 {
+	return storagev1.StorageObjectReaderGeneration(x.reader)
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	n, err := x.reader.Read(p)
+	x.read += int64(n)
+	// keep the first error, but the end of the content
+	if err != nil && x.err == nil && err != io.EOF {
+		x.err = err
+	}
+
+	return n, err
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return x.reader.Remain()
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.reader.Size()
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents a storage object writer whose span ends when
+// it is closed.
+type StorageObjectWriter struct {
+	writer  storagev1.StorageObjectWriter
+	op      *operation
+	written int64
+	err     error
+	closed  bool
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.writer.Attrs()
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	err := x.writer.Close()
+	if x.closed {
+		return err
+	}
+
+	x.closed = true
+	// record the content written
+	x.op.telemetry.written.Record(x.op.ctx, x.written, metric.WithAttributes(x.op.attrs...))
+
+	if x.err == nil {
+		x.err = err
+	}
+
+	x.op.object(x.writer.Attrs())
+	x.op.end(x.err)
+	return err
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	cerr := x.writer.CloseWithError(err)
+	if x.closed {
+		return cerr
+	}
+
+	x.closed = true
+	// the upload is aborted
+	x.op.end(err)
+	return cerr
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return x.writer.ObjectAttrs()
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.writer.SetCRC32C(v)
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.writer.SetChunkSize(v)
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.writer.SetContentType(v)
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.writer.SetProgressFunc(fn)
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	n, err := x.writer.Write(p)
+	x.written += int64(n)
+	// keep the first error
+	if err != nil && x.err == nil {
+		x.err = err
+	}
+
+	return n, err
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents a storage object copier that instruments
+// its runs.
+type StorageObjectCopier struct {
+	copier storagev1.StorageObjectCopier
+	handle *StorageObjectHandle
+	attrs  []attribute.KeyValue
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return x.copier.ObjectAttrs()
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	ctx, op := x.handle.config.get().start(ctx, "object.copy", x.handle.bucket, append(x.handle.attributes(), x.attrs...)...)
+
+	attrs, err := x.copier.Run(ctx)
+	op.object(attrs)
+	op.end(err)
+	return attrs, err
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(v string) {
+	x.copier.SetDestinationKMSKeyName(v)
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.copier.SetProgressFunc(fn)
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(v string) {
+	x.copier.SetRewriteToken(v)
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents a storage object composer that instruments
+// its runs.
+type StorageObjectComposer struct {
+	composer storagev1.StorageObjectComposer
+	handle   *StorageObjectHandle
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return x.composer.ObjectAttrs()
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	ctx, op := x.handle.config.get().start(ctx, "object.compose", x.handle.bucket, x.handle.attributes()...)
+
+	attrs, err := x.composer.Run(ctx)
+	op.object(attrs)
+	op.end(err)
+	return attrs, err
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+// StorageACLHandle represents a storage ACL handle that instruments the
+// operations of another handle.
+type StorageACLHandle struct {
+	handle storagev1.StorageACLHandle
+	config *config
+	scope  string
+	bucket string
+	attrs  []attribute.KeyValue
+}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	ctx, op := x.config.get().start(ctx, x.scope+".delete", x.bucket, x.attrs...)
+
+	err := x.handle.Delete(ctx, entity)
+	op.end(err)
+	return err
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	ctx, op := x.config.get().start(ctx, x.scope+".list", x.bucket, x.attrs...)
+
+	rules, err := x.handle.List(ctx)
+	op.end(err)
+	return rules, err
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	ctx, op := x.config.get().start(ctx, x.scope+".set", x.bucket, x.attrs...)
+
+	err := x.handle.Set(ctx, entity, role)
+	op.end(err)
+	return err
+}
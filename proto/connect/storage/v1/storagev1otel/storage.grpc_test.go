@@ -0,0 +1,125 @@
+package storagev1otel_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	metricdata "go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	tracetest "go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1otel"
+)
+
+func TestStorageServiceClient(t *testing.T) {
+	ctx := context.Background()
+
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+
+	client := storagev1otel.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+	client.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	client.SetMeterProvider(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	writer := bucket.Object("a").NewWriter(ctx)
+	io.WriteString(writer, "hello")
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	if _, err := bucket.Object("missing").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Fatalf("Attrs = %v, want ErrObjectNotExist", err)
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	for _, name := range []string{"storage.bucket.create", "storage.object.attrs"} {
+		if _, ok := spans[name]; !ok {
+			t.Errorf("span %q is missing, got %v", name, spans)
+		}
+	}
+
+	if span, ok := spans["storage.object.attrs"]; ok {
+		if span.Status().Code != codes.Error {
+			t.Errorf("status = %v, want an error", span.Status())
+		}
+
+		if !hasAttribute(span.Attributes(), storagev1otel.AttributeErrorType.String("404")) {
+			t.Errorf("attributes = %v, want the 404 error type", span.Attributes())
+		}
+
+		if !hasAttribute(span.Attributes(), storagev1otel.AttributeObject.String("missing")) {
+			t.Errorf("attributes = %v, want the object", span.Attributes())
+		}
+	}
+
+	var metrics metricdata.ResourceMetrics
+	if err := reader.Collect(ctx, &metrics); err != nil {
+		t.Fatalf("Collect: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, scope := range metrics.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			names[m.Name] = true
+		}
+	}
+
+	for _, name := range []string{"storage.client.operation.duration", "storage.client.errors", "storage.client.write.size"} {
+		if !names[name] {
+			t.Errorf("metric %q is missing, got %v", name, names)
+		}
+	}
+}
+
+func TestStorageObjectReaderGeneration(t *testing.T) {
+	ctx := context.Background()
+
+	client := storagev1otel.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	writer := bucket.Object("a").NewWriter(ctx)
+	io.WriteString(writer, "hello")
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	reader, err := bucket.Object("a").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	if got, want := storagev1.StorageObjectReaderGeneration(reader), writer.Attrs().Generation; got != want || got == 0 {
+		t.Errorf("StorageObjectReaderGeneration() = %v, want %v", got, want)
+	}
+}
+
+func hasAttribute(attrs []attribute.KeyValue, want attribute.KeyValue) bool {
+	for _, attr := range attrs {
+		if attr == want {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,198 @@
+package storagev1otel
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	googleapi "google.golang.org/api/googleapi"
+	iterator "google.golang.org/api/iterator"
+)
+
+// ScopeName is the instrumentation scope of the tracer and the meter.
+const ScopeName = "github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1otel"
+
+const (
+	// AttributeOperation is the attribute of the operation name.
+	AttributeOperation = attribute.Key("storage.operation")
+	// AttributeBucket is the attribute of the bucket name.
+	AttributeBucket = attribute.Key("storage.bucket")
+	// AttributeObject is the attribute of the object name.
+	AttributeObject = attribute.Key("storage.object")
+	// AttributeGeneration is the attribute of the object generation.
+	AttributeGeneration = attribute.Key("storage.generation")
+	// AttributeSourceBucket is the attribute of the bucket of a copy source.
+	AttributeSourceBucket = attribute.Key("storage.source.bucket")
+	// AttributeSourceObject is the attribute of the object of a copy source.
+	AttributeSourceObject = attribute.Key("storage.source.object")
+	// AttributeErrorType is the attribute of the error code.
+	AttributeErrorType = attribute.Key("error.type")
+	// AttributeEventType is the attribute of the type of a storage object
+	// event.
+	AttributeEventType = attribute.Key("storage.event.type")
+	// AttributeMessageID is the attribute of the message ID of a storage
+	// object event.
+	AttributeMessageID = attribute.Key("messaging.message.id")
+)
+
+// config holds the telemetry shared by a client and its handles.
+type config struct {
+	mu        sync.RWMutex
+	tp        trace.TracerProvider
+	mp        metric.MeterProvider
+	telemetry *telemetry
+}
+
+// get returns the current telemetry.
+func (x *config) get() *telemetry {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	// done!
+	return x.telemetry
+}
+
+// telemetry holds the tracer and the instruments of a client.
+type telemetry struct {
+	tracer   trace.Tracer
+	duration metric.Float64Histogram
+	read     metric.Int64Histogram
+	written  metric.Int64Histogram
+	errors   metric.Int64Counter
+}
+
+// newTelemetry creates the tracer and the instruments. The errors of the
+// instruments are reported to the global error handler, and the failed
+// instruments are no-ops.
+func newTelemetry(tp trace.TracerProvider, mp metric.MeterProvider) *telemetry {
+	meter := mp.Meter(ScopeName)
+
+	t := &telemetry{tracer: tp.Tracer(ScopeName)}
+
+	var err error
+	// create the instruments
+	t.duration, err = meter.Float64Histogram("storage.client.operation.duration",
+		metric.WithDescription("Duration of the storage operations."),
+		metric.WithUnit("s"),
+	)
+	handle(err)
+
+	t.read, err = meter.Int64Histogram("storage.client.read.size",
+		metric.WithDescription("Size of the content read by a storage object reader."),
+		metric.WithUnit("By"),
+	)
+	handle(err)
+
+	t.written, err = meter.Int64Histogram("storage.client.write.size",
+		metric.WithDescription("Size of the content written by a storage object writer."),
+		metric.WithUnit("By"),
+	)
+	handle(err)
+
+	t.errors, err = meter.Int64Counter("storage.client.errors",
+		metric.WithDescription("Number of the storage operations that failed."),
+		metric.WithUnit("{error}"),
+	)
+	handle(err)
+
+	return t
+}
+
+func handle(err error) {
+	if err != nil {
+		otel.Handle(err)
+	}
+}
+
+// start starts the span of an operation. The metrics of the operation carry
+// the operation and the bucket only, so that their cardinality stays bounded.
+func (x *telemetry) start(ctx context.Context, op, bucket string, attrs ...attribute.KeyValue) (context.Context, *operation) {
+	metrics := []attribute.KeyValue{AttributeOperation.String(op)}
+	if bucket != "" {
+		metrics = append(metrics, AttributeBucket.String(bucket))
+	}
+
+	attrs = append(metrics[:len(metrics):len(metrics)], attrs...)
+	// start the span
+	ctx, span := x.tracer.Start(ctx, "storage."+op,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attrs...),
+	)
+
+	return ctx, &operation{
+		ctx:       ctx,
+		telemetry: x,
+		span:      span,
+		attrs:     metrics,
+		start:     time.Now(),
+	}
+}
+
+// operation represents a started operation.
+type operation struct {
+	ctx       context.Context
+	telemetry *telemetry
+	span      trace.Span
+	attrs     []attribute.KeyValue
+	start     time.Time
+}
+
+// end ends the operation, recording its duration and its error. The
+// iterator.Done error is not an error.
+func (x *operation) end(err error) {
+	attrs := x.attrs
+
+	if err != nil && !errors.Is(err, iterator.Done) {
+		code := errorType(err)
+		attrs = append(attrs[:len(attrs):len(attrs)], AttributeErrorType.String(code))
+		// record the error
+		x.telemetry.errors.Add(x.ctx, 1, metric.WithAttributes(attrs...))
+		x.span.RecordError(err)
+		x.span.SetAttributes(AttributeErrorType.String(code))
+		x.span.SetStatus(codes.Error, err.Error())
+	}
+
+	x.telemetry.duration.Record(x.ctx, time.Since(x.start).Seconds(), metric.WithAttributes(attrs...))
+	x.span.End()
+}
+
+// object sets the generation of the object that the operation returns.
+func (x *operation) object(attrs *storage.ObjectAttrs) {
+	if attrs != nil && attrs.Generation != 0 {
+		x.span.SetAttributes(AttributeGeneration.Int64(attrs.Generation))
+	}
+}
+
+// errorType returns the code of an error: the HTTP status of a googleapi
+// error, the code of a connect error, or the name of a known error.
+func errorType(err error) string {
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) {
+		return strconv.Itoa(gerr.Code)
+	}
+
+	var cerr *connect.Error
+	if errors.As(err, &cerr) {
+		return cerr.Code().String()
+	}
+
+	switch {
+	case errors.Is(err, storage.ErrBucketNotExist), errors.Is(err, storage.ErrObjectNotExist):
+		return strconv.Itoa(http.StatusNotFound)
+	case errors.Is(err, context.Canceled):
+		return connect.CodeCanceled.String()
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.CodeDeadlineExceeded.String()
+	default:
+		return connect.CodeUnknown.String()
+	}
+}
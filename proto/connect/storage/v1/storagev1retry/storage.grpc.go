@@ -0,0 +1,985 @@
+// Package storagev1retry decorates any storagev1.StorageServiceClient with
+// retries of the transient errors:
+//
+//	client := storagev1retry.NewStorageServiceClient(storagev1mem.NewStorageServiceClient())
+//	client.SetOperationRetryPolicy(storagev1retry.OperationObjectRead, storagev1retry.RetryPolicy{
+//		MaxAttempts:    10,
+//		InitialBackoff: 100 * time.Millisecond,
+//		MaxBackoff:     5 * time.Second,
+//		Multiplier:     2,
+//		Jitter:         0.5,
+//	})
+//
+// The reads, the listings, the ACLs and the creations and deletions of the
+// buckets are always retried. The updates of the buckets and the deletes, the
+// updates, the writes, the copies and the compositions of the objects are
+// retried only when a generation or a metageneration precondition is set with
+// If, because repeating them may apply them twice otherwise.
+//
+// A conditional write is repeated only while its content fits in the chunk
+// size of the writer, which it buffers in memory. A larger content is streamed
+// to the decorated writer in a single attempt, whose resumable upload retries
+// the chunks itself.
+//
+// A read that fails midway resumes from the last byte offset with
+// NewRangeReader instead of restarting, after the backoff of the policy. The
+// read pins the generation that the reader reports when it opens, so that it
+// resumes on the same content, and it does not resume when the reader reports
+// none.
+package storagev1retry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	storage "cloud.google.com/go/storage"
+	googleapi "google.golang.org/api/googleapi"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// ErrWriterClosed is returned when writing to a closed writer.
+var ErrWriterClosed = errors.New("storage: writer is closed")
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents a storage service client that retries the
+// transient errors of another client.
+type StorageServiceClient struct {
+	client storagev1.StorageServiceClient
+	config *config
+}
+
+// NewStorageServiceClient creates a new StorageServiceClient that retries the
+// operations of the given client with DefaultRetryPolicy.
+func NewStorageServiceClient(client storagev1.StorageServiceClient) *StorageServiceClient {
+	return &StorageServiceClient{
+		client: client,
+		config: &config{
+			policy:   DefaultRetryPolicy,
+			policies: make(map[Operation]RetryPolicy),
+		},
+	}
+}
+
+// SetRetryPolicy sets the policy of the operations that do not have their
+// own.
+func (x *StorageServiceClient) SetRetryPolicy(policy RetryPolicy) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// set the policy
+	x.config.policy = policy
+}
+
+// SetOperationRetryPolicy sets the policy of the given operation.
+func (x *StorageServiceClient) SetOperationRetryPolicy(op Operation, policy RetryPolicy) {
+	x.config.mu.Lock()
+	defer x.config.mu.Unlock()
+	// set the policy
+	x.config.policies[op] = policy
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{handle: x.client.Bucket(name), config: x.config, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{
+		ctx:       ctx,
+		client:    x.client,
+		policy:    x.config.get(OperationBucketList),
+		projectID: projectID,
+		iter:      x.client.Buckets(ctx, projectID),
+	}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return x.client.Close()
+}
+
+// config holds the retry policies shared by a client and its handles.
+type config struct {
+	mu       sync.RWMutex
+	policy   RetryPolicy
+	policies map[Operation]RetryPolicy
+}
+
+// get returns the policy of the given operation.
+func (x *config) get(op Operation) *RetryPolicy {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	policy, ok := x.policies[op]
+	if !ok {
+		policy = x.policy
+	}
+
+	return &policy
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents a storage bucket handle that retries the
+// transient errors of another handle.
+type StorageBucketHandle struct {
+	handle storagev1.StorageBucketHandle
+	config *config
+	name   string
+	conds  *storage.BucketConditions
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	var notification *storage.Notification
+	// the service assigns a new id on every call
+	err := x.config.get(OperationNotification).run(ctx, false, func(ctx context.Context) (err error) {
+		notification, err = x.handle.AddNotification(ctx, n)
+		return err
+	})
+
+	return notification, err
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	var attrs *storage.BucketAttrs
+
+	err := x.config.get(OperationBucketAttrs).run(ctx, true, func(ctx context.Context) (err error) {
+		attrs, err = x.handle.Attrs(ctx)
+		return err
+	})
+
+	return attrs, err
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	return x.config.get(OperationBucketCreate).run(ctx, true, func(ctx context.Context) error {
+		return x.handle.Create(ctx, projectID, attrs)
+	})
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.DefaultObjectACL(), config: x.config}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	return x.config.get(OperationBucketDelete).run(ctx, true, func(ctx context.Context) error {
+		return x.handle.Delete(ctx)
+	})
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	return x.config.get(OperationNotification).run(ctx, true, func(ctx context.Context) error {
+		return x.handle.DeleteNotification(ctx, id)
+	})
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(conds storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	handle.conds = &conds
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	return x.config.get(OperationBucketLock).run(ctx, conditionalBucket(x.conds), func(ctx context.Context) error {
+		return x.handle.LockRetentionPolicy(ctx)
+	})
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	var notifications map[string]*storage.Notification
+
+	err := x.config.get(OperationNotification).run(ctx, true, func(ctx context.Context) (err error) {
+		notifications, err = x.handle.Notifications(ctx)
+		return err
+	})
+
+	return notifications, err
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{handle: x.handle.Object(name), config: x.config, bucket: x.name, name: name}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	iter := &StorageObjectIterator{
+		ctx:    ctx,
+		handle: x.handle,
+		policy: x.config.get(OperationObjectList),
+		iter:   x.handle.Objects(ctx, query),
+	}
+
+	if query != nil {
+		iter.query = *query
+	}
+
+	return iter
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, uattrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	var attrs *storage.BucketAttrs
+
+	err := x.config.get(OperationBucketUpdate).run(ctx, conditionalBucket(x.conds), func(ctx context.Context) (err error) {
+		attrs, err = x.handle.Update(ctx, uattrs)
+		return err
+	})
+
+	return attrs, err
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.handle = x.handle.UserProject(projectID)
+	return &handle
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents a storage bucket iterator that restarts
+// the listing after a transient error, skipping the buckets that it has
+// returned already.
+type StorageBucketIterator struct {
+	ctx       context.Context
+	client    storagev1.StorageServiceClient
+	policy    *RetryPolicy
+	projectID string
+	iter      storagev1.StorageBucketIterator
+	last      string
+	restart   bool
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	var item *storage.BucketAttrs
+
+	err := x.policy.run(x.ctx, true, func(context.Context) error {
+		if x.restart {
+			x.iter = x.client.Buckets(x.ctx, x.projectID)
+			x.restart = false
+		}
+
+		for {
+			attrs, err := x.iter.Next()
+			if err != nil {
+				x.restart = err != iterator.Done
+				return err
+			}
+
+			// the buckets are listed in the order of their names
+			if x.last != "" && attrs.Name <= x.last {
+				continue
+			}
+
+			item = attrs
+			x.last = attrs.Name
+			// done!
+			return nil
+		}
+	})
+
+	return item, err
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents a storage object iterator that restarts
+// the listing after a transient error, skipping the objects and the prefixes
+// that it has returned already.
+type StorageObjectIterator struct {
+	ctx     context.Context
+	handle  storagev1.StorageBucketHandle
+	policy  *RetryPolicy
+	query   storage.Query
+	iter    storagev1.StorageObjectIterator
+	last    *position
+	restart bool
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	var item *storage.ObjectAttrs
+
+	err := x.policy.run(x.ctx, true, func(context.Context) error {
+		if x.restart {
+			query := x.query
+			// start the listing from the last returned name
+			if x.last != nil && x.last.name > query.StartOffset {
+				query.StartOffset = x.last.name
+			}
+
+			x.iter = x.handle.Objects(x.ctx, &query)
+			x.restart = false
+		}
+
+		for {
+			attrs, err := x.iter.Next()
+			if err != nil {
+				x.restart = err != iterator.Done
+				return err
+			}
+
+			next := positionOf(attrs)
+			if x.last != nil && !x.last.less(next) {
+				continue
+			}
+
+			item = attrs
+			x.last = &next
+			// done!
+			return nil
+		}
+	})
+
+	return item, err
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return x.iter.PageInfo()
+}
+
+// position is the position of an item in an object listing, which orders the
+// objects by name and generation, and a prefix before the objects that have
+// the same name.
+type position struct {
+	name       string
+	generation int64
+}
+
+func positionOf(attrs *storage.ObjectAttrs) position {
+	if attrs.Prefix != "" {
+		return position{name: attrs.Prefix, generation: -1}
+	}
+
+	return position{name: attrs.Name, generation: attrs.Generation}
+}
+
+func (x position) less(y position) bool {
+	if x.name != y.name {
+		return x.name < y.name
+	}
+
+	return x.generation < y.generation
+}
+
+var (
+	_ storagev1.StorageObjectHandle        = &StorageObjectHandle{}
+	_ storagev1.StorageObjectHandleWrapper = &StorageObjectHandle{}
+)
+
+// StorageObjectHandle represents a storage object handle that retries the
+// transient errors of another handle.
+type StorageObjectHandle struct {
+	handle     storagev1.StorageObjectHandle
+	config     *config
+	bucket     string
+	name       string
+	generation int64
+	conds      *storage.Conditions
+	compressed bool
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{handle: x.handle.ACL(), config: x.config}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	var attrs *storage.ObjectAttrs
+
+	err := x.config.get(OperationObjectAttrs).run(ctx, true, func(ctx context.Context) (err error) {
+		attrs, err = x.handle.Attrs(ctx)
+		return err
+	})
+
+	return attrs, err
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(srcs ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	handles := make([]storagev1.StorageObjectHandle, len(srcs))
+	for i, src := range srcs {
+		handles[i] = unwrap(src)
+	}
+
+	return &StorageObjectComposer{handle: x, srcs: handles}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(src storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	return &StorageObjectCopier{handle: x, src: unwrap(src)}
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	return x.config.get(OperationObjectDelete).run(ctx, conditional(x.conds), func(ctx context.Context) error {
+		return x.handle.Delete(ctx)
+	})
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Generation(gen)
+	handle.generation = gen
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(conds storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.If(conds)
+	handle.conds = &conds
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(key []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.Key(key)
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset, length int64) (storagev1.StorageObjectReader, error) {
+	var reader storagev1.StorageObjectReader
+
+	policy := x.config.get(OperationObjectRead)
+	// open the reader, which keeps the context of the call
+	err := policy.run(ctx, true, func(context.Context) (err error) {
+		reader, err = x.handle.NewRangeReader(ctx, offset, length)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	generation := x.generation
+	// pin the generation that the reader reads
+	if generation == 0 {
+		generation = storagev1.StorageObjectReaderGeneration(reader)
+	}
+
+	return &StorageObjectReader{
+		ctx:        ctx,
+		handle:     x,
+		policy:     policy,
+		reader:     reader,
+		offset:     offset,
+		length:     length,
+		generation: generation,
+	}, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+//
+// The writer of a handle without a generation or a metageneration
+// precondition is the writer of the decorated handle. Otherwise, the writer
+// buffers the content in memory until it is closed, so that the upload can be
+// repeated, as long as the content fits in the chunk size. The writer streams
+// a larger content to the writer of the decorated handle without repeating it.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	if !conditional(x.conds) {
+		return x.handle.NewWriter(ctx)
+	}
+
+	return &StorageObjectWriter{
+		ctx:    ctx,
+		handle: x,
+		attrs: storage.ObjectAttrs{
+			Bucket: x.bucket,
+			Name:   x.name,
+		},
+	}
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.handle = x.handle.ReadCompressed(compressed)
+	handle.compressed = compressed
+	return &handle
+}
+
+// Unwrap implements storagev1.StorageObjectHandleWrapper.
+func (x *StorageObjectHandle) Unwrap() storagev1.StorageObjectHandle {
+	return x.handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, uattrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	var attrs *storage.ObjectAttrs
+
+	err := x.config.get(OperationObjectUpdate).run(ctx, conditional(x.conds), func(ctx context.Context) (err error) {
+		attrs, err = x.handle.Update(ctx, uattrs)
+		return err
+	})
+
+	return attrs, err
+}
+
+// unwrap returns the decorated handle of a StorageObjectHandle, so that the
+// backends can recognize their own handles.
+func unwrap(handle storagev1.StorageObjectHandle) storagev1.StorageObjectHandle {
+	if v, ok := handle.(*StorageObjectHandle); ok {
+		return v.handle
+	}
+
+	return handle
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents a storage object reader that resumes from
+// the last byte offset after a transient error.
+type StorageObjectReader struct {
+	ctx        context.Context
+	handle     *StorageObjectHandle
+	policy     *RetryPolicy
+	reader     storagev1.StorageObjectReader
+	offset     int64
+	length     int64
+	read       int64
+	generation int64
+	checked    bool
+	failures   int
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.reader.CacheControl()
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	return x.reader.Close()
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.reader.ContentEncoding()
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.reader.ContentType()
+}
+
+// Generation returns the generation of the object, or zero when it is not
+// known.
+func (x *StorageObjectReader) Generation() int64 {
+	return x.generation
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	for {
+		n, err := x.reader.Read(p)
+		x.read += int64(n)
+
+		if err == nil || !x.policy.retryable(err) {
+			return n, err
+		}
+
+		// the failures are counted until the read makes progress again
+		if n > 0 {
+			x.failures = 0
+		}
+
+		x.failures++
+		if x.failures >= x.policy.MaxAttempts || !x.policy.wait(x.ctx, x.failures) {
+			return n, err
+		}
+
+		if err := x.resume(err); err != nil {
+			return n, err
+		}
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// resume reopens the reader at the last byte offset. It returns cause when
+// the read cannot be resumed.
+func (x *StorageObjectReader) resume(cause error) error {
+	// the content may have changed since the reader was opened
+	if x.generation == 0 {
+		return cause
+	}
+
+	// pin the generation, so that the content cannot change between two reads
+	handle := x.handle.handle.Generation(x.generation)
+
+	if !x.handle.compressed && !x.checked {
+		attrs, err := handle.Attrs(x.ctx)
+		if err != nil {
+			return cause
+		}
+
+		// the offsets of a decompressed content do not match the stored bytes
+		if attrs.ContentEncoding == "gzip" {
+			return cause
+		}
+
+		x.checked = true
+	}
+
+	offset := x.offset
+	if offset < 0 {
+		offset = max(x.reader.Size()+offset, 0)
+	}
+
+	length := x.length
+	if length >= 0 {
+		length -= x.read
+	}
+
+	// the range has been read entirely
+	if length == 0 || offset+x.read >= x.reader.Size() {
+		return io.EOF
+	}
+
+	var reader storagev1.StorageObjectReader
+
+	err := x.policy.run(x.ctx, true, func(context.Context) (err error) {
+		reader, err = handle.NewRangeReader(x.ctx, offset+x.read, length)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	x.reader.Close()
+	x.reader = reader
+	// done!
+	return nil
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return x.reader.Remain()
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.reader.Size()
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents a storage object writer that buffers the
+// content in memory and repeats the upload after a transient error. The
+// content that exceeds the chunk size is streamed instead.
+type StorageObjectWriter struct {
+	ctx       context.Context
+	handle    *StorageObjectHandle
+	attrs     storage.ObjectAttrs
+	buffer    bytes.Buffer
+	writer    storagev1.StorageObjectWriter
+	chunkSize *int
+	progress  func(int64)
+	crc32c    *uint32
+	result    *storage.ObjectAttrs
+	closed    bool
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.result
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	if x.closed {
+		return ErrWriterClosed
+	}
+
+	x.closed = true
+	// the streamed content is not repeated
+	if x.writer != nil {
+		if err := x.writer.Close(); err != nil {
+			return err
+		}
+
+		x.result = x.writer.Attrs()
+		// done!
+		return nil
+	}
+
+	// upload the content
+	return x.handle.config.get(OperationObjectWrite).run(x.ctx, true, func(ctx context.Context) error {
+		writer := x.open(ctx)
+
+		if _, err := writer.Write(x.buffer.Bytes()); err != nil {
+			writer.CloseWithError(err)
+			return err
+		}
+
+		if err := writer.Close(); err != nil {
+			return err
+		}
+
+		x.result = writer.Attrs()
+		// done!
+		return nil
+	})
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	if x.closed {
+		return ErrWriterClosed
+	}
+
+	x.closed = true
+	// discard the content
+	x.buffer.Reset()
+
+	if x.writer != nil {
+		return x.writer.CloseWithError(err)
+	}
+
+	return nil
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.crc32c = &v
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.chunkSize = &v
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.attrs.ContentType = v
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.progress = fn
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if x.writer == nil && x.buffer.Len()+len(p) > x.limit() {
+		x.writer = x.open(x.ctx)
+		// stream the buffered content first
+		if _, err := x.writer.Write(x.buffer.Bytes()); err != nil {
+			return 0, err
+		}
+
+		x.buffer.Reset()
+	}
+
+	if x.writer != nil {
+		return x.writer.Write(p)
+	}
+
+	return x.buffer.Write(p)
+}
+
+// limit returns the size of the content that the writer buffers, which is its
+// chunk size.
+func (x *StorageObjectWriter) limit() int {
+	if x.chunkSize != nil && *x.chunkSize > 0 {
+		return *x.chunkSize
+	}
+
+	return googleapi.DefaultUploadChunkSize
+}
+
+// open creates a writer of the decorated handle with the attributes and the
+// settings of the writer.
+func (x *StorageObjectWriter) open(ctx context.Context) storagev1.StorageObjectWriter {
+	writer := x.handle.handle.NewWriter(ctx)
+	*writer.ObjectAttrs() = x.attrs
+
+	if x.chunkSize != nil {
+		writer.SetChunkSize(*x.chunkSize)
+	}
+
+	if x.progress != nil {
+		writer.SetProgressFunc(x.progress)
+	}
+
+	if x.crc32c != nil {
+		writer.SetCRC32C(*x.crc32c)
+	}
+
+	return writer
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents a storage object copier that repeats the
+// copy after a transient error when the destination has a precondition.
+type StorageObjectCopier struct {
+	handle   *StorageObjectHandle
+	src      storagev1.StorageObjectHandle
+	attrs    storage.ObjectAttrs
+	token    string
+	kmsKey   string
+	progress func(uint64, uint64)
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	var attrs *storage.ObjectAttrs
+
+	err := x.handle.config.get(OperationObjectCopy).run(ctx, conditional(x.handle.conds), func(ctx context.Context) (err error) {
+		copier := x.handle.handle.CopierFrom(x.src)
+		*copier.ObjectAttrs() = x.attrs
+
+		if x.token != "" {
+			copier.SetRewriteToken(x.token)
+		}
+
+		if x.kmsKey != "" {
+			copier.SetDestinationKMSKeyName(x.kmsKey)
+		}
+
+		if x.progress != nil {
+			copier.SetProgressFunc(x.progress)
+		}
+
+		attrs, err = copier.Run(ctx)
+		return err
+	})
+
+	return attrs, err
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(v string) {
+	x.kmsKey = v
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.progress = fn
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(v string) {
+	x.token = v
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents a storage object composer that repeats the
+// composition after a transient error when the destination has a
+// precondition.
+type StorageObjectComposer struct {
+	handle *StorageObjectHandle
+	srcs   []storagev1.StorageObjectHandle
+	attrs  storage.ObjectAttrs
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	var attrs *storage.ObjectAttrs
+
+	err := x.handle.config.get(OperationObjectCompose).run(ctx, conditional(x.handle.conds), func(ctx context.Context) (err error) {
+		composer := x.handle.handle.ComposerFrom(x.srcs...)
+		*composer.ObjectAttrs() = x.attrs
+
+		attrs, err = composer.Run(ctx)
+		return err
+	})
+
+	return attrs, err
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+// StorageACLHandle represents a storage ACL handle that retries the
+// transient errors of another handle.
+type StorageACLHandle struct {
+	handle storagev1.StorageACLHandle
+	config *config
+}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	return x.config.get(OperationACL).run(ctx, true, func(ctx context.Context) error {
+		return x.handle.Delete(ctx, entity)
+	})
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	var rules []storage.ACLRule
+
+	err := x.config.get(OperationACL).run(ctx, true, func(ctx context.Context) (err error) {
+		rules, err = x.handle.List(ctx)
+		return err
+	})
+
+	return rules, err
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	return x.config.get(OperationACL).run(ctx, true, func(ctx context.Context) error {
+		return x.handle.Set(ctx, entity, role)
+	})
+}
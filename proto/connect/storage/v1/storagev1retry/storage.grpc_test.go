@@ -0,0 +1,310 @@
+package storagev1retry_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1chaos"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1retry"
+)
+
+// newClient returns a retrying client on top of a client that injects the
+// faults of the chaos client into a memory backend with a bucket.
+func newClient(t *testing.T, policy storagev1retry.RetryPolicy) (*storagev1retry.StorageServiceClient, *storagev1chaos.StorageServiceClient, storagev1.StorageServiceClient) {
+	t.Helper()
+
+	backend := storagev1mem.NewStorageServiceClient()
+	if err := backend.Bucket("bucket").Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	chaos := storagev1chaos.NewStorageServiceClient(backend)
+
+	client := storagev1retry.NewStorageServiceClient(chaos)
+	client.SetRetryPolicy(policy)
+
+	return client, chaos, backend
+}
+
+func writeObject(t *testing.T, handle storagev1.StorageObjectHandle, data string) *storage.ObjectAttrs {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	return writer.Attrs()
+}
+
+func TestStorageObjectReaderResume(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	writeObject(t, backend.Bucket("bucket").Object("a"), "hello world")
+	// truncate the first read after 5 bytes
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+		Schedule: storagev1chaos.Calls(1),
+		Fault:    storagev1chaos.Fault{Err: io.ErrUnexpectedEOF, Offset: 5},
+	})
+
+	reader, err := client.Bucket("bucket").Object("a").NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if string(data) != "hello world" {
+		t.Errorf("ReadAll = %q, want %q", data, "hello world")
+	}
+}
+
+// An object that is overwritten with the same size between the failure and
+// the resumption must not mix the two contents.
+func TestStorageObjectReaderResumeOverwritten(t *testing.T) {
+	ctx := context.Background()
+	bucket := "bucket"
+
+	var overwrite func()
+
+	policy := storagev1retry.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		ShouldRetry: func(err error) bool {
+			if overwrite != nil {
+				overwrite()
+				overwrite = nil
+			}
+
+			return storagev1retry.IsRetryable(err)
+		},
+	}
+
+	client, chaos, backend := newClient(t, policy)
+
+	writeObject(t, backend.Bucket(bucket).Object("a"), "hello world")
+	overwrite = func() { writeObject(t, backend.Bucket(bucket).Object("a"), "HELLO WORLD") }
+
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+		Schedule: storagev1chaos.Calls(1),
+		Fault:    storagev1chaos.Fault{Err: io.ErrUnexpectedEOF, Offset: 5},
+	})
+
+	reader, err := client.Bucket(bucket).Object("a").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	// the pinned generation no longer exists without versioning
+	data, err := io.ReadAll(reader)
+	if err == nil {
+		t.Fatalf("ReadAll = %q, want an error", data)
+	}
+
+	if string(data) != "hello" {
+		t.Errorf("ReadAll = %q, want %q", data, "hello")
+	}
+}
+
+func TestStorageObjectReaderResumeBackoff(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: 50 * time.Millisecond})
+
+	writeObject(t, backend.Bucket("bucket").Object("a"), "hello world")
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectRead},
+		Schedule: storagev1chaos.Calls(1),
+		Fault:    storagev1chaos.Fault{Err: io.ErrUnexpectedEOF, Offset: 5},
+	})
+
+	reader, err := client.Bucket("bucket").Object("a").NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	start := time.Now()
+	if _, err := io.ReadAll(reader); err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("the read resumed after %v, want the backoff of 50ms", elapsed)
+	}
+}
+
+func TestRetryPolicyDeadline(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond, Deadline: 50 * time.Millisecond})
+
+	writeObject(t, backend.Bucket("bucket").Object("a"), "data")
+	// a call that hangs is bounded by the deadline
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectAttrs},
+		Schedule: storagev1chaos.Always(),
+		Fault:    storagev1chaos.Fault{Latency: time.Minute},
+	})
+
+	start := time.Now()
+	if _, err := client.Bucket("bucket").Object("a").Attrs(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Attrs = %v, want context.DeadlineExceeded", err)
+	}
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Attrs took %v, want the deadline of 50ms", elapsed)
+	}
+}
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	writeObject(t, backend.Bucket("bucket").Object("a"), "data")
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectAttrs},
+		Schedule: storagev1chaos.First(2),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+	})
+
+	if _, err := client.Bucket("bucket").Object("a").Attrs(context.Background()); err != nil {
+		t.Errorf("Attrs = %v, want the third attempt to succeed", err)
+	}
+
+	// a delete without a precondition is attempted once
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectDelete},
+		Schedule: storagev1chaos.First(1),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+	})
+
+	if err := client.Bucket("bucket").Object("a").Delete(context.Background()); err == nil {
+		t.Error("Delete: want the error of the single attempt")
+	}
+}
+
+func readObject(t *testing.T, handle storagev1.StorageObjectHandle) string {
+	t.Helper()
+
+	reader, err := handle.NewReader(context.Background())
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+
+	return string(data)
+}
+
+func TestStorageObjectWriterRetry(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	// the first upload is cut short and the second one fails to close
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectWrite},
+		Schedule: storagev1chaos.Calls(1),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable), Offset: 3},
+	})
+
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectClose},
+		Schedule: storagev1chaos.Calls(2),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+	})
+
+	writer := client.Bucket("bucket").Object("a").If(storage.Conditions{DoesNotExist: true}).NewWriter(context.Background())
+	for _, part := range []string{"hello ", "world"} {
+		if _, err := io.WriteString(writer, part); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close = %v, want the third attempt to succeed", err)
+	}
+
+	if got := readObject(t, backend.Bucket("bucket").Object("a")); got != "hello world" {
+		t.Errorf("object = %q, want the buffered content", got)
+	}
+}
+
+func TestStorageObjectWriterStream(t *testing.T) {
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	chaos.AddRule(storagev1chaos.Rule{
+		Methods:  []storagev1chaos.Method{storagev1chaos.MethodObjectClose},
+		Schedule: storagev1chaos.Calls(1),
+		Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+	})
+
+	// a content larger than the chunk size is streamed in a single attempt
+	for _, want := range []bool{false, true} {
+		writer := client.Bucket("bucket").Object("a").If(storage.Conditions{DoesNotExist: true}).NewWriter(context.Background())
+		writer.SetChunkSize(4)
+
+		if _, err := io.WriteString(writer, "hello world"); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+
+		if err := writer.Close(); (err == nil) != want {
+			t.Fatalf("Close = %v, want success %v", err, want)
+		}
+	}
+
+	if got := readObject(t, backend.Bucket("bucket").Object("a")); got != "hello world" {
+		t.Errorf("object = %q, want the streamed content", got)
+	}
+}
+
+func TestStorageObjectHandleRetry(t *testing.T) {
+	ctx := context.Background()
+	client, chaos, backend := newClient(t, storagev1retry.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+
+	attrs := writeObject(t, backend.Bucket("bucket").Object("a"), "data")
+	for _, method := range []storagev1chaos.Method{storagev1chaos.MethodObjectUpdate, storagev1chaos.MethodObjectDelete} {
+		chaos.AddRule(storagev1chaos.Rule{
+			Methods:  []storagev1chaos.Method{method},
+			Schedule: storagev1chaos.First(1),
+			Fault:    storagev1chaos.Fault{Err: storagev1chaos.StatusError(http.StatusServiceUnavailable)},
+		})
+	}
+
+	// a precondition makes the update and the delete idempotent
+	object := client.Bucket("bucket").Object("a")
+
+	updated, err := object.If(storage.Conditions{MetagenerationMatch: attrs.Metageneration}).Update(ctx, storage.ObjectAttrsToUpdate{ContentType: "text/plain"})
+	if err != nil {
+		t.Fatalf("Update = %v, want the second attempt to succeed", err)
+	}
+
+	if updated.ContentType != "text/plain" {
+		t.Errorf("ContentType = %q, want text/plain", updated.ContentType)
+	}
+
+	if err := object.If(storage.Conditions{GenerationMatch: attrs.Generation}).Delete(ctx); err != nil {
+		t.Fatalf("Delete = %v, want the second attempt to succeed", err)
+	}
+
+	if _, err := backend.Bucket("bucket").Object("a").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs after Delete = %v, want ErrObjectNotExist", err)
+	}
+}
@@ -0,0 +1,183 @@
+package storagev1retry
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// Operation identifies a storage operation that has its own retry policy.
+type Operation string
+
+const (
+	// OperationBucketList lists the buckets of a project.
+	OperationBucketList Operation = "bucket.list"
+	// OperationBucketAttrs fetches the attributes of a bucket.
+	OperationBucketAttrs Operation = "bucket.attrs"
+	// OperationBucketCreate creates a bucket.
+	OperationBucketCreate Operation = "bucket.create"
+	// OperationBucketUpdate updates the attributes of a bucket.
+	OperationBucketUpdate Operation = "bucket.update"
+	// OperationBucketDelete deletes a bucket.
+	OperationBucketDelete Operation = "bucket.delete"
+	// OperationBucketLock locks the retention policy of a bucket.
+	OperationBucketLock Operation = "bucket.lock"
+	// OperationNotification manages the notifications of a bucket.
+	OperationNotification Operation = "notification"
+	// OperationACL manages an access control list.
+	OperationACL Operation = "acl"
+	// OperationObjectList lists the objects of a bucket.
+	OperationObjectList Operation = "object.list"
+	// OperationObjectAttrs fetches the attributes of an object.
+	OperationObjectAttrs Operation = "object.attrs"
+	// OperationObjectUpdate updates the attributes of an object.
+	OperationObjectUpdate Operation = "object.update"
+	// OperationObjectDelete deletes an object.
+	OperationObjectDelete Operation = "object.delete"
+	// OperationObjectRead opens and resumes the reads of an object.
+	OperationObjectRead Operation = "object.read"
+	// OperationObjectWrite uploads an object.
+	OperationObjectWrite Operation = "object.write"
+	// OperationObjectCopy copies an object.
+	OperationObjectCopy Operation = "object.copy"
+	// OperationObjectCompose composes an object.
+	OperationObjectCompose Operation = "object.compose"
+)
+
+// DefaultRetryPolicy is the policy of the operations that do not have their
+// own.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.5,
+}
+
+// RetryPolicy describes how an operation is retried. The backoff before the
+// n-th retry is InitialBackoff * Multiplier^(n-1), capped at MaxBackoff, of
+// which the Jitter fraction is randomized.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// A value of zero or one disables the retries.
+	MaxAttempts int
+	// InitialBackoff is the backoff before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the backoff between two attempts.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each retry. Values below one keep the
+	// backoff constant.
+	Multiplier float64
+	// Jitter is the fraction of the backoff, from 0 to 1, that is randomized.
+	Jitter float64
+	// Deadline bounds the time spent on all the attempts of an operation,
+	// including the backoffs, as a deadline of the context of the attempts.
+	// The readers and the iterators outlive their operation, so it bounds
+	// only the retries of their opening and their resumptions. Zero means
+	// that the attempts are bounded by the context only.
+	Deadline time.Duration
+	// ShouldRetry reports whether an error is transient. It defaults to
+	// IsRetryable.
+	ShouldRetry func(err error) bool
+}
+
+// retryable reports whether err is transient for the policy.
+func (x *RetryPolicy) retryable(err error) bool {
+	if x.ShouldRetry != nil {
+		return x.ShouldRetry(err)
+	}
+
+	return IsRetryable(err)
+}
+
+// backoff returns the backoff before the given retry, starting from 1.
+func (x *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(x.InitialBackoff)
+	if x.Multiplier > 1 {
+		delay *= math.Pow(x.Multiplier, float64(retry-1))
+	}
+
+	if x.MaxBackoff > 0 && delay > float64(x.MaxBackoff) {
+		delay = float64(x.MaxBackoff)
+	}
+
+	if jitter := min(max(x.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// run calls fn until it succeeds, fails with a permanent error or runs out of
+// attempts. A call that is not idempotent is attempted once. The attempts
+// receive a context that expires at the deadline of the policy.
+func (x *RetryPolicy) run(ctx context.Context, idempotent bool, fn func(ctx context.Context) error) error {
+	if x.Deadline > 0 {
+		var cancel context.CancelFunc
+		// bound the attempts and the backoffs
+		ctx, cancel = context.WithTimeout(ctx, x.Deadline)
+		defer cancel()
+	}
+
+	for attempt := 1; ; attempt++ {
+		err := fn(ctx)
+		if err == nil || !idempotent || attempt >= x.MaxAttempts || !x.retryable(err) {
+			return err
+		}
+
+		if !x.wait(ctx, attempt) {
+			return err
+		}
+	}
+}
+
+// wait waits for the backoff before the given retry, starting from 1. It
+// reports false when the context is done before, or when its deadline does
+// not leave room for another attempt.
+func (x *RetryPolicy) wait(ctx context.Context, retry int) bool {
+	delay := x.backoff(retry)
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+		return false
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// IsRetryable reports whether err is transient, as
+// storagev1.IsRetryableStorageError does.
+func IsRetryable(err error) bool {
+	return storagev1.IsRetryableStorageError(err)
+}
+
+// conditional reports whether the object conditions make a mutation
+// idempotent.
+func conditional(conds *storage.Conditions) bool {
+	if conds == nil {
+		return false
+	}
+
+	return conds.GenerationMatch != 0 || conds.DoesNotExist || conds.MetagenerationMatch != 0
+}
+
+// conditionalBucket reports whether the bucket conditions make a mutation
+// idempotent.
+func conditionalBucket(conds *storage.BucketConditions) bool {
+	if conds == nil {
+		return false
+	}
+
+	return conds.MetagenerationMatch != 0
+}
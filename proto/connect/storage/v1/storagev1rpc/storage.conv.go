@@ -0,0 +1,609 @@
+package storagev1rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	googleapi "google.golang.org/api/googleapi"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// toTimestamp converts t to a timestamp, keeping the zero time unset.
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+// fromTimestamp converts ts to a time, keeping an unset timestamp zero.
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+// fromWritableObject converts the properties of a StorageObject that can be
+// set by a writer, a copier or a composer.
+func fromWritableObject(object *storagev1.StorageObject) (*storage.ObjectAttrs, error) {
+	value, err := storagev1.StorageObjectToAttrs(object)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := &storage.ObjectAttrs{
+		ContentType:        value.ContentType,
+		ContentLanguage:    value.ContentLanguage,
+		ContentEncoding:    value.ContentEncoding,
+		ContentDisposition: value.ContentDisposition,
+		CacheControl:       value.CacheControl,
+		Metadata:           value.Metadata,
+		StorageClass:       value.StorageClass,
+		KMSKeyName:         value.KMSKeyName,
+		TemporaryHold:      value.TemporaryHold,
+		EventBasedHold:     value.EventBasedHold,
+		CRC32C:             value.CRC32C,
+		MD5:                value.MD5,
+	}
+
+	return attrs, nil
+}
+
+// toObjectUpdate converts the updated properties of an object to a
+// StorageObject and the field mask of the properties.
+func toObjectUpdate(attrs *storage.ObjectAttrsToUpdate) (*storagev1.StorageObject, *fieldmaskpb.FieldMask, error) {
+	if !attrs.CustomTime.IsZero() || attrs.ACL != nil || attrs.PredefinedACL != "" || attrs.Retention != nil || attrs.Contexts != nil {
+		return nil, nil, ErrUnsupported
+	}
+
+	var (
+		object = &storagev1.StorageObject{}
+		mask   = &fieldmaskpb.FieldMask{}
+	)
+
+	if attrs.EventBasedHold != nil {
+		object.EventBasedHold = attrs.EventBasedHold.(bool)
+		mask.Paths = append(mask.Paths, "event_based_hold")
+	}
+
+	if attrs.TemporaryHold != nil {
+		object.TemporaryHold = attrs.TemporaryHold.(bool)
+		mask.Paths = append(mask.Paths, "temporary_hold")
+	}
+
+	if attrs.ContentType != nil {
+		object.ContentType = attrs.ContentType.(string)
+		mask.Paths = append(mask.Paths, "content_type")
+	}
+
+	if attrs.ContentLanguage != nil {
+		object.ContentLanguage = attrs.ContentLanguage.(string)
+		mask.Paths = append(mask.Paths, "content_language")
+	}
+
+	if attrs.ContentEncoding != nil {
+		object.ContentEncoding = attrs.ContentEncoding.(string)
+		mask.Paths = append(mask.Paths, "content_encoding")
+	}
+
+	if attrs.ContentDisposition != nil {
+		object.ContentDisposition = attrs.ContentDisposition.(string)
+		mask.Paths = append(mask.Paths, "content_disposition")
+	}
+
+	if attrs.CacheControl != nil {
+		object.CacheControl = attrs.CacheControl.(string)
+		mask.Paths = append(mask.Paths, "cache_control")
+	}
+
+	if attrs.Metadata != nil {
+		object.Metadata = attrs.Metadata
+		mask.Paths = append(mask.Paths, "metadata")
+	}
+
+	return object, mask, nil
+}
+
+// fromObjectUpdate converts a StorageObject and a field mask to the updated
+// properties of an object.
+func fromObjectUpdate(object *storagev1.StorageObject, mask *fieldmaskpb.FieldMask) (*storage.ObjectAttrsToUpdate, error) {
+	attrs := &storage.ObjectAttrsToUpdate{}
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "event_based_hold":
+			attrs.EventBasedHold = object.GetEventBasedHold()
+		case "temporary_hold":
+			attrs.TemporaryHold = object.GetTemporaryHold()
+		case "content_type":
+			attrs.ContentType = object.GetContentType()
+		case "content_language":
+			attrs.ContentLanguage = object.GetContentLanguage()
+		case "content_encoding":
+			attrs.ContentEncoding = object.GetContentEncoding()
+		case "content_disposition":
+			attrs.ContentDisposition = object.GetContentDisposition()
+		case "cache_control":
+			attrs.CacheControl = object.GetCacheControl()
+		case "metadata":
+			attrs.Metadata = object.GetMetadata()
+			// an empty map removes all the metadata
+			if attrs.Metadata == nil {
+				attrs.Metadata = map[string]string{}
+			}
+		default:
+			return nil, fmt.Errorf("storage: field %q cannot be updated", path)
+		}
+	}
+
+	return attrs, nil
+}
+
+// toBucket converts the attributes of a bucket to a StorageBucket.
+func toBucket(attrs *storage.BucketAttrs) *storagev1.StorageBucket {
+	if attrs == nil {
+		return nil
+	}
+
+	bucket := &storagev1.StorageBucket{
+		Name:                     attrs.Name,
+		ProjectNumber:            attrs.ProjectNumber,
+		Location:                 attrs.Location,
+		LocationType:             attrs.LocationType,
+		StorageClass:             attrs.StorageClass,
+		Labels:                   attrs.Labels,
+		VersioningEnabled:        attrs.VersioningEnabled,
+		RequesterPays:            attrs.RequesterPays,
+		DefaultEventBasedHold:    attrs.DefaultEventBasedHold,
+		RetentionPolicy:          toRetentionPolicy(attrs.RetentionPolicy),
+		UniformBucketLevelAccess: attrs.UniformBucketLevelAccess.Enabled,
+		Metageneration:           attrs.MetaGeneration,
+		Etag:                     attrs.Etag,
+		TimeCreated:              toTimestamp(attrs.Created),
+		Updated:                  toTimestamp(attrs.Updated),
+	}
+
+	if attrs.Encryption != nil {
+		bucket.DefaultKmsKeyName = attrs.Encryption.DefaultKMSKeyName
+	}
+
+	if attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		bucket.PublicAccessPrevention = attrs.PublicAccessPrevention.String()
+	}
+
+	return bucket
+}
+
+// fromBucket converts a StorageBucket to the attributes of a bucket.
+func fromBucket(bucket *storagev1.StorageBucket) (*storage.BucketAttrs, error) {
+	pap, err := fromPublicAccessPrevention(bucket.GetPublicAccessPrevention())
+	if err != nil {
+		return nil, err
+	}
+
+	attrs := &storage.BucketAttrs{
+		Name:                   bucket.GetName(),
+		ProjectNumber:          bucket.GetProjectNumber(),
+		Location:               bucket.GetLocation(),
+		LocationType:           bucket.GetLocationType(),
+		StorageClass:           bucket.GetStorageClass(),
+		Labels:                 bucket.GetLabels(),
+		VersioningEnabled:      bucket.GetVersioningEnabled(),
+		RequesterPays:          bucket.GetRequesterPays(),
+		DefaultEventBasedHold:  bucket.GetDefaultEventBasedHold(),
+		RetentionPolicy:        fromRetentionPolicy(bucket.GetRetentionPolicy()),
+		PublicAccessPrevention: pap,
+		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{
+			Enabled: bucket.GetUniformBucketLevelAccess(),
+		},
+		MetaGeneration: bucket.GetMetageneration(),
+		Etag:           bucket.GetEtag(),
+		Created:        fromTimestamp(bucket.GetTimeCreated()),
+		Updated:        fromTimestamp(bucket.GetUpdated()),
+	}
+
+	if v := bucket.GetDefaultKmsKeyName(); v != "" {
+		attrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: v}
+	}
+
+	return attrs, nil
+}
+
+func toRetentionPolicy(policy *storage.RetentionPolicy) *storagev1.StorageBucket_RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &storagev1.StorageBucket_RetentionPolicy{
+		RetentionPeriod: durationpb.New(policy.RetentionPeriod),
+		EffectiveTime:   toTimestamp(policy.EffectiveTime),
+		IsLocked:        policy.IsLocked,
+	}
+}
+
+func fromRetentionPolicy(policy *storagev1.StorageBucket_RetentionPolicy) *storage.RetentionPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &storage.RetentionPolicy{
+		RetentionPeriod: policy.GetRetentionPeriod().AsDuration(),
+		EffectiveTime:   fromTimestamp(policy.GetEffectiveTime()),
+		IsLocked:        policy.GetIsLocked(),
+	}
+}
+
+func fromPublicAccessPrevention(v string) (storage.PublicAccessPrevention, error) {
+	switch v {
+	case "":
+		return storage.PublicAccessPreventionUnknown, nil
+	case storage.PublicAccessPreventionInherited.String():
+		return storage.PublicAccessPreventionInherited, nil
+	case storage.PublicAccessPreventionEnforced.String():
+		return storage.PublicAccessPreventionEnforced, nil
+	default:
+		return storage.PublicAccessPreventionUnknown, fmt.Errorf("storage: invalid public access prevention %q", v)
+	}
+}
+
+// toBucketUpdate converts the updated properties of a bucket to a
+// StorageBucket and the field mask of the properties.
+func toBucketUpdate(attrs *storage.BucketAttrsToUpdate) (*storagev1.StorageBucket, *fieldmaskpb.FieldMask, error) {
+	if attrs.BucketPolicyOnly != nil || attrs.CORS != nil || attrs.Lifecycle != nil || attrs.Logging != nil ||
+		attrs.Website != nil || attrs.PredefinedACL != "" || attrs.PredefinedDefaultObjectACL != "" ||
+		attrs.RPO != storage.RPOUnknown || attrs.Autoclass != nil || attrs.SoftDeletePolicy != nil ||
+		attrs.GoogleManagedEncryptionEnforcementConfig != nil ||
+		attrs.CustomerManagedEncryptionEnforcementConfig != nil ||
+		attrs.CustomerSuppliedEncryptionEnforcementConfig != nil {
+		return nil, nil, ErrUnsupported
+	}
+
+	var (
+		bucket = &storagev1.StorageBucket{}
+		mask   = &fieldmaskpb.FieldMask{}
+	)
+
+	if attrs.VersioningEnabled != nil {
+		bucket.VersioningEnabled = attrs.VersioningEnabled.(bool)
+		mask.Paths = append(mask.Paths, "versioning_enabled")
+	}
+
+	if attrs.RequesterPays != nil {
+		bucket.RequesterPays = attrs.RequesterPays.(bool)
+		mask.Paths = append(mask.Paths, "requester_pays")
+	}
+
+	if attrs.DefaultEventBasedHold != nil {
+		bucket.DefaultEventBasedHold = attrs.DefaultEventBasedHold.(bool)
+		mask.Paths = append(mask.Paths, "default_event_based_hold")
+	}
+
+	if attrs.UniformBucketLevelAccess != nil {
+		bucket.UniformBucketLevelAccess = attrs.UniformBucketLevelAccess.Enabled
+		mask.Paths = append(mask.Paths, "uniform_bucket_level_access")
+	}
+
+	if attrs.PublicAccessPrevention != storage.PublicAccessPreventionUnknown {
+		bucket.PublicAccessPrevention = attrs.PublicAccessPrevention.String()
+		mask.Paths = append(mask.Paths, "public_access_prevention")
+	}
+
+	if attrs.StorageClass != "" {
+		bucket.StorageClass = attrs.StorageClass
+		mask.Paths = append(mask.Paths, "storage_class")
+	}
+
+	if attrs.RetentionPolicy != nil {
+		// a zero retention period removes the policy
+		if attrs.RetentionPolicy.RetentionPeriod > 0 {
+			bucket.RetentionPolicy = toRetentionPolicy(attrs.RetentionPolicy)
+		}
+
+		mask.Paths = append(mask.Paths, "retention_policy")
+	}
+
+	if attrs.Encryption != nil {
+		bucket.DefaultKmsKeyName = attrs.Encryption.DefaultKMSKeyName
+		mask.Paths = append(mask.Paths, "default_kms_key_name")
+	}
+
+	set, unset, err := storagev1.StorageBucketLabelsToUpdate(attrs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// labels with an empty value are removed
+	if len(set) > 0 || len(unset) > 0 {
+		bucket.Labels = set
+		for _, k := range unset {
+			bucket.Labels[k] = ""
+		}
+
+		mask.Paths = append(mask.Paths, "labels")
+	}
+
+	return bucket, mask, nil
+}
+
+// fromBucketUpdate converts a StorageBucket and a field mask to the updated
+// properties of a bucket.
+func fromBucketUpdate(bucket *storagev1.StorageBucket, mask *fieldmaskpb.FieldMask) (*storage.BucketAttrsToUpdate, error) {
+	attrs := &storage.BucketAttrsToUpdate{}
+
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "versioning_enabled":
+			attrs.VersioningEnabled = bucket.GetVersioningEnabled()
+		case "requester_pays":
+			attrs.RequesterPays = bucket.GetRequesterPays()
+		case "default_event_based_hold":
+			attrs.DefaultEventBasedHold = bucket.GetDefaultEventBasedHold()
+		case "uniform_bucket_level_access":
+			attrs.UniformBucketLevelAccess = &storage.UniformBucketLevelAccess{
+				Enabled: bucket.GetUniformBucketLevelAccess(),
+			}
+		case "public_access_prevention":
+			pap, err := fromPublicAccessPrevention(bucket.GetPublicAccessPrevention())
+			if err != nil {
+				return nil, err
+			}
+
+			attrs.PublicAccessPrevention = pap
+		case "storage_class":
+			attrs.StorageClass = bucket.GetStorageClass()
+		case "retention_policy":
+			attrs.RetentionPolicy = &storage.RetentionPolicy{}
+			// an unset policy removes the policy
+			if policy := bucket.GetRetentionPolicy(); policy != nil {
+				attrs.RetentionPolicy.RetentionPeriod = policy.GetRetentionPeriod().AsDuration()
+			}
+		case "default_kms_key_name":
+			attrs.Encryption = &storage.BucketEncryption{
+				DefaultKMSKeyName: bucket.GetDefaultKmsKeyName(),
+			}
+		case "labels":
+			for k, v := range bucket.GetLabels() {
+				if v == "" {
+					attrs.DeleteLabel(k)
+				} else {
+					attrs.SetLabel(k, v)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("storage: field %q cannot be updated", path)
+		}
+	}
+
+	return attrs, nil
+}
+
+// toConditions converts object preconditions to their optional request
+// fields. A generation match of zero means that the object must not exist.
+func toConditions(cond *storage.Conditions) (genMatch, genNotMatch, metaMatch, metaNotMatch *int64) {
+	if cond == nil {
+		return
+	}
+
+	if cond.DoesNotExist {
+		genMatch = new(int64)
+	}
+
+	if cond.GenerationMatch != 0 {
+		genMatch = &cond.GenerationMatch
+	}
+
+	if cond.GenerationNotMatch != 0 {
+		genNotMatch = &cond.GenerationNotMatch
+	}
+
+	if cond.MetagenerationMatch != 0 {
+		metaMatch = &cond.MetagenerationMatch
+	}
+
+	if cond.MetagenerationNotMatch != 0 {
+		metaNotMatch = &cond.MetagenerationNotMatch
+	}
+
+	return
+}
+
+// fromConditions converts optional request fields to object preconditions.
+// It returns nil when no precondition is set.
+func fromConditions(genMatch, genNotMatch, metaMatch, metaNotMatch *int64) *storage.Conditions {
+	cond := &storage.Conditions{}
+
+	if genMatch != nil {
+		if *genMatch == 0 {
+			cond.DoesNotExist = true
+		} else {
+			cond.GenerationMatch = *genMatch
+		}
+	}
+
+	if genNotMatch != nil {
+		cond.GenerationNotMatch = *genNotMatch
+	}
+
+	if metaMatch != nil {
+		cond.MetagenerationMatch = *metaMatch
+	}
+
+	if metaNotMatch != nil {
+		cond.MetagenerationNotMatch = *metaNotMatch
+	}
+
+	if *cond == (storage.Conditions{}) {
+		return nil
+	}
+
+	return cond
+}
+
+// toBucketConditions converts bucket preconditions to their optional request
+// fields.
+func toBucketConditions(cond *storage.BucketConditions) (metaMatch, metaNotMatch *int64) {
+	if cond == nil {
+		return
+	}
+
+	if cond.MetagenerationMatch != 0 {
+		metaMatch = &cond.MetagenerationMatch
+	}
+
+	if cond.MetagenerationNotMatch != 0 {
+		metaNotMatch = &cond.MetagenerationNotMatch
+	}
+
+	return
+}
+
+// fromBucketConditions converts optional request fields to bucket
+// preconditions. It returns nil when no precondition is set.
+func fromBucketConditions(metaMatch, metaNotMatch *int64) *storage.BucketConditions {
+	cond := &storage.BucketConditions{}
+
+	if metaMatch != nil {
+		cond.MetagenerationMatch = *metaMatch
+	}
+
+	if metaNotMatch != nil {
+		cond.MetagenerationNotMatch = *metaNotMatch
+	}
+
+	if *cond == (storage.BucketConditions{}) {
+		return nil
+	}
+
+	return cond
+}
+
+// codes maps the HTTP status codes of the storage service to connect codes.
+var codes = map[int]connect.Code{
+	http.StatusBadRequest:                   connect.CodeInvalidArgument,
+	http.StatusUnauthorized:                 connect.CodeUnauthenticated,
+	http.StatusForbidden:                    connect.CodePermissionDenied,
+	http.StatusNotFound:                     connect.CodeNotFound,
+	http.StatusConflict:                     connect.CodeAlreadyExists,
+	http.StatusPreconditionFailed:           connect.CodeFailedPrecondition,
+	http.StatusRequestedRangeNotSatisfiable: connect.CodeOutOfRange,
+	http.StatusTooManyRequests:              connect.CodeResourceExhausted,
+	499:                                     connect.CodeCanceled,
+	http.StatusInternalServerError:          connect.CodeInternal,
+	http.StatusNotImplemented:               connect.CodeUnimplemented,
+	http.StatusBadGateway:                   connect.CodeUnavailable,
+	http.StatusServiceUnavailable:           connect.CodeUnavailable,
+	http.StatusGatewayTimeout:               connect.CodeDeadlineExceeded,
+}
+
+// statuses maps connect codes to the HTTP status codes of the storage service.
+var statuses = map[connect.Code]int{
+	connect.CodeInvalidArgument:    http.StatusBadRequest,
+	connect.CodeUnauthenticated:    http.StatusUnauthorized,
+	connect.CodePermissionDenied:   http.StatusForbidden,
+	connect.CodeNotFound:           http.StatusNotFound,
+	connect.CodeAlreadyExists:      http.StatusConflict,
+	connect.CodeAborted:            http.StatusConflict,
+	connect.CodeFailedPrecondition: http.StatusPreconditionFailed,
+	connect.CodeOutOfRange:         http.StatusRequestedRangeNotSatisfiable,
+	connect.CodeResourceExhausted:  http.StatusTooManyRequests,
+	connect.CodeUnimplemented:      http.StatusNotImplemented,
+	connect.CodeUnavailable:        http.StatusServiceUnavailable,
+}
+
+// toConnectError converts an error of a storagev1.StorageServiceClient to a
+// connect error.
+func toConnectError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var (
+		cerr *connect.Error
+		gerr *googleapi.Error
+	)
+
+	switch {
+	case errors.As(err, &cerr):
+		return cerr
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	case errors.Is(err, storage.ErrBucketNotExist), errors.Is(err, storage.ErrObjectNotExist):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, ErrUnsupported):
+		return connect.NewError(connect.CodeUnimplemented, err)
+	case errors.As(err, &gerr):
+		code, ok := codes[gerr.Code]
+		if !ok {
+			code = connect.CodeUnknown
+			// classify the remaining server errors
+			if gerr.Code >= http.StatusInternalServerError {
+				code = connect.CodeInternal
+			}
+		}
+
+		message := gerr.Message
+		if message == "" {
+			message = http.StatusText(gerr.Code)
+		}
+
+		return connect.NewError(code, errors.New(message))
+	default:
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+}
+
+// fromConnectError converts a connect error to an error of a
+// storagev1.StorageServiceClient. The storage sentinel errors are restored,
+// the other errors are returned as a googleapi.Error that wraps the connect
+// error.
+func fromConnectError(err error) error {
+	var cerr *connect.Error
+	if !errors.As(err, &cerr) {
+		return err
+	}
+
+	switch cerr.Code() {
+	case connect.CodeCanceled:
+		return context.Canceled
+	case connect.CodeDeadlineExceeded:
+		return context.DeadlineExceeded
+	case connect.CodeNotFound:
+		switch cerr.Message() {
+		case storage.ErrBucketNotExist.Error():
+			return storage.ErrBucketNotExist
+		case storage.ErrObjectNotExist.Error():
+			return storage.ErrObjectNotExist
+		}
+	case connect.CodeUnimplemented:
+		if cerr.Message() == ErrUnsupported.Error() {
+			return ErrUnsupported
+		}
+	}
+
+	gerr := &googleapi.Error{
+		Code:    http.StatusInternalServerError,
+		Message: cerr.Message(),
+	}
+
+	if status, ok := statuses[cerr.Code()]; ok {
+		gerr.Code = status
+	}
+
+	gerr.Wrap(cerr)
+	// done!
+	return gerr
+}
@@ -0,0 +1,1145 @@
+// Package storagev1rpc serves the connect.storage.v1.StorageService on top of
+// any storagev1.StorageServiceClient, and implements a
+// storagev1.StorageServiceClient on top of the service, so that the storage
+// can be reached through a single gateway:
+//
+//	mux := http.NewServeMux()
+//	mux.Handle(storagev1connect.NewStorageServiceHandler(storagev1rpc.NewStorageServiceHandler(storagev1mem.NewStorageServiceClient())))
+//
+//	server := httptest.NewServer(mux)
+//	defer server.Close()
+//
+//	client := storagev1rpc.NewStorageServiceClient(storagev1connect.NewStorageServiceClient(http.DefaultClient, server.URL))
+package storagev1rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	storagev1connect "github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1connect"
+)
+
+// DefaultPageSize is the number of items a listing returns per page.
+const DefaultPageSize = 1000
+
+// MaxChunkSize is the maximum size of the content carried by a stream
+// message.
+const MaxChunkSize = 2 << 20
+
+// ErrUnsupported is returned for the operations that the storage service does
+// not provide.
+var ErrUnsupported = errors.New("storage: operation is not supported by the storage service")
+
+// ErrWriterClosed is returned when writing to a closed writer.
+var ErrWriterClosed = errors.New("storage: writer is closed")
+
+// ErrReaderClosed is returned when reading from a closed reader.
+var ErrReaderClosed = errors.New("storage: reader is closed")
+
+var _ storagev1.StorageServiceClient = &StorageServiceClient{}
+
+// StorageServiceClient represents a storage service client that calls the
+// connect StorageService.
+type StorageServiceClient struct {
+	client storagev1connect.StorageServiceClient
+}
+
+// NewStorageServiceClient creates a new StorageServiceClient that calls the
+// given connect client.
+func NewStorageServiceClient(client storagev1connect.StorageServiceClient) *StorageServiceClient {
+	return &StorageServiceClient{client: client}
+}
+
+// Bucket implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Bucket(name string) storagev1.StorageBucketHandle {
+	return &StorageBucketHandle{client: x.client, name: name}
+}
+
+// Buckets implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Buckets(ctx context.Context, projectID string) storagev1.StorageBucketIterator {
+	return &StorageBucketIterator{ctx: ctx, client: x.client, projectID: projectID, page: page{size: DefaultPageSize}}
+}
+
+// Close implements storagev1.StorageServiceClient.
+func (x *StorageServiceClient) Close() error {
+	return nil
+}
+
+var _ storagev1.StorageBucketHandle = &StorageBucketHandle{}
+
+// StorageBucketHandle represents a storage bucket handle of the connect
+// StorageService.
+type StorageBucketHandle struct {
+	client      storagev1connect.StorageServiceClient
+	name        string
+	conds       *storage.BucketConditions
+	userProject string
+}
+
+// ACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{}
+}
+
+// AddNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) AddNotification(ctx context.Context, n *storage.Notification) (*storage.Notification, error) {
+	return nil, ErrUnsupported
+}
+
+// Attrs implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Attrs(ctx context.Context) (*storage.BucketAttrs, error) {
+	request := &storagev1.GetBucketRequest{
+		Bucket:      x.name,
+		UserProject: x.userProject,
+	}
+
+	request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toBucketConditions(x.conds)
+	// fetch the bucket
+	response, err := x.client.GetBucket(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return fromBucket(response.Msg.Bucket)
+}
+
+// Create implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Create(ctx context.Context, projectID string, attrs *storage.BucketAttrs) error {
+	request := &storagev1.CreateBucketRequest{
+		Project:  projectID,
+		Bucket:   x.name,
+		Resource: toBucket(attrs),
+	}
+
+	_, err := x.client.CreateBucket(ctx, connect.NewRequest(request))
+	return fromConnectError(err)
+}
+
+// DefaultObjectACL implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DefaultObjectACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{}
+}
+
+// Delete implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Delete(ctx context.Context) error {
+	request := &storagev1.DeleteBucketRequest{
+		Bucket:      x.name,
+		UserProject: x.userProject,
+	}
+
+	request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toBucketConditions(x.conds)
+	// delete the bucket
+	_, err := x.client.DeleteBucket(ctx, connect.NewRequest(request))
+	return fromConnectError(err)
+}
+
+// DeleteNotification implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) DeleteNotification(ctx context.Context, id string) error {
+	return ErrUnsupported
+}
+
+// If implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) If(cond storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// LockRetentionPolicy implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) LockRetentionPolicy(ctx context.Context) error {
+	return ErrUnsupported
+}
+
+// Notifications implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Notifications(ctx context.Context) (map[string]*storage.Notification, error) {
+	return nil, ErrUnsupported
+}
+
+// Object implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Object(name string) storagev1.StorageObjectHandle {
+	return &StorageObjectHandle{client: x.client, bucket: x.name, object: name, userProject: x.userProject}
+}
+
+// Objects implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Objects(ctx context.Context, query *storage.Query) storagev1.StorageObjectIterator {
+	iter := &StorageObjectIterator{
+		ctx:         ctx,
+		client:      x.client,
+		bucket:      x.name,
+		userProject: x.userProject,
+		page:        page{size: DefaultPageSize},
+	}
+
+	if query != nil {
+		iter.query = *query
+	}
+
+	return iter
+}
+
+// Update implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) Update(ctx context.Context, attrs storage.BucketAttrsToUpdate) (*storage.BucketAttrs, error) {
+	bucket, mask, err := toBucketUpdate(&attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &storagev1.UpdateBucketRequest{
+		Bucket:      x.name,
+		Resource:    bucket,
+		UpdateMask:  mask,
+		UserProject: x.userProject,
+	}
+
+	request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toBucketConditions(x.conds)
+	// update the bucket
+	response, err := x.client.UpdateBucket(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return fromBucket(response.Msg.Bucket)
+}
+
+// UserProject implements storagev1.StorageBucketHandle.
+func (x *StorageBucketHandle) UserProject(projectID string) storagev1.StorageBucketHandle {
+	handle := *x
+	handle.userProject = projectID
+	// done!
+	return &handle
+}
+
+type page struct {
+	token string
+	size  int
+	items int
+	done  bool
+}
+
+var _ storagev1.StorageBucketIterator = &StorageBucketIterator{}
+
+// StorageBucketIterator represents a storage bucket iterator of the connect
+// StorageService.
+type StorageBucketIterator struct {
+	ctx       context.Context
+	client    storagev1connect.StorageServiceClient
+	projectID string
+	items     []*storage.BucketAttrs
+	page      page
+}
+
+// Next implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) Next() (*storage.BucketAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		if err := x.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageBucketIterator) fetch() error {
+	request := &storagev1.ListBucketsRequest{
+		Project:   x.projectID,
+		PageSize:  int32(x.page.size),
+		PageToken: x.page.token,
+	}
+
+	response, err := x.client.ListBuckets(x.ctx, connect.NewRequest(request))
+	if err != nil {
+		return fromConnectError(err)
+	}
+
+	for _, bucket := range response.Msg.Buckets {
+		attrs, err := fromBucket(bucket)
+		if err != nil {
+			return err
+		}
+
+		x.items = append(x.items, attrs)
+	}
+
+	x.page.token = response.Msg.NextPageToken
+	x.page.done = x.page.token == ""
+	x.page.items = len(x.items)
+	// done!
+	return nil
+}
+
+// PageInfo implements storagev1.StorageBucketIterator.
+func (x *StorageBucketIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+var _ storagev1.StorageACLHandle = &StorageACLHandle{}
+
+// StorageACLHandle represents a storage ACL handle of the connect
+// StorageService. The service does not manage access control lists, so every
+// operation returns ErrUnsupported.
+type StorageACLHandle struct{}
+
+// Delete implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Delete(ctx context.Context, entity storage.ACLEntity) error {
+	return ErrUnsupported
+}
+
+// List implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) List(ctx context.Context) ([]storage.ACLRule, error) {
+	return nil, ErrUnsupported
+}
+
+// Set implements storagev1.StorageACLHandle.
+func (x *StorageACLHandle) Set(ctx context.Context, entity storage.ACLEntity, role storage.ACLRole) error {
+	return ErrUnsupported
+}
+
+var _ storagev1.StorageObjectHandle = &StorageObjectHandle{}
+
+// StorageObjectHandle represents a storage object handle of the connect
+// StorageService.
+type StorageObjectHandle struct {
+	client      storagev1connect.StorageServiceClient
+	bucket      string
+	object      string
+	gen         int64
+	conds       *storage.Conditions
+	key         []byte
+	compressed  bool
+	userProject string
+}
+
+// ACL implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ACL() storagev1.StorageACLHandle {
+	return &StorageACLHandle{}
+}
+
+// Attrs implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Attrs(ctx context.Context) (*storage.ObjectAttrs, error) {
+	request := &storagev1.GetObjectRequest{
+		Bucket:        x.bucket,
+		Object:        x.object,
+		Generation:    x.gen,
+		EncryptionKey: x.key,
+		UserProject:   x.userProject,
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.conds)
+	// fetch the object
+	response, err := x.client.GetObject(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return storagev1.StorageObjectToAttrs(response.Msg.Object)
+}
+
+// ComposerFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ComposerFrom(handles ...storagev1.StorageObjectHandle) storagev1.StorageObjectComposer {
+	return &StorageObjectComposer{dst: x, srcs: handles}
+}
+
+// CopierFrom implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) CopierFrom(handle storagev1.StorageObjectHandle) storagev1.StorageObjectCopier {
+	return &StorageObjectCopier{dst: x, src: handle}
+}
+
+// Delete implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Delete(ctx context.Context) error {
+	request := &storagev1.DeleteObjectRequest{
+		Bucket:      x.bucket,
+		Object:      x.object,
+		Generation:  x.gen,
+		UserProject: x.userProject,
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.conds)
+	// delete the object
+	_, err := x.client.DeleteObject(ctx, connect.NewRequest(request))
+	return fromConnectError(err)
+}
+
+// Generation implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Generation(gen int64) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.gen = gen
+	// done!
+	return &handle
+}
+
+// If implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) If(cond storage.Conditions) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.conds = &cond
+	// done!
+	return &handle
+}
+
+// Key implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Key(data []byte) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.key = append([]byte(nil), data...)
+	// done!
+	return &handle
+}
+
+// NewRangeReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewRangeReader(ctx context.Context, offset int64, length int64) (storagev1.StorageObjectReader, error) {
+	if offset < 0 && length >= 0 {
+		return nil, fmt.Errorf("storage: invalid offset %d < 0 requires negative length", offset)
+	}
+
+	// an empty range carries no content
+	if length == 0 {
+		attrs, err := x.Attrs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		reader := &StorageObjectReader{
+			size:            attrs.Size,
+			contentType:     attrs.ContentType,
+			contentEncoding: attrs.ContentEncoding,
+			cacheControl:    attrs.CacheControl,
+			generation:      attrs.Generation,
+		}
+
+		return reader, nil
+	}
+
+	request := &storagev1.ReadObjectRequest{
+		Bucket:         x.bucket,
+		Object:         x.object,
+		Generation:     x.gen,
+		ReadOffset:     offset,
+		ReadLimit:      max(length, 0),
+		EncryptionKey:  x.key,
+		ReadCompressed: x.compressed,
+		UserProject:    x.userProject,
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.conds)
+
+	ctx, cancel := context.WithCancel(ctx)
+	// open the stream
+	stream, err := x.client.ReadObject(ctx, connect.NewRequest(request))
+	if err != nil {
+		cancel()
+		return nil, fromConnectError(err)
+	}
+
+	// the first message carries the properties of the content
+	if !stream.Receive() {
+		err := stream.Err()
+		if err == nil {
+			err = io.ErrUnexpectedEOF
+		}
+
+		_ = stream.Close()
+		cancel()
+		return nil, fromConnectError(err)
+	}
+
+	response := stream.Msg()
+
+	reader := &StorageObjectReader{
+		cancel:          cancel,
+		stream:          stream,
+		data:            response.Data,
+		size:            response.Size,
+		remain:          response.Remain,
+		contentType:     response.ContentType,
+		contentEncoding: response.ContentEncoding,
+		cacheControl:    response.CacheControl,
+		// the responses do not carry the generation of an object that is not
+		// pinned
+		generation: x.gen,
+	}
+
+	return reader, nil
+}
+
+// NewReader implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewReader(ctx context.Context) (storagev1.StorageObjectReader, error) {
+	return x.NewRangeReader(ctx, 0, -1)
+}
+
+// NewWriter implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) NewWriter(ctx context.Context) storagev1.StorageObjectWriter {
+	writer := &StorageObjectWriter{
+		ctx:    ctx,
+		handle: x,
+		attrs: storage.ObjectAttrs{
+			Bucket: x.bucket,
+			Name:   x.object,
+		},
+	}
+
+	return writer
+}
+
+// ReadCompressed implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) ReadCompressed(compressed bool) storagev1.StorageObjectHandle {
+	handle := *x
+	handle.compressed = compressed
+	// done!
+	return &handle
+}
+
+// Update implements storagev1.StorageObjectHandle.
+func (x *StorageObjectHandle) Update(ctx context.Context, attrs storage.ObjectAttrsToUpdate) (*storage.ObjectAttrs, error) {
+	object, mask, err := toObjectUpdate(&attrs)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &storagev1.UpdateObjectRequest{
+		Bucket:        x.bucket,
+		Object:        x.object,
+		Generation:    x.gen,
+		Resource:      object,
+		UpdateMask:    mask,
+		EncryptionKey: x.key,
+		UserProject:   x.userProject,
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.conds)
+	// update the object
+	response, err := x.client.UpdateObject(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return storagev1.StorageObjectToAttrs(response.Msg.Object)
+}
+
+var _ storagev1.StorageObjectIterator = &StorageObjectIterator{}
+
+// StorageObjectIterator represents a storage object iterator of the connect
+// StorageService. The objects and the prefixes of a page are returned in
+// lexicographic order.
+type StorageObjectIterator struct {
+	ctx         context.Context
+	client      storagev1connect.StorageServiceClient
+	bucket      string
+	userProject string
+	query       storage.Query
+	items       []*storage.ObjectAttrs
+	page        page
+}
+
+// Next implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) Next() (*storage.ObjectAttrs, error) {
+	if err := x.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(x.items) == 0 {
+		if x.page.done {
+			return nil, iterator.Done
+		}
+
+		if err := x.fetch(); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(x.items) == 0 {
+		return nil, iterator.Done
+	}
+
+	item := x.items[0]
+	// move to the next item
+	x.items = x.items[1:]
+	x.page.items = len(x.items)
+
+	return item, nil
+}
+
+func (x *StorageObjectIterator) fetch() error {
+	request := &storagev1.ListObjectsRequest{
+		Bucket:                   x.bucket,
+		Prefix:                   x.query.Prefix,
+		Delimiter:                x.query.Delimiter,
+		StartOffset:              x.query.StartOffset,
+		EndOffset:                x.query.EndOffset,
+		MatchGlob:                x.query.MatchGlob,
+		Versions:                 x.query.Versions,
+		IncludeTrailingDelimiter: x.query.IncludeTrailingDelimiter,
+		PageSize:                 int32(x.page.size),
+		PageToken:                x.page.token,
+		UserProject:              x.userProject,
+	}
+
+	response, err := x.client.ListObjects(x.ctx, connect.NewRequest(request))
+	if err != nil {
+		return fromConnectError(err)
+	}
+
+	var items []*storage.ObjectAttrs
+	// merge the objects and the prefixes
+	for _, object := range response.Msg.Objects {
+		attrs, err := storagev1.StorageObjectToAttrs(object)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, attrs)
+	}
+
+	for _, prefix := range response.Msg.Prefixes {
+		items = append(items, &storage.ObjectAttrs{Prefix: prefix})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return positionOf(items[i]).before(positionOf(items[j]))
+	})
+
+	x.items = append(x.items, items...)
+	x.page.token = response.Msg.NextPageToken
+	x.page.done = x.page.token == ""
+	x.page.items = len(x.items)
+	// done!
+	return nil
+}
+
+// PageInfo implements storagev1.StorageObjectIterator.
+func (x *StorageObjectIterator) PageInfo() storagev1.StoragePageInfo {
+	return &StoragePageInfo{page: &x.page}
+}
+
+var _ storagev1.StorageObjectReader = &StorageObjectReader{}
+
+// StorageObjectReader represents a storage object reader of the connect
+// StorageService. The content is received in chunks as it is read.
+type StorageObjectReader struct {
+	cancel          context.CancelFunc
+	stream          *connect.ServerStreamForClient[storagev1.ReadObjectResponse]
+	data            []byte
+	size            int64
+	remain          int64
+	contentType     string
+	contentEncoding string
+	cacheControl    string
+	generation      int64
+	closed          bool
+}
+
+// CacheControl implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) CacheControl() string {
+	return x.cacheControl
+}
+
+// Close implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Close() error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+	x.release()
+	// done!
+	return nil
+}
+
+// ContentEncoding implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentEncoding() string {
+	return x.contentEncoding
+}
+
+// ContentType implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) ContentType() string {
+	return x.contentType
+}
+
+// Generation returns the generation of the object, or zero when the handle
+// does not pin one.
+func (x *StorageObjectReader) Generation() int64 {
+	return x.generation
+}
+
+// Read implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Read(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrReaderClosed
+	}
+
+	for len(x.data) == 0 {
+		if x.stream == nil {
+			return 0, io.EOF
+		}
+
+		if !x.stream.Receive() {
+			err := x.stream.Err()
+			// release the stream
+			x.release()
+
+			if err != nil {
+				return 0, fromConnectError(err)
+			}
+
+			return 0, io.EOF
+		}
+
+		x.data = x.stream.Msg().Data
+	}
+
+	n := copy(p, x.data)
+	// move to the next byte
+	x.data = x.data[n:]
+	x.remain -= int64(n)
+
+	return n, nil
+}
+
+func (x *StorageObjectReader) release() {
+	if x.stream != nil {
+		_ = x.stream.Close()
+		x.stream = nil
+	}
+
+	if x.cancel != nil {
+		x.cancel()
+		x.cancel = nil
+	}
+}
+
+// Remain implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Remain() int64 {
+	return x.remain
+}
+
+// Size implements storagev1.StorageObjectReader.
+func (x *StorageObjectReader) Size() int64 {
+	return x.size
+}
+
+var _ storagev1.StorageObjectWriter = &StorageObjectWriter{}
+
+// StorageObjectWriter represents a storage object writer of the connect
+// StorageService. The content is sent in chunks as it is written and the
+// object is committed when the writer is closed.
+type StorageObjectWriter struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	handle    *StorageObjectHandle
+	attrs     storage.ObjectAttrs
+	stream    *connect.ClientStreamForClient[storagev1.WriteObjectRequest, storagev1.WriteObjectResponse]
+	chunkSize int
+	progress  func(int64)
+	crc32c    bool
+	written   int64
+	result    *storage.ObjectAttrs
+	closed    bool
+	err       error
+}
+
+// Attrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Attrs() *storage.ObjectAttrs {
+	return x.result
+}
+
+// Close implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Close() error {
+	if x.closed {
+		return x.err
+	}
+
+	x.closed = true
+	// commit the object
+	x.result, x.err = x.commit()
+	// done!
+	return x.err
+}
+
+func (x *StorageObjectWriter) commit() (*storage.ObjectAttrs, error) {
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	if err := x.open(); err != nil {
+		return nil, err
+	}
+
+	defer x.cancel()
+
+	response, err := x.stream.CloseAndReceive()
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return storagev1.StorageObjectToAttrs(response.Msg.Object)
+}
+
+// open opens the stream and sends the upload description.
+func (x *StorageObjectWriter) open() error {
+	if x.stream != nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(x.ctx)
+	// prepare the stream
+	x.cancel = cancel
+	x.stream = x.handle.client.WriteObject(ctx)
+
+	spec := &storagev1.WriteObjectSpec{
+		Bucket:        x.handle.bucket,
+		Object:        x.handle.object,
+		Resource:      storagev1.StorageObjectFromAttrs(&x.attrs),
+		EncryptionKey: x.handle.key,
+		UserProject:   x.handle.userProject,
+	}
+
+	spec.IfGenerationMatch, spec.IfGenerationNotMatch, spec.IfMetagenerationMatch, spec.IfMetagenerationNotMatch = toConditions(x.handle.conds)
+	// the checksum is verified only when it is set explicitly
+	if x.crc32c {
+		spec.Resource.Crc32C = storagev1.EncodeCRC32C(x.attrs.CRC32C)
+	} else {
+		spec.Resource.Crc32C = ""
+	}
+
+	return x.send(&storagev1.WriteObjectRequest{Spec: spec})
+}
+
+func (x *StorageObjectWriter) send(request *storagev1.WriteObjectRequest) error {
+	err := x.stream.Send(request)
+	// the error of a closed stream is reported by the response
+	if errors.Is(err, io.EOF) {
+		_, err = x.stream.CloseAndReceive()
+	}
+
+	if err != nil {
+		x.cancel()
+		return fromConnectError(err)
+	}
+
+	return nil
+}
+
+// CloseWithError implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) CloseWithError(err error) error {
+	if x.closed {
+		return nil
+	}
+
+	x.closed = true
+	x.err = err
+	// abort the upload
+	if x.stream != nil {
+		x.cancel()
+		_, _ = x.stream.CloseAndReceive()
+	}
+
+	return nil
+}
+
+// ObjectAttrs implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// SetCRC32C implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetCRC32C(v uint32) {
+	x.attrs.CRC32C = v
+	x.crc32c = true
+}
+
+// SetChunkSize implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetChunkSize(v int) {
+	x.chunkSize = v
+}
+
+// SetContentType implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetContentType(v string) {
+	x.attrs.ContentType = v
+}
+
+// SetProgressFunc implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) SetProgressFunc(fn func(int64)) {
+	x.progress = fn
+}
+
+// Write implements storagev1.StorageObjectWriter.
+func (x *StorageObjectWriter) Write(p []byte) (int, error) {
+	if x.closed {
+		return 0, ErrWriterClosed
+	}
+
+	if x.err != nil {
+		return 0, x.err
+	}
+
+	if err := x.open(); err != nil {
+		x.err = err
+		return 0, err
+	}
+
+	size := MaxChunkSize
+	if x.chunkSize > 0 && x.chunkSize < size {
+		size = x.chunkSize
+	}
+
+	var count int
+	// send the content in chunks
+	for len(p) > 0 {
+		n := min(len(p), size)
+
+		if err := x.send(&storagev1.WriteObjectRequest{Data: p[:n]}); err != nil {
+			x.err = err
+			return count, err
+		}
+
+		p = p[n:]
+		count += n
+		x.written += int64(n)
+
+		if x.progress != nil {
+			x.progress(x.written)
+		}
+	}
+
+	return count, nil
+}
+
+var _ storagev1.StorageObjectCopier = &StorageObjectCopier{}
+
+// StorageObjectCopier represents a storage object copier of the connect
+// StorageService.
+type StorageObjectCopier struct {
+	dst      *StorageObjectHandle
+	src      storagev1.StorageObjectHandle
+	attrs    storage.ObjectAttrs
+	token    string
+	kms      string
+	progress func(uint64, uint64)
+}
+
+// ObjectAttrs implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	src, ok := x.src.(*StorageObjectHandle)
+	// copy between services by streaming
+	if !ok || src.client != x.dst.client {
+		return x.stream(ctx)
+	}
+
+	request := &storagev1.CopyObjectRequest{
+		DestinationBucket:     x.dst.bucket,
+		DestinationObject:     x.dst.object,
+		DestinationKmsKeyName: x.kms,
+		SourceBucket:          src.bucket,
+		SourceObject:          src.object,
+		SourceGeneration:      src.gen,
+		EncryptionKey:         x.dst.key,
+		SourceEncryptionKey:   src.key,
+		RewriteToken:          x.token,
+		UserProject:           x.dst.userProject,
+	}
+
+	if !isZeroWritableAttrs(&x.attrs) {
+		request.Destination = storagev1.StorageObjectFromAttrs(&x.attrs)
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.dst.conds)
+	request.IfSourceGenerationMatch, request.IfSourceGenerationNotMatch, request.IfSourceMetagenerationMatch, request.IfSourceMetagenerationNotMatch = toConditions(src.conds)
+	// copy the object
+	response, err := x.dst.client.CopyObject(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	result, err := storagev1.StorageObjectToAttrs(response.Msg.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	if x.progress != nil {
+		x.progress(uint64(result.Size), uint64(result.Size))
+	}
+
+	return result, nil
+}
+
+func (x *StorageObjectCopier) stream(ctx context.Context) (*storage.ObjectAttrs, error) {
+	attrs, err := x.src.Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := x.src.Generation(attrs.Generation).ReadCompressed(true).NewReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	writer := x.dst.NewWriter(ctx)
+	// inherit the properties of the source object
+	setWritableAttrs(writer.ObjectAttrs(), &storage.ObjectAttrs{
+		ContentType:        attrs.ContentType,
+		ContentLanguage:    attrs.ContentLanguage,
+		ContentEncoding:    attrs.ContentEncoding,
+		ContentDisposition: attrs.ContentDisposition,
+		CacheControl:       attrs.CacheControl,
+		Metadata:           attrs.Metadata,
+		StorageClass:       attrs.StorageClass,
+		KMSKeyName:         x.kms,
+	})
+
+	if !isZeroWritableAttrs(&x.attrs) {
+		setWritableAttrs(writer.ObjectAttrs(), &x.attrs)
+	}
+
+	if _, err := io.Copy(writer, reader); err != nil {
+		_ = writer.CloseWithError(err)
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	result := writer.Attrs()
+	// report the progress
+	if x.progress != nil {
+		x.progress(uint64(result.Size), uint64(result.Size))
+	}
+
+	return result, nil
+}
+
+// SetDestinationKMSKeyName implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetDestinationKMSKeyName(name string) {
+	x.kms = name
+}
+
+// SetProgressFunc implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetProgressFunc(fn func(uint64, uint64)) {
+	x.progress = fn
+}
+
+// SetRewriteToken implements storagev1.StorageObjectCopier.
+func (x *StorageObjectCopier) SetRewriteToken(token string) {
+	x.token = token
+}
+
+var _ storagev1.StorageObjectComposer = &StorageObjectComposer{}
+
+// StorageObjectComposer represents a storage object composer of the connect
+// StorageService.
+type StorageObjectComposer struct {
+	dst   *StorageObjectHandle
+	srcs  []storagev1.StorageObjectHandle
+	attrs storage.ObjectAttrs
+}
+
+// ObjectAttrs implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) ObjectAttrs() *storage.ObjectAttrs {
+	return &x.attrs
+}
+
+// Run implements storagev1.StorageObjectComposer.
+func (x *StorageObjectComposer) Run(ctx context.Context) (*storage.ObjectAttrs, error) {
+	request := &storagev1.ComposeObjectRequest{
+		DestinationBucket: x.dst.bucket,
+		DestinationObject: x.dst.object,
+		EncryptionKey:     x.dst.key,
+		UserProject:       x.dst.userProject,
+	}
+
+	if !isZeroWritableAttrs(&x.attrs) {
+		request.Destination = storagev1.StorageObjectFromAttrs(&x.attrs)
+	}
+
+	for _, item := range x.srcs {
+		src, ok := item.(*StorageObjectHandle)
+		if !ok || src.bucket != x.dst.bucket {
+			return nil, fmt.Errorf("storage: all source objects must be in bucket %q", x.dst.bucket)
+		}
+
+		if src.conds != nil && (src.conds.GenerationNotMatch != 0 || src.conds.DoesNotExist || src.conds.MetagenerationMatch != 0 || src.conds.MetagenerationNotMatch != 0) {
+			return nil, errors.New("storage: compose: only GenerationMatch is allowed for source conditions")
+		}
+
+		source := &storagev1.ComposeObjectRequest_SourceObject{
+			Name:       src.object,
+			Generation: src.gen,
+		}
+
+		if src.conds != nil && src.conds.GenerationMatch != 0 {
+			source.IfGenerationMatch = &src.conds.GenerationMatch
+		}
+
+		request.SourceObjects = append(request.SourceObjects, source)
+	}
+
+	request.IfGenerationMatch, request.IfGenerationNotMatch, request.IfMetagenerationMatch, request.IfMetagenerationNotMatch = toConditions(x.dst.conds)
+	// compose the object
+	response, err := x.dst.client.ComposeObject(ctx, connect.NewRequest(request))
+	if err != nil {
+		return nil, fromConnectError(err)
+	}
+
+	return storagev1.StorageObjectToAttrs(response.Msg.Object)
+}
+
+var _ storagev1.StoragePageInfo = &StoragePageInfo{}
+
+// StoragePageInfo represents a storage page info of the connect
+// StorageService.
+type StoragePageInfo struct {
+	page *page
+}
+
+// MaxSize implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) MaxSize() int {
+	return x.page.size
+}
+
+// Remaining implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Remaining() int {
+	return x.page.items
+}
+
+// Token implements storagev1.StoragePageInfo.
+func (x *StoragePageInfo) Token() string {
+	return x.page.token
+}
+
+// isZeroWritableAttrs reports whether none of the writable properties of
+// attrs is set.
+func isZeroWritableAttrs(attrs *storage.ObjectAttrs) bool {
+	return attrs.ContentType == "" && attrs.ContentLanguage == "" && attrs.ContentEncoding == "" &&
+		attrs.ContentDisposition == "" && attrs.CacheControl == "" && attrs.Metadata == nil &&
+		attrs.StorageClass == "" && attrs.KMSKeyName == "" && !attrs.TemporaryHold && !attrs.EventBasedHold &&
+		attrs.CRC32C == 0 && len(attrs.MD5) == 0
+}
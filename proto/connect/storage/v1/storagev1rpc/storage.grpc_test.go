@@ -0,0 +1,150 @@
+package storagev1rpc_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1connect"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1rpc"
+)
+
+func newClient(t *testing.T) storagev1.StorageServiceClient {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.Handle(storagev1connect.NewStorageServiceHandler(storagev1rpc.NewStorageServiceHandler(storagev1mem.NewStorageServiceClient())))
+
+	server := httptest.NewUnstartedServer(mux)
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	return storagev1rpc.NewStorageServiceClient(storagev1connect.NewStorageServiceClient(server.Client(), server.URL))
+}
+
+func TestStorageServiceClient(t *testing.T) {
+	ctx := context.Background()
+	bucket := newClient(t).Bucket("bucket")
+
+	if err := bucket.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	for _, name := range []string{"a/1.txt", "a/2.txt", "b.txt"} {
+		writer := bucket.Object(name).NewWriter(ctx)
+		if _, err := io.WriteString(writer, "data of "+name); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+
+		if err := writer.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	}
+
+	reader, err := bucket.Object("a/2.txt").NewRangeReader(ctx, 0, 4)
+	if err != nil {
+		t.Fatalf("NewRangeReader: %v", err)
+	}
+
+	data, err := io.ReadAll(reader)
+	reader.Close()
+	if err != nil || string(data) != "data" {
+		t.Errorf("NewRangeReader(0, 4) = %q, %v", data, err)
+	}
+
+	var names []string
+
+	iter := bucket.Objects(ctx, &storage.Query{Delimiter: "/"})
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			t.Fatalf("Next: %v", err)
+		}
+
+		names = append(names, attrs.Name+attrs.Prefix)
+	}
+
+	if want := []string{"a/", "b.txt"}; !slices.Equal(names, want) {
+		t.Errorf("Objects = %v, want %v", names, want)
+	}
+
+	if _, err := bucket.Object("missing").Attrs(ctx); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Attrs(missing) = %v, want ErrObjectNotExist", err)
+	}
+
+	if err := bucket.Object("b.txt").Delete(ctx); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+}
+
+func TestStorageBucketHandleUpdateLabels(t *testing.T) {
+	ctx := context.Background()
+	bucket := newClient(t).Bucket("bucket")
+
+	if err := bucket.Create(ctx, "project", &storage.BucketAttrs{Labels: map[string]string{"team": "storage"}}); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	update := storage.BucketAttrsToUpdate{}
+	update.SetLabel("env", "prod")
+	update.DeleteLabel("team")
+
+	attrs, err := bucket.Update(ctx, update)
+	if err != nil {
+		t.Fatalf("Update: %v", err)
+	}
+
+	if want := map[string]string{"env": "prod"}; !maps.Equal(attrs.Labels, want) {
+		t.Errorf("Labels = %v, want %v", attrs.Labels, want)
+	}
+}
+
+func TestStorageObjectCopierServices(t *testing.T) {
+	ctx := context.Background()
+
+	source := newClient(t).Bucket("source")
+	if err := source.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	writer := source.Object("a").NewWriter(ctx)
+	io.WriteString(writer, "hello")
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// the destination service has no source bucket, so that the copy streams
+	destination := newClient(t).Bucket("destination")
+	if err := destination.Create(ctx, "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	if _, err := destination.Object("b").CopierFrom(source.Object("a")).Run(ctx); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	reader, err := destination.Object("b").NewReader(ctx)
+	if err != nil {
+		t.Fatalf("NewReader: %v", err)
+	}
+	defer reader.Close()
+
+	if data, _ := io.ReadAll(reader); string(data) != "hello" {
+		t.Errorf("copy = %q, want hello", data)
+	}
+}
@@ -0,0 +1,630 @@
+package storagev1rpc
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	storage "cloud.google.com/go/storage"
+	connect "connectrpc.com/connect"
+	iterator "google.golang.org/api/iterator"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	storagev1connect "github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1connect"
+)
+
+var _ storagev1connect.StorageServiceHandler = &StorageServiceHandler{}
+
+// StorageServiceHandler serves the connect StorageService on top of a
+// storagev1.StorageServiceClient.
+type StorageServiceHandler struct {
+	client storagev1.StorageServiceClient
+}
+
+// NewStorageServiceHandler creates a new StorageServiceHandler that serves
+// the given client.
+func NewStorageServiceHandler(client storagev1.StorageServiceClient) *StorageServiceHandler {
+	return &StorageServiceHandler{client: client}
+}
+
+// GetBucket implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) GetBucket(ctx context.Context, r *connect.Request[storagev1.GetBucketRequest]) (*connect.Response[storagev1.GetBucketResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	handle := x.bucket(r.Msg.Bucket, r.Msg.UserProject, fromBucketConditions(r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch))
+	// fetch the bucket
+	attrs, err := handle.Attrs(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.GetBucketResponse{Bucket: toBucket(attrs)}), nil
+}
+
+// ListBuckets implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) ListBuckets(ctx context.Context, r *connect.Request[storagev1.ListBucketsRequest]) (*connect.Response[storagev1.ListBucketsResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	size := int(r.Msg.PageSize)
+	if size == 0 {
+		size = DefaultPageSize
+	}
+
+	var (
+		response = &storagev1.ListBucketsResponse{}
+		iter     = x.client.Buckets(ctx, r.Msg.Project)
+	)
+
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+
+		// skip the buckets of the previous pages
+		if attrs.Name <= r.Msg.PageToken || !strings.HasPrefix(attrs.Name, r.Msg.Prefix) {
+			continue
+		}
+
+		if len(response.Buckets) == size {
+			response.NextPageToken = response.Buckets[size-1].Name
+			break
+		}
+
+		response.Buckets = append(response.Buckets, toBucket(attrs))
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// CreateBucket implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) CreateBucket(ctx context.Context, r *connect.Request[storagev1.CreateBucketRequest]) (*connect.Response[storagev1.CreateBucketResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	var attrs *storage.BucketAttrs
+	// the bucket properties are optional
+	if r.Msg.Resource != nil {
+		value, err := fromBucket(r.Msg.Resource)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		attrs = value
+	}
+
+	handle := x.client.Bucket(r.Msg.Bucket)
+	// create the bucket
+	if err := handle.Create(ctx, r.Msg.Project, attrs); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	result, err := handle.Attrs(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.CreateBucketResponse{Bucket: toBucket(result)}), nil
+}
+
+// UpdateBucket implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) UpdateBucket(ctx context.Context, r *connect.Request[storagev1.UpdateBucketRequest]) (*connect.Response[storagev1.UpdateBucketResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	attrs, err := fromBucketUpdate(r.Msg.Resource, r.Msg.UpdateMask)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	handle := x.bucket(r.Msg.Bucket, r.Msg.UserProject, fromBucketConditions(r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch))
+	// update the bucket
+	result, err := handle.Update(ctx, *attrs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.UpdateBucketResponse{Bucket: toBucket(result)}), nil
+}
+
+// DeleteBucket implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) DeleteBucket(ctx context.Context, r *connect.Request[storagev1.DeleteBucketRequest]) (*connect.Response[storagev1.DeleteBucketResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	handle := x.bucket(r.Msg.Bucket, r.Msg.UserProject, fromBucketConditions(r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch))
+	// delete the bucket
+	if err := handle.Delete(ctx); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.DeleteBucketResponse{}), nil
+}
+
+// GetObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) GetObject(ctx context.Context, r *connect.Request[storagev1.GetObjectRequest]) (*connect.Response[storagev1.GetObjectResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	handle := x.object(
+		x.bucket(r.Msg.Bucket, r.Msg.UserProject, nil).Object(r.Msg.Object),
+		r.Msg.Generation,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		r.Msg.EncryptionKey,
+	)
+
+	attrs, err := handle.Attrs(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.GetObjectResponse{Object: storagev1.StorageObjectFromAttrs(attrs)}), nil
+}
+
+// ListObjects implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) ListObjects(ctx context.Context, r *connect.Request[storagev1.ListObjectsRequest]) (*connect.Response[storagev1.ListObjectsResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	size := int(r.Msg.PageSize)
+	if size == 0 {
+		size = DefaultPageSize
+	}
+
+	query := &storage.Query{
+		Prefix:                   r.Msg.Prefix,
+		Delimiter:                r.Msg.Delimiter,
+		StartOffset:              r.Msg.StartOffset,
+		EndOffset:                r.Msg.EndOffset,
+		MatchGlob:                r.Msg.MatchGlob,
+		Versions:                 r.Msg.Versions,
+		IncludeTrailingDelimiter: r.Msg.IncludeTrailingDelimiter,
+	}
+
+	var cursor *position
+	// resume after the last item of the previous page
+	if r.Msg.PageToken != "" {
+		value, err := decodePageToken(r.Msg.PageToken)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		query.StartOffset = max(query.StartOffset, value.name)
+		cursor = value
+	}
+
+	var (
+		response = &storagev1.ListObjectsResponse{}
+		iter     = x.bucket(r.Msg.Bucket, r.Msg.UserProject, nil).Objects(ctx, query)
+		last     *position
+		count    int
+	)
+
+	for {
+		attrs, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+
+		if err != nil {
+			return nil, toConnectError(err)
+		}
+
+		item := positionOf(attrs)
+		// skip the items of the previous pages
+		if cursor != nil && !cursor.before(item) {
+			continue
+		}
+
+		if count == size {
+			response.NextPageToken = encodePageToken(last)
+			break
+		}
+
+		if attrs.Prefix != "" {
+			response.Prefixes = append(response.Prefixes, attrs.Prefix)
+		} else {
+			response.Objects = append(response.Objects, storagev1.StorageObjectFromAttrs(attrs))
+		}
+
+		last = item
+		count++
+	}
+
+	return connect.NewResponse(response), nil
+}
+
+// ReadObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) ReadObject(ctx context.Context, r *connect.Request[storagev1.ReadObjectRequest], stream *connect.ServerStream[storagev1.ReadObjectResponse]) error {
+	if err := validate(r.Msg); err != nil {
+		return err
+	}
+
+	if r.Msg.ReadOffset < 0 && r.Msg.ReadLimit > 0 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("storage: read limit cannot be set for a negative read offset"))
+	}
+
+	handle := x.object(
+		x.bucket(r.Msg.Bucket, r.Msg.UserProject, nil).Object(r.Msg.Object),
+		r.Msg.Generation,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		r.Msg.EncryptionKey,
+	).ReadCompressed(r.Msg.ReadCompressed)
+
+	length := r.Msg.ReadLimit
+	// a zero limit reads to the end of the object
+	if length == 0 {
+		length = -1
+	}
+
+	reader, err := handle.NewRangeReader(ctx, r.Msg.ReadOffset, length)
+	if err != nil {
+		return toConnectError(err)
+	}
+	defer reader.Close()
+
+	response := &storagev1.ReadObjectResponse{
+		Size:            reader.Size(),
+		Remain:          reader.Remain(),
+		ContentType:     reader.ContentType(),
+		ContentEncoding: reader.ContentEncoding(),
+		CacheControl:    reader.CacheControl(),
+	}
+
+	buffer := make([]byte, MaxChunkSize)
+	// the first message is sent even for empty content
+	for first := true; ; first = false {
+		n, err := io.ReadFull(reader, buffer)
+		if n > 0 || first {
+			response.Data = buffer[:n]
+			// send the chunk
+			if err := stream.Send(response); err != nil {
+				return err
+			}
+
+			response = &storagev1.ReadObjectResponse{}
+		}
+
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+
+		if err != nil {
+			return toConnectError(err)
+		}
+	}
+}
+
+// WriteObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) WriteObject(ctx context.Context, stream *connect.ClientStream[storagev1.WriteObjectRequest]) (*connect.Response[storagev1.WriteObjectResponse], error) {
+	if !stream.Receive() {
+		if err := stream.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("storage: missing write object spec"))
+	}
+
+	spec := stream.Msg().GetSpec()
+	if spec == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("storage: missing write object spec"))
+	}
+
+	if err := validate(spec); err != nil {
+		return nil, err
+	}
+
+	attrs := &storage.ObjectAttrs{}
+	// the object properties are optional
+	if spec.Resource != nil {
+		value, err := fromWritableObject(spec.Resource)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		attrs = value
+	}
+
+	handle := x.object(
+		x.bucket(spec.Bucket, spec.UserProject, nil).Object(spec.Object),
+		0,
+		fromConditions(spec.IfGenerationMatch, spec.IfGenerationNotMatch, spec.IfMetagenerationMatch, spec.IfMetagenerationNotMatch),
+		spec.EncryptionKey,
+	)
+
+	writer := handle.NewWriter(ctx)
+	// apply the object properties
+	setWritableAttrs(writer.ObjectAttrs(), attrs)
+
+	if spec.GetResource().GetCrc32C() != "" {
+		writer.SetCRC32C(attrs.CRC32C)
+	}
+
+	for data := stream.Msg().GetData(); ; data = stream.Msg().GetData() {
+		if len(data) > 0 {
+			if _, err := writer.Write(data); err != nil {
+				_ = writer.CloseWithError(err)
+				return nil, toConnectError(err)
+			}
+		}
+
+		if !stream.Receive() {
+			break
+		}
+
+		if stream.Msg().GetSpec() != nil {
+			err := errors.New("storage: write object spec must be sent in the first message only")
+			_ = writer.CloseWithError(err)
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	// an aborted upload must not commit the object
+	if err := stream.Err(); err != nil {
+		_ = writer.CloseWithError(err)
+		return nil, err
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.WriteObjectResponse{Object: storagev1.StorageObjectFromAttrs(writer.Attrs())}), nil
+}
+
+// UpdateObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) UpdateObject(ctx context.Context, r *connect.Request[storagev1.UpdateObjectRequest]) (*connect.Response[storagev1.UpdateObjectResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	attrs, err := fromObjectUpdate(r.Msg.Resource, r.Msg.UpdateMask)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	handle := x.object(
+		x.bucket(r.Msg.Bucket, r.Msg.UserProject, nil).Object(r.Msg.Object),
+		r.Msg.Generation,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		r.Msg.EncryptionKey,
+	)
+
+	result, err := handle.Update(ctx, *attrs)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.UpdateObjectResponse{Object: storagev1.StorageObjectFromAttrs(result)}), nil
+}
+
+// DeleteObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) DeleteObject(ctx context.Context, r *connect.Request[storagev1.DeleteObjectRequest]) (*connect.Response[storagev1.DeleteObjectResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	handle := x.object(
+		x.bucket(r.Msg.Bucket, r.Msg.UserProject, nil).Object(r.Msg.Object),
+		r.Msg.Generation,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		nil,
+	)
+
+	if err := handle.Delete(ctx); err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.DeleteObjectResponse{}), nil
+}
+
+// CopyObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) CopyObject(ctx context.Context, r *connect.Request[storagev1.CopyObjectRequest]) (*connect.Response[storagev1.CopyObjectResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	dst := x.object(
+		x.bucket(r.Msg.DestinationBucket, r.Msg.UserProject, nil).Object(r.Msg.DestinationObject),
+		0,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		r.Msg.EncryptionKey,
+	)
+
+	src := x.object(
+		x.bucket(r.Msg.SourceBucket, r.Msg.UserProject, nil).Object(r.Msg.SourceObject),
+		r.Msg.SourceGeneration,
+		fromConditions(r.Msg.IfSourceGenerationMatch, r.Msg.IfSourceGenerationNotMatch, r.Msg.IfSourceMetagenerationMatch, r.Msg.IfSourceMetagenerationNotMatch),
+		r.Msg.SourceEncryptionKey,
+	)
+
+	copier := dst.CopierFrom(src)
+	// the destination properties are optional
+	if r.Msg.Destination != nil {
+		attrs, err := fromWritableObject(r.Msg.Destination)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		setWritableAttrs(copier.ObjectAttrs(), attrs)
+	}
+
+	if r.Msg.DestinationKmsKeyName != "" {
+		copier.SetDestinationKMSKeyName(r.Msg.DestinationKmsKeyName)
+	}
+
+	if r.Msg.RewriteToken != "" {
+		copier.SetRewriteToken(r.Msg.RewriteToken)
+	}
+
+	result, err := copier.Run(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.CopyObjectResponse{Object: storagev1.StorageObjectFromAttrs(result)}), nil
+}
+
+// ComposeObject implements storagev1connect.StorageServiceHandler.
+func (x *StorageServiceHandler) ComposeObject(ctx context.Context, r *connect.Request[storagev1.ComposeObjectRequest]) (*connect.Response[storagev1.ComposeObjectResponse], error) {
+	if err := validate(r.Msg); err != nil {
+		return nil, err
+	}
+
+	bucket := x.bucket(r.Msg.DestinationBucket, r.Msg.UserProject, nil)
+	// the source objects share the destination bucket
+	dst := x.object(
+		bucket.Object(r.Msg.DestinationObject),
+		0,
+		fromConditions(r.Msg.IfGenerationMatch, r.Msg.IfGenerationNotMatch, r.Msg.IfMetagenerationMatch, r.Msg.IfMetagenerationNotMatch),
+		r.Msg.EncryptionKey,
+	)
+
+	var srcs []storagev1.StorageObjectHandle
+	// prepare the source objects
+	for _, item := range r.Msg.SourceObjects {
+		srcs = append(srcs, x.object(
+			bucket.Object(item.Name),
+			item.Generation,
+			fromConditions(item.IfGenerationMatch, nil, nil, nil),
+			nil,
+		))
+	}
+
+	composer := dst.ComposerFrom(srcs...)
+	// the destination properties are optional
+	if r.Msg.Destination != nil {
+		attrs, err := fromWritableObject(r.Msg.Destination)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+
+		setWritableAttrs(composer.ObjectAttrs(), attrs)
+	}
+
+	result, err := composer.Run(ctx)
+	if err != nil {
+		return nil, toConnectError(err)
+	}
+
+	return connect.NewResponse(&storagev1.ComposeObjectResponse{Object: storagev1.StorageObjectFromAttrs(result)}), nil
+}
+
+func (x *StorageServiceHandler) bucket(name, userProject string, cond *storage.BucketConditions) storagev1.StorageBucketHandle {
+	handle := x.client.Bucket(name)
+
+	if userProject != "" {
+		handle = handle.UserProject(userProject)
+	}
+
+	if cond != nil {
+		handle = handle.If(*cond)
+	}
+
+	return handle
+}
+
+func (x *StorageServiceHandler) object(handle storagev1.StorageObjectHandle, gen int64, cond *storage.Conditions, key []byte) storagev1.StorageObjectHandle {
+	if gen != 0 {
+		handle = handle.Generation(gen)
+	}
+
+	if cond != nil {
+		handle = handle.If(*cond)
+	}
+
+	if len(key) > 0 {
+		handle = handle.Key(key)
+	}
+
+	return handle
+}
+
+// validate validates a request message, reporting a violation as an invalid
+// argument.
+func validate(msg interface{ Validate() error }) error {
+	if err := msg.Validate(); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return nil
+}
+
+// setWritableAttrs copies the writable properties of src into dst.
+func setWritableAttrs(dst, src *storage.ObjectAttrs) {
+	dst.ContentType = src.ContentType
+	dst.ContentLanguage = src.ContentLanguage
+	dst.ContentEncoding = src.ContentEncoding
+	dst.ContentDisposition = src.ContentDisposition
+	dst.CacheControl = src.CacheControl
+	dst.Metadata = src.Metadata
+	dst.StorageClass = src.StorageClass
+	dst.KMSKeyName = src.KMSKeyName
+	dst.TemporaryHold = src.TemporaryHold
+	dst.EventBasedHold = src.EventBasedHold
+	dst.CRC32C = src.CRC32C
+	dst.MD5 = src.MD5
+}
+
+// position is the position of an object or a prefix in a listing. Prefixes
+// have a negative generation, so that they sort before the objects of the
+// same name.
+type position struct {
+	name       string
+	generation int64
+}
+
+func positionOf(attrs *storage.ObjectAttrs) *position {
+	if attrs.Prefix != "" {
+		return &position{name: attrs.Prefix, generation: -1}
+	}
+
+	return &position{name: attrs.Name, generation: attrs.Generation}
+}
+
+func (x *position) before(y *position) bool {
+	if x.name != y.name {
+		return x.name < y.name
+	}
+
+	return x.generation < y.generation
+}
+
+func encodePageToken(x *position) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(x.name + "\n" + strconv.FormatInt(x.generation, 10)))
+}
+
+func decodePageToken(token string) (*position, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New("storage: invalid page token")
+	}
+
+	index := strings.LastIndexByte(string(data), '\n')
+	if index < 0 {
+		return nil, errors.New("storage: invalid page token")
+	}
+
+	gen, err := strconv.ParseInt(string(data[index+1:]), 10, 64)
+	if err != nil {
+		return nil, errors.New("storage: invalid page token")
+	}
+
+	return &position{name: string(data[:index]), generation: gen}, nil
+}
@@ -0,0 +1,243 @@
+package storagev1sdk
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// CloudEventSpecVersion is the version of the CloudEvents specification that
+// the StorageEventCloudEventHandler accepts.
+const CloudEventSpecVersion = "1.0"
+
+// DefaultCloudEventMaxBodySize is the default size limit of the body of a
+// CloudEvent, which is the size limit of a Pub/Sub message.
+const DefaultCloudEventMaxBodySize = 10 << 20
+
+var _ http.Handler = &StorageEventCloudEventHandler{}
+
+// StorageEventCloudEventHandler represents a storage CloudEvents handler, such
+// as the target of an Eventarc trigger. It accepts the events in the binary
+// and in the structured HTTP content modes.
+type StorageEventCloudEventHandler struct {
+	// StorageObjectEventHandler is a storage object event handler.
+	StorageObjectEventHandler storagev1.StorageObjectEventHandler
+	// AcknowledgePermanentErrors acknowledges the events that fail with a
+	// permanent error, such as the malformed events, instead of failing them
+	// so that they are redelivered.
+	AcknowledgePermanentErrors bool
+	// MaxBodySize limits the size of the body of a request. Zero uses
+	// DefaultCloudEventMaxBodySize.
+	MaxBodySize int64
+}
+
+// ServeHTTP implements http.Handler.
+func (x *StorageEventCloudEventHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	size := x.MaxBodySize
+	if size <= 0 {
+		size = DefaultCloudEventMaxBodySize
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, size))
+	if err != nil {
+		// a body that is too large is too large on every delivery
+		if errors.As(err, new(*http.MaxBytesError)) {
+			x.reject(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	var ce *cloudEvent
+	// decode the event
+	switch mediaType(r.Header.Get("Content-Type")) {
+	case "application/cloudevents+json":
+		ce, err = decodeStructured(body)
+	default:
+		ce, err = decodeBinary(r.Header, body)
+	}
+
+	if err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(err))
+		return
+	}
+
+	if ce.ID == "" || ce.Source == "" {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(errors.New("missing id or source attribute")))
+		return
+	}
+
+	if ce.SpecVersion != CloudEventSpecVersion {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(fmt.Errorf("unsupported specversion %q", ce.SpecVersion)))
+		return
+	}
+
+	event, err := storagev1.NewStorageObjectCloudEvent(ce.Type)
+	if err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(fmt.Errorf("%w: %q", err, ce.Type)))
+		return
+	}
+
+	if kind := mediaType(ce.DataContentType); kind != "" && kind != "application/json" && !strings.HasSuffix(kind, "+json") {
+		x.reject(w, http.StatusUnsupportedMediaType, fmt.Errorf("unsupported datacontenttype %q", ce.DataContentType))
+		return
+	}
+
+	var eventTime *timestamppb.Timestamp
+	// the time attribute is optional
+	if ce.Time != "" {
+		t, err := time.Parse(time.RFC3339Nano, ce.Time)
+		if err != nil {
+			writeError(w, x.AcknowledgePermanentErrors, invalid(fmt.Errorf("invalid time %q: %w", ce.Time, err)))
+			return
+		}
+
+		eventTime = timestamppb.New(t)
+	}
+
+	resource := &storagev1.StorageObject{}
+	// unmarshal the object
+	if err := json.Unmarshal(ce.Data, resource); err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(err))
+		return
+	}
+
+	event.SetObject(resource)
+	event.SetEnvelope(&storagev1.StorageObjectEventEnvelope{
+		MessageId:        ce.ID,
+		EventType:        storagev1.StorageObjectEventTypeOf(event),
+		BucketId:         resource.Bucket,
+		ObjectId:         resource.Name,
+		ObjectGeneration: resource.Generation,
+		EventTime:        eventTime,
+		PayloadFormat:    storagev1.StoragePayloadFormatJSON,
+	})
+	// validate the event
+	if err := storagev1.ValidateStorageObjectEvent(event); err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, invalid(err))
+		return
+	}
+
+	ctx := r.Context()
+	// pass the distributed tracing extension
+	if ce.TraceParent != "" {
+		ctx = storagev1.WithStorageObjectEventAttributes(ctx, map[string]string{
+			"traceparent": ce.TraceParent,
+			"tracestate":  ce.TraceState,
+		})
+	}
+
+	// handle the event
+	if err := x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event); err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reject writes the response of a request that fails with a permanent error
+// of its own status code. The request is acknowledged when
+// AcknowledgePermanentErrors is set.
+func (x *StorageEventCloudEventHandler) reject(w http.ResponseWriter, status int, err error) {
+	if x.AcknowledgePermanentErrors {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	http.Error(w, err.Error(), status)
+}
+
+// cloudEvent represents the attributes and the data of a CloudEvent.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	Type            string          `json:"type"`
+	Source          string          `json:"source"`
+	ID              string          `json:"id"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      string          `json:"data_base64,omitempty"`
+	TraceParent     string          `json:"traceparent,omitempty"`
+	TraceState      string          `json:"tracestate,omitempty"`
+}
+
+// decodeStructured decodes a CloudEvent in the structured content mode, where
+// the body carries both the attributes and the data.
+func decodeStructured(body []byte) (*cloudEvent, error) {
+	ce := &cloudEvent{}
+	if err := json.Unmarshal(body, ce); err != nil {
+		return nil, err
+	}
+
+	if ce.DataBase64 != "" {
+		data, err := base64.StdEncoding.DecodeString(ce.DataBase64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid data_base64: %w", err)
+		}
+
+		ce.Data = data
+	}
+
+	if len(ce.Data) == 0 {
+		return nil, errors.New("missing data")
+	}
+
+	return ce, nil
+}
+
+// decodeBinary decodes a CloudEvent in the binary content mode, where the
+// headers carry the attributes and the body carries the data.
+func decodeBinary(header http.Header, body []byte) (*cloudEvent, error) {
+	ce := &cloudEvent{
+		SpecVersion:     header.Get("Ce-Specversion"),
+		Type:            header.Get("Ce-Type"),
+		Source:          header.Get("Ce-Source"),
+		ID:              header.Get("Ce-Id"),
+		Subject:         header.Get("Ce-Subject"),
+		Time:            header.Get("Ce-Time"),
+		DataContentType: header.Get("Content-Type"),
+		Data:            body,
+		TraceParent:     header.Get("Ce-Traceparent"),
+		TraceState:      header.Get("Ce-Tracestate"),
+	}
+
+	if ce.SpecVersion == "" {
+		return nil, errors.New("missing ce-specversion header")
+	}
+
+	if len(ce.Data) == 0 {
+		return nil, errors.New("missing data")
+	}
+
+	return ce, nil
+}
+
+// mediaType returns the media type of a content type without its parameters.
+func mediaType(v string) string {
+	kind, _, err := mime.ParseMediaType(v)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(v))
+	}
+
+	return kind
+}
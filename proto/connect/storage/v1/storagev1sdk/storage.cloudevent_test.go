@@ -0,0 +1,355 @@
+package storagev1sdk_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+const objectJSON = `{"bucket":"bucket","name":"a/b.txt","generation":"7","size":"5"}`
+
+// recorder is a storage object event handler that records the events and the
+// contexts that it handles, and fails with err.
+type recorder struct {
+	events []storagev1.StorageObjectEvent
+	ctx    context.Context
+	err    error
+}
+
+func (x *recorder) HandleStorageObjectEvent(ctx context.Context, event storagev1.StorageObjectEvent) error {
+	x.events = append(x.events, event)
+	x.ctx = ctx
+	return x.err
+}
+
+func newBinaryRequest(kind, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	r.Header.Set("Ce-Specversion", "1.0")
+	r.Header.Set("Ce-Type", kind)
+	r.Header.Set("Ce-Source", "//storage.googleapis.com/projects/_/buckets/bucket")
+	r.Header.Set("Ce-Id", "1234")
+	r.Header.Set("Ce-Time", "2026-01-02T03:04:05.5Z")
+	return r
+}
+
+func newStructuredRequest(body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/cloudevents+json; charset=utf-8")
+	return r
+}
+
+func TestStorageEventCloudEventHandlerBinary(t *testing.T) {
+	handler := &recorder{}
+	server := &storagev1sdk.StorageEventCloudEventHandler{StorageObjectEventHandler: handler}
+
+	r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+	r.Header.Set("Ce-Traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, r)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("ServeHTTP() status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+
+	if len(handler.events) != 1 {
+		t.Fatalf("HandleStorageObjectEvent calls = %d, want 1", len(handler.events))
+	}
+
+	event, ok := handler.events[0].(*storagev1.StorageObjectFinalizedEvent)
+	if !ok {
+		t.Fatalf("event = %T, want *storagev1.StorageObjectFinalizedEvent", handler.events[0])
+	}
+
+	if got := event.GetObject(); got.GetBucket() != "bucket" || got.GetName() != "a/b.txt" || got.GetGeneration() != 7 {
+		t.Errorf("event.Object = %v, want bucket/a/b.txt#7", got)
+	}
+
+	envelope := event.GetEnvelope()
+	if envelope.GetMessageId() != "1234" {
+		t.Errorf("envelope.MessageId = %q, want %q", envelope.GetMessageId(), "1234")
+	}
+
+	if envelope.GetEventType() != storagev1.StorageObjectEventType_OBJECT_FINALIZE {
+		t.Errorf("envelope.EventType = %v, want %v", envelope.GetEventType(), storagev1.StorageObjectEventType_OBJECT_FINALIZE)
+	}
+
+	if want := time.Date(2026, 1, 2, 3, 4, 5, 5e8, time.UTC); !envelope.GetEventTime().AsTime().Equal(want) {
+		t.Errorf("envelope.EventTime = %v, want %v", envelope.GetEventTime().AsTime(), want)
+	}
+
+	if envelope.GetPayloadFormat() != storagev1.StoragePayloadFormatJSON {
+		t.Errorf("envelope.PayloadFormat = %q, want %q", envelope.GetPayloadFormat(), storagev1.StoragePayloadFormatJSON)
+	}
+
+	if got := storagev1.StorageObjectEventAttributesFromContext(handler.ctx)["traceparent"]; got != r.Header.Get("Ce-Traceparent") {
+		t.Errorf("traceparent = %q, want %q", got, r.Header.Get("Ce-Traceparent"))
+	}
+}
+
+func TestStorageEventCloudEventHandlerStructured(t *testing.T) {
+	cases := []struct {
+		name string
+		body string
+	}{
+		{
+			name: "Data",
+			body: `{"specversion":"1.0","type":"google.cloud.storage.object.v1.deleted","source":"s","id":"1","data":` + objectJSON + `}`,
+		},
+		{
+			name: "DataBase64",
+			body: `{"specversion":"1.0","type":"google.cloud.storage.object.v1.deleted","source":"s","id":"1","data_base64":"` + base64.StdEncoding.EncodeToString([]byte(objectJSON)) + `"}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &recorder{}
+			server := &storagev1sdk.StorageEventCloudEventHandler{StorageObjectEventHandler: handler}
+
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, newStructuredRequest(tc.body))
+
+			if w.Code != http.StatusNoContent {
+				t.Fatalf("ServeHTTP() status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+			}
+
+			if len(handler.events) != 1 {
+				t.Fatalf("HandleStorageObjectEvent calls = %d, want 1", len(handler.events))
+			}
+
+			if _, ok := handler.events[0].(*storagev1.StorageObjectDeletedEvent); !ok {
+				t.Errorf("event = %T, want *storagev1.StorageObjectDeletedEvent", handler.events[0])
+			}
+
+			if got := handler.events[0].GetObject().GetName(); got != "a/b.txt" {
+				t.Errorf("event.Object.Name = %q, want %q", got, "a/b.txt")
+			}
+		})
+	}
+}
+
+func TestStorageEventCloudEventHandlerRejects(t *testing.T) {
+	cases := []struct {
+		name    string
+		request func() *http.Request
+		want    int
+	}{
+		{
+			name:    "Method",
+			request: func() *http.Request { return httptest.NewRequest(http.MethodGet, "/", nil) },
+			want:    http.StatusMethodNotAllowed,
+		},
+		{
+			name: "SpecVersion",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Set("Ce-Specversion", "0.3")
+				return r
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "MissingSpecVersion",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Del("Ce-Specversion")
+				return r
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Type",
+			request: func() *http.Request {
+				return newBinaryRequest("google.cloud.storage.bucket.v1.created", objectJSON)
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "ContentType",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Set("Content-Type", "application/protobuf")
+				return r
+			},
+			want: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "MissingSource",
+			request: func() *http.Request {
+				return newStructuredRequest(`{"specversion":"1.0","type":"google.cloud.storage.object.v1.deleted","id":"1","data":` + objectJSON + `}`)
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Time",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Set("Ce-Time", "yesterday")
+				return r
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Data",
+			request: func() *http.Request {
+				return newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, `{"bucket":`)
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "EmptyData",
+			request: func() *http.Request {
+				return newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, "")
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "MissingName",
+			request: func() *http.Request {
+				return newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, `{"bucket":"bucket"}`)
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "Structured",
+			request: func() *http.Request {
+				return newStructuredRequest(`{"specversion":"1.0","type":"google.cloud.storage.object.v1.deleted"}`)
+			},
+			want: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &recorder{}
+			server := &storagev1sdk.StorageEventCloudEventHandler{StorageObjectEventHandler: handler}
+
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, tc.request())
+
+			if w.Code != tc.want {
+				t.Errorf("ServeHTTP() status = %d, want %d: %s", w.Code, tc.want, w.Body)
+			}
+
+			if len(handler.events) != 0 {
+				t.Errorf("HandleStorageObjectEvent calls = %d, want 0", len(handler.events))
+			}
+		})
+	}
+}
+
+func TestStorageEventCloudEventHandlerPermanent(t *testing.T) {
+	cases := []struct {
+		name    string
+		request func() *http.Request
+		want    int
+	}{
+		{
+			name: "BodySize",
+			request: func() *http.Request {
+				return newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON+strings.Repeat(" ", 64))
+			},
+			want: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name: "ContentType",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Set("Content-Type", "application/protobuf")
+				return r
+			},
+			want: http.StatusUnsupportedMediaType,
+		},
+		{
+			name: "MissingID",
+			request: func() *http.Request {
+				r := newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON)
+				r.Header.Del("Ce-Id")
+				return r
+			},
+			want: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// the permanent errors are acknowledged only when asked
+			for _, acknowledge := range []bool{false, true} {
+				handler := &recorder{}
+				server := &storagev1sdk.StorageEventCloudEventHandler{
+					StorageObjectEventHandler:  handler,
+					AcknowledgePermanentErrors: acknowledge,
+					MaxBodySize:                int64(len(objectJSON)),
+				}
+
+				want := tc.want
+				if acknowledge {
+					want = http.StatusNoContent
+				}
+
+				w := httptest.NewRecorder()
+				server.ServeHTTP(w, tc.request())
+
+				if w.Code != want {
+					t.Errorf("ServeHTTP() status with AcknowledgePermanentErrors %v = %d, want %d", acknowledge, w.Code, want)
+				}
+
+				if len(handler.events) != 0 {
+					t.Errorf("HandleStorageObjectEvent calls = %d, want 0", len(handler.events))
+				}
+			}
+		})
+	}
+}
+
+func TestStorageEventCloudEventHandlerErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		err         error
+		acknowledge bool
+		want        int
+	}{
+		{"Retryable", storagev1.NewRetryableStorageEventError(errors.New("busy")), false, http.StatusServiceUnavailable},
+		{"Permanent", storagev1.NewPermanentStorageEventError(errors.New("bad")), false, http.StatusUnprocessableEntity},
+		{"PermanentAcknowledged", storagev1.NewPermanentStorageEventError(errors.New("bad")), true, http.StatusNoContent},
+		{"RetryableAcknowledged", storagev1.NewRetryableStorageEventError(errors.New("busy")), true, http.StatusServiceUnavailable},
+		{"DeadlineExceeded", context.DeadlineExceeded, false, http.StatusGatewayTimeout},
+		{"Unknown", errors.New("boom"), false, http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &storagev1sdk.StorageEventCloudEventHandler{
+				StorageObjectEventHandler:  &recorder{err: tc.err},
+				AcknowledgePermanentErrors: tc.acknowledge,
+			}
+
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, newBinaryRequest(storagev1.StorageObjectFinalizedCloudEventType, objectJSON))
+
+			if w.Code != tc.want {
+				t.Errorf("ServeHTTP() status = %d, want %d", w.Code, tc.want)
+			}
+		})
+	}
+
+	// the malformed events are acknowledged as permanent errors
+	server := &storagev1sdk.StorageEventCloudEventHandler{StorageObjectEventHandler: &recorder{}, AcknowledgePermanentErrors: true}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, newBinaryRequest("unknown", objectJSON))
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("ServeHTTP() status of a malformed event = %d, want %d", w.Code, http.StatusNoContent)
+	}
+}
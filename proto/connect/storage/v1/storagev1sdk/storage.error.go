@@ -0,0 +1,98 @@
+package storagev1sdk
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	connect "connectrpc.com/connect"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// statuses maps connect codes to the HTTP status codes of the push responses.
+var statuses = map[connect.Code]int{
+	connect.CodeCanceled:           499,
+	connect.CodeInvalidArgument:    http.StatusBadRequest,
+	connect.CodeDeadlineExceeded:   http.StatusGatewayTimeout,
+	connect.CodeNotFound:           http.StatusNotFound,
+	connect.CodeAlreadyExists:      http.StatusConflict,
+	connect.CodePermissionDenied:   http.StatusForbidden,
+	connect.CodeResourceExhausted:  http.StatusTooManyRequests,
+	connect.CodeFailedPrecondition: http.StatusUnprocessableEntity,
+	connect.CodeAborted:            http.StatusConflict,
+	connect.CodeUnimplemented:      http.StatusNotImplemented,
+	connect.CodeUnavailable:        http.StatusServiceUnavailable,
+	connect.CodeUnauthenticated:    http.StatusUnauthorized,
+}
+
+// newPubsubEvent decodes and validates the event of a Pub/Sub message. Its
+// errors are permanent invalid arguments.
+func newPubsubEvent(attrs map[string]string, data []byte, messageID string, publishTime time.Time) (storagev1.StorageObjectEvent, error) {
+	event, err := storagev1.NewStorageObjectPubsubEvent(attrs, data, messageID, publishTime)
+	if err == nil {
+		err = storagev1.ValidateStorageObjectEvent(event)
+	}
+
+	if err != nil {
+		return nil, invalid(err)
+	}
+
+	return event, nil
+}
+
+// invalid returns the error of an event that cannot be decoded.
+func invalid(err error) error {
+	return connect.NewError(connect.CodeInvalidArgument, storagev1.NewPermanentStorageEventError(err))
+}
+
+// toConnectError converts an error of a storage event handler to a connect
+// error. The permanent errors are failed preconditions and the retryable
+// errors are unavailable, so that they are told apart by the push
+// subscriptions and by the dead letter policies.
+func toConnectError(err error) error {
+	var cerr *connect.Error
+
+	switch {
+	case errors.As(err, &cerr):
+		return cerr
+	case errors.As(err, new(storagev1.StorageObjectEventValidationError)),
+		errors.Is(err, storagev1.ErrStorageEventTypeUnknown):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case storagev1.IsPermanentStorageEventError(err):
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	case storagev1.IsRetryableStorageEventError(err):
+		return connect.NewError(connect.CodeUnavailable, err)
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	default:
+		return connect.NewError(connect.CodeUnknown, err)
+	}
+}
+
+// acknowledge reports whether a failed event is acknowledged rather than
+// redelivered.
+func acknowledge(permanent bool, err error) bool {
+	return permanent && storagev1.IsPermanentStorageEventError(err)
+}
+
+// writeError writes the HTTP response of an error of a storage event handler.
+// An error that is acknowledged is written as a success.
+func writeError(w http.ResponseWriter, permanent bool, err error) {
+	if acknowledge(permanent, err) {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	cerr := toConnectError(err).(*connect.Error)
+
+	status, ok := statuses[cerr.Code()]
+	if !ok {
+		status = http.StatusInternalServerError
+	}
+
+	http.Error(w, cerr.Message(), status)
+}
@@ -3,8 +3,10 @@ package storagev1sdk
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
 
-	connect "connectrpc.com/connect"
 	pubsubv1 "github.com/connect-sdk/pubsub-api/proto/connect/pubsub/v1"
 
 	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
@@ -16,36 +18,127 @@ var _ pubsubv1.PubsubService = &StorageEventPubsubService{}
 type StorageEventPubsubService struct {
 	// StorageObjectEventHandler is a storage object event handler.
 	StorageObjectEventHandler storagev1.StorageObjectEventHandler
+	// AcknowledgePermanentErrors acknowledges the events that fail with a
+	// permanent error, such as the malformed events, instead of failing them
+	// so that they are redelivered until a dead letter policy takes them.
+	AcknowledgePermanentErrors bool
 }
 
 // PushPubsubMessage implements pubsubv1.PubsubService.
+//
+// The events that cannot be decoded or that fail validation are invalid
+// arguments. The errors of the handler are converted to connect errors: the
+// permanent errors are failed preconditions and the retryable errors are
+// unavailable.
 func (x *StorageEventPubsubService) PushPubsubMessage(ctx context.Context, r *pubsubv1.PushPubsubMessageRequest) (*pubsubv1.PushPubsubMessageResponse, error) {
-	var event storagev1.StorageObjectEvent
-	// prepare the event
-	switch r.Message.Attributes["eventType"] {
-	case storagev1.StorageObjectEventType_OBJECT_FINALIZE.String():
-		event = &storagev1.StorageObjectFinalizedEvent{}
-	case storagev1.StorageObjectEventType_OBJECT_ARCHIVE.String():
-		event = &storagev1.StorageObjectArchivedEvent{}
-	case storagev1.StorageObjectEventType_OBJECT_DELETE.String():
-		event = &storagev1.StorageObjectDeletedEvent{}
-	case storagev1.StorageObjectEventType_OBJECT_METADATA_UPDATE.String():
-		event = &storagev1.StorageObjectMetadataUpdatedEvent{}
-	default:
-		return nil, connect.NewError(connect.CodeInvalidArgument, storagev1.ErrStorageEventTypeUnknown)
-	}
-
-	resource := &storagev1.StorageObject{}
-	// unmarshal the object
-	if err := json.Unmarshal(r.Message.Data, resource); err != nil {
-		return nil, err
-	}
-
-	event.SetObject(resource)
+	// the pushed message carries neither its id nor its publish time
+	event, err := newPubsubEvent(r.Message.Attributes, r.Message.Data, "", time.Time{})
+	if err == nil {
+		ctx = storagev1.WithStorageObjectEventAttributes(ctx, r.Message.Attributes)
+		// handle the event
+		err = x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event)
+	}
+
+	if err != nil && !acknowledge(x.AcknowledgePermanentErrors, err) {
+		return nil, toConnectError(err)
+	}
+
+	return &pubsubv1.PushPubsubMessageResponse{}, nil
+}
+
+// DefaultPubsubPushMaxBodySize is the default size limit of the body of a
+// Pub/Sub push request: a Pub/Sub message of the largest size, whose data is
+// encoded in base64, with room for its attributes and its envelope.
+const DefaultPubsubPushMaxBodySize = 16 << 20
+
+var _ http.Handler = &StorageEventPubsubPushHandler{}
+
+// StorageEventPubsubPushHandler represents a storage handler of the raw
+// Pub/Sub push requests. Unlike StorageEventPubsubService, it passes the ID
+// and the publish time of the message in the envelope of the event.
+type StorageEventPubsubPushHandler struct {
+	// StorageObjectEventHandler is a storage object event handler.
+	StorageObjectEventHandler storagev1.StorageObjectEventHandler
+	// AcknowledgePermanentErrors acknowledges the events that fail with a
+	// permanent error, such as the malformed events, instead of failing them
+	// so that they are redelivered until a dead letter policy takes them.
+	AcknowledgePermanentErrors bool
+	// MaxBodySize limits the size of the body of a request. Zero uses
+	// DefaultPubsubPushMaxBodySize.
+	MaxBodySize int64
+}
+
+// ServeHTTP implements http.Handler.
+func (x *StorageEventPubsubPushHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	size := x.MaxBodySize
+	if size <= 0 {
+		size = DefaultPubsubPushMaxBodySize
+	}
+
+	request := &pushRequest{}
+	// decode the request
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, size)).Decode(request); err != nil {
+		// a body that is too large is too large on every delivery
+		if errors.As(err, new(*http.MaxBytesError)) {
+			x.reject(w, http.StatusRequestEntityTooLarge, err)
+			return
+		}
+
+		writeError(w, x.AcknowledgePermanentErrors, invalid(err))
+		return
+	}
+
+	message := request.Message
+	// the push requests carry both spellings of the fields
+	if message.MessageID == "" {
+		message.MessageID = message.MessageIDSnake
+	}
+
+	if message.PublishTime.IsZero() {
+		message.PublishTime = message.PublishTimeSnake
+	}
+
+	event, err := newPubsubEvent(message.Attributes, message.Data, message.MessageID, message.PublishTime)
+	if err != nil {
+		writeError(w, x.AcknowledgePermanentErrors, err)
+		return
+	}
+
+	ctx := storagev1.WithStorageObjectEventAttributes(r.Context(), message.Attributes)
 	// handle the event
 	if err := x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event); err != nil {
-		return nil, err
+		writeError(w, x.AcknowledgePermanentErrors, err)
+		return
 	}
 
-	return &pubsubv1.PushPubsubMessageResponse{}, nil
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reject responds to a request that fails before its event is decoded.
+func (x *StorageEventPubsubPushHandler) reject(w http.ResponseWriter, status int, err error) {
+	if x.AcknowledgePermanentErrors {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	http.Error(w, err.Error(), status)
+}
+
+// pushRequest represents the body of a Pub/Sub push request.
+type pushRequest struct {
+	Message struct {
+		Data             []byte            `json:"data"`
+		Attributes       map[string]string `json:"attributes"`
+		MessageID        string            `json:"messageId"`
+		MessageIDSnake   string            `json:"message_id"`
+		PublishTime      time.Time         `json:"publishTime"`
+		PublishTimeSnake time.Time         `json:"publish_time"`
+	} `json:"message"`
+	Subscription string `json:"subscription"`
 }
@@ -0,0 +1,178 @@
+package storagev1sdk_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	connect "connectrpc.com/connect"
+	pubsubv1 "github.com/connect-sdk/pubsub-api/proto/connect/pubsub/v1"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+var pushAttributes = map[string]string{
+	"eventType":        "OBJECT_FINALIZE",
+	"bucketId":         "bucket",
+	"objectId":         "a/b.txt",
+	"objectGeneration": "7",
+	"payloadFormat":    storagev1.StoragePayloadFormatJSON,
+}
+
+func newPushRequest(attrs map[string]string, data string) *http.Request {
+	body, _ := json.Marshal(map[string]any{
+		"subscription": "projects/p/subscriptions/s",
+		"message": map[string]any{
+			"messageId":   "42",
+			"publishTime": "2026-01-02T03:04:05Z",
+			"data":        []byte(data),
+			"attributes":  attrs,
+		},
+	})
+
+	return httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+}
+
+func TestStorageEventPubsubPushHandler(t *testing.T) {
+	handler := &recorder{}
+	server := &storagev1sdk.StorageEventPubsubPushHandler{StorageObjectEventHandler: handler}
+
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, newPushRequest(pushAttributes, objectJSON))
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("ServeHTTP() status = %d, want %d: %s", w.Code, http.StatusNoContent, w.Body)
+	}
+
+	if len(handler.events) != 1 {
+		t.Fatalf("HandleStorageObjectEvent calls = %d, want 1", len(handler.events))
+	}
+
+	envelope := handler.events[0].GetEnvelope()
+	if envelope.GetMessageId() != "42" {
+		t.Errorf("envelope.MessageId = %q, want %q", envelope.GetMessageId(), "42")
+	}
+
+	if want := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC); !envelope.GetPublishTime().AsTime().Equal(want) {
+		t.Errorf("envelope.PublishTime = %v, want %v", envelope.GetPublishTime().AsTime(), want)
+	}
+
+	if got := storagev1.StorageObjectEventAttributesFromContext(handler.ctx)["bucketId"]; got != "bucket" {
+		t.Errorf("attributes[bucketId] = %q, want %q", got, "bucket")
+	}
+}
+
+func TestStorageEventPubsubPushHandlerErrors(t *testing.T) {
+	cases := []struct {
+		name        string
+		request     func() *http.Request
+		err         error
+		acknowledge bool
+		size        int64
+		want        int
+	}{
+		{
+			name:    "Method",
+			request: func() *http.Request { return httptest.NewRequest(http.MethodPut, "/", nil) },
+			want:    http.StatusMethodNotAllowed,
+		},
+		{
+			name:    "Body",
+			request: func() *http.Request { return httptest.NewRequest(http.MethodPost, "/", strings.NewReader("{")) },
+			want:    http.StatusBadRequest,
+		},
+		{
+			name:    "TooLarge",
+			request: func() *http.Request { return newPushRequest(pushAttributes, objectJSON) },
+			size:    int64(len(objectJSON)),
+			want:    http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:        "TooLargeAcknowledged",
+			request:     func() *http.Request { return newPushRequest(pushAttributes, objectJSON) },
+			acknowledge: true,
+			size:        int64(len(objectJSON)),
+			want:        http.StatusNoContent,
+		},
+		{
+			name: "Type",
+			request: func() *http.Request {
+				return newPushRequest(map[string]string{"eventType": "BUCKET_CREATE"}, objectJSON)
+			},
+			want: http.StatusBadRequest,
+		},
+		{
+			name: "TypeAcknowledged",
+			request: func() *http.Request {
+				return newPushRequest(map[string]string{"eventType": "BUCKET_CREATE"}, objectJSON)
+			},
+			acknowledge: true,
+			want:        http.StatusNoContent,
+		},
+		{
+			name:    "Retryable",
+			request: func() *http.Request { return newPushRequest(pushAttributes, objectJSON) },
+			err:     storagev1.NewRetryableStorageEventError(errors.New("busy")),
+			want:    http.StatusServiceUnavailable,
+		},
+		{
+			name:    "Permanent",
+			request: func() *http.Request { return newPushRequest(pushAttributes, objectJSON) },
+			err:     storagev1.NewPermanentStorageEventError(errors.New("bad")),
+			want:    http.StatusUnprocessableEntity,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := &storagev1sdk.StorageEventPubsubPushHandler{
+				StorageObjectEventHandler:  &recorder{err: tc.err},
+				AcknowledgePermanentErrors: tc.acknowledge,
+				MaxBodySize:                tc.size,
+			}
+
+			w := httptest.NewRecorder()
+			server.ServeHTTP(w, tc.request())
+
+			if w.Code != tc.want {
+				t.Errorf("ServeHTTP() status = %d, want %d: %s", w.Code, tc.want, w.Body)
+			}
+		})
+	}
+}
+
+func TestStorageEventPubsubService(t *testing.T) {
+	cases := []struct {
+		name  string
+		attrs map[string]string
+		err   error
+		want  connect.Code
+	}{
+		{"Success", pushAttributes, nil, 0},
+		{"Invalid", map[string]string{"eventType": "BUCKET_CREATE"}, nil, connect.CodeInvalidArgument},
+		{"Permanent", pushAttributes, storagev1.NewPermanentStorageEventError(errors.New("bad")), connect.CodeFailedPrecondition},
+		{"Retryable", pushAttributes, storagev1.NewRetryableStorageEventError(errors.New("busy")), connect.CodeUnavailable},
+		{"Canceled", pushAttributes, context.Canceled, connect.CodeCanceled},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			service := &storagev1sdk.StorageEventPubsubService{StorageObjectEventHandler: &recorder{err: tc.err}}
+
+			_, err := service.PushPubsubMessage(context.Background(), &pubsubv1.PushPubsubMessageRequest{
+				Message: &pubsubv1.PubsubMessage{Attributes: tc.attrs, Data: []byte(objectJSON)},
+			})
+
+			if got := connect.CodeOf(err); err != nil && got != tc.want || err == nil && tc.want != 0 {
+				t.Errorf("PushPubsubMessage() error = %v, want code %v", err, tc.want)
+			}
+		})
+	}
+}
@@ -362,6 +362,7 @@ func (x *StorageWriter) ObjectAttrs() *storage.ObjectAttrs {
 // SetCRC32C implements storagev1.StorageWriter.
 func (x *StorageWriter) SetCRC32C(v uint32) {
 	x.writer.CRC32C = v
+	x.writer.SendCRC32C = true
 }
 
 // SetChunkSize implements storagev1.StorageWriter.
@@ -392,9 +393,7 @@ type StorageCopier struct {
 
 // ObjectAttrs implements storagev1.StorageCopier.
 func (x *StorageCopier) ObjectAttrs() *storage.ObjectAttrs {
-	result := x.copier.ObjectAttrs
-	// done!
-	return (*storage.ObjectAttrs)(&result)
+	return (*storage.ObjectAttrs)(&x.copier.ObjectAttrs)
 }
 
 // Run implements storagev1.StorageCopier.
@@ -430,9 +429,7 @@ type StorageComposer struct {
 
 // ObjectAttrs implements storagev1.StorageComposer.
 func (x *StorageComposer) ObjectAttrs() *storage.ObjectAttrs {
-	result := x.composer.ObjectAttrs
-	// done!
-	return (*storage.ObjectAttrs)(&result)
+	return (*storage.ObjectAttrs)(&x.composer.ObjectAttrs)
 }
 
 // Run implements storagev1.StorageComposer.
@@ -0,0 +1,118 @@
+package storagev1sdk_test
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	storage "cloud.google.com/go/storage"
+	option "google.golang.org/api/option"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1emu"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1mem"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+// newBucket returns a bucket of an emulator on top of a memory backend.
+func newBucket(t *testing.T) storagev1.StorageBucketHandle {
+	t.Helper()
+
+	server := httptest.NewServer(storagev1emu.NewStorageEmulator(storagev1mem.NewStorageServiceClient()))
+	t.Cleanup(server.Close)
+
+	client, err := storagev1sdk.NewStorageServiceClient(context.Background(),
+		option.WithEndpoint(server.URL+"/storage/v1/"),
+		option.WithoutAuthentication(),
+	)
+	if err != nil {
+		t.Fatalf("NewStorageServiceClient: %v", err)
+	}
+
+	t.Cleanup(func() { client.Close() })
+
+	bucket := client.Bucket("bucket")
+	if err := bucket.Create(context.Background(), "project", nil); err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	return bucket
+}
+
+func writeObject(t *testing.T, handle storagev1.StorageObjectHandle, data string) {
+	t.Helper()
+
+	writer := handle.NewWriter(context.Background())
+	if _, err := io.WriteString(writer, data); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+}
+
+// setAttrs sets the attributes of a new object, as the callers of ObjectAttrs
+// do on every backend.
+func setAttrs(attrs *storage.ObjectAttrs) {
+	attrs.ContentType = "text/plain"
+	attrs.Metadata = map[string]string{"owner": "team"}
+}
+
+// checkAttrs checks that an object has the attributes of setAttrs.
+func checkAttrs(t *testing.T, handle storagev1.StorageObjectHandle) {
+	t.Helper()
+
+	attrs, err := handle.Attrs(context.Background())
+	if err != nil {
+		t.Fatalf("Attrs: %v", err)
+	}
+
+	if attrs.ContentType != "text/plain" || attrs.Metadata["owner"] != "team" {
+		t.Errorf("Attrs() = ContentType %q, Metadata %v, want text/plain and the owner", attrs.ContentType, attrs.Metadata)
+	}
+}
+
+func TestStorageObjectWriterObjectAttrs(t *testing.T) {
+	bucket := newBucket(t)
+
+	writer := bucket.Object("a").NewWriter(context.Background())
+	setAttrs(writer.ObjectAttrs())
+
+	io.WriteString(writer, "hello")
+	if err := writer.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	checkAttrs(t, bucket.Object("a"))
+}
+
+func TestStorageObjectCopierObjectAttrs(t *testing.T) {
+	bucket := newBucket(t)
+	writeObject(t, bucket.Object("a"), "hello")
+
+	copier := bucket.Object("b").CopierFrom(bucket.Object("a"))
+	setAttrs(copier.ObjectAttrs())
+
+	if _, err := copier.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	checkAttrs(t, bucket.Object("b"))
+}
+
+func TestStorageObjectComposerObjectAttrs(t *testing.T) {
+	bucket := newBucket(t)
+	writeObject(t, bucket.Object("a"), "hello ")
+	writeObject(t, bucket.Object("b"), "world")
+
+	composer := bucket.Object("c").ComposerFrom(bucket.Object("a"), bucket.Object("b"))
+	setAttrs(composer.ObjectAttrs())
+
+	if _, err := composer.Run(context.Background()); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	checkAttrs(t, bucket.Object("c"))
+}
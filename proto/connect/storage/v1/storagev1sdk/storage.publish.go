@@ -0,0 +1,98 @@
+package storagev1sdk
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	pubsub "cloud.google.com/go/pubsub/v2"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+var _ storagev1.StorageObjectEventHandler = &StorageEventPubsubPublisher{}
+
+// StorageEventPubsubPublisher represents a storage object event handler that
+// publishes every event to a Pub/Sub topic as a Cloud Storage notification, so
+// that the subscribers of the topic receive it as a notification of the
+// bucket. It is used to send the events of a StorageObjectEventBackfill to
+// the processors that consume the notifications.
+//
+// Example:
+//
+//	client, err := pubsub.NewClient(ctx, "my-project")
+//	if err != nil {
+//		return err
+//	}
+//
+//	publisher := client.Publisher("my-topic")
+//	defer publisher.Stop()
+//
+//	backfill := &storagev1.StorageObjectEventBackfill{
+//		Bucket:                    bucket,
+//		StorageObjectEventHandler: &storagev1sdk.StorageEventPubsubPublisher{Publisher: publisher},
+//	}
+type StorageEventPubsubPublisher struct {
+	// Publisher is the publisher of the topic.
+	Publisher *pubsub.Publisher
+	// Attributes are the custom attributes of the messages.
+	Attributes map[string]string
+}
+
+// HandleStorageObjectEvent implements storagev1.StorageObjectEventHandler. It
+// returns when the message has been published.
+func (x *StorageEventPubsubPublisher) HandleStorageObjectEvent(ctx context.Context, event storagev1.StorageObjectEvent) error {
+	envelope := event.GetEnvelope()
+
+	payload := envelope.GetPayloadFormat()
+	if payload == "" {
+		payload = storagev1.StoragePayloadFormatJSON
+	}
+
+	attrs := make(map[string]string, len(x.Attributes)+8)
+	// the custom attributes do not override the attributes of the service
+	for k, v := range x.Attributes {
+		attrs[k] = v
+	}
+
+	attrs["eventType"] = storagev1.StorageObjectEventTypeOf(event).String()
+	attrs["payloadFormat"] = payload
+	attrs["bucketId"] = event.GetObject().GetBucket()
+	attrs["objectId"] = event.GetObject().GetName()
+	attrs["objectGeneration"] = strconv.FormatInt(event.GetObject().GetGeneration(), 10)
+
+	if config := envelope.GetNotificationConfig(); config != "" {
+		attrs["notificationConfig"] = config
+	}
+
+	if envelope.GetEventTime() != nil {
+		attrs["eventTime"] = envelope.GetEventTime().AsTime().Format(time.RFC3339Nano)
+	}
+
+	if generation := envelope.GetOverwroteGeneration(); generation != 0 {
+		attrs["overwroteGeneration"] = strconv.FormatInt(generation, 10)
+	}
+
+	if generation := envelope.GetOverwrittenByGeneration(); generation != 0 {
+		attrs["overwrittenByGeneration"] = strconv.FormatInt(generation, 10)
+	}
+
+	msg := &pubsub.Message{Attributes: attrs}
+	// marshal the object
+	if payload != storagev1.StoragePayloadFormatNone {
+		data, err := event.GetObject().MarshalJSON()
+		if err != nil {
+			return err
+		}
+
+		msg.Data = data
+	}
+
+	// publish the message
+	if _, err := x.Publisher.Publish(ctx, msg).Get(ctx); err != nil {
+		return storagev1.NewRetryableStorageEventError(err)
+	}
+
+	// done!
+	return nil
+}
@@ -0,0 +1,134 @@
+package storagev1sdk_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+func TestStorageEventPubsubPublisher(t *testing.T) {
+	eventTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		event    storagev1.StorageObjectEvent
+		want     map[string]string
+		wantData bool
+	}{
+		{
+			name: "Finalized",
+			event: &storagev1.StorageObjectFinalizedEvent{
+				Object: &storagev1.StorageObject{Bucket: "bucket", Name: "a", Generation: 3},
+				Envelope: &storagev1.StorageObjectEventEnvelope{
+					NotificationConfig:  "projects/_/buckets/bucket/notificationConfigs/1",
+					EventTime:           timestamppb.New(eventTime),
+					OverwroteGeneration: 2,
+				},
+			},
+			want: map[string]string{
+				"eventType":           "OBJECT_FINALIZE",
+				"payloadFormat":       storagev1.StoragePayloadFormatJSON,
+				"bucketId":            "bucket",
+				"objectId":            "a",
+				"objectGeneration":    "3",
+				"notificationConfig":  "projects/_/buckets/bucket/notificationConfigs/1",
+				"eventTime":           "2026-01-01T00:00:00Z",
+				"overwroteGeneration": "2",
+				"source":              "backfill",
+			},
+			wantData: true,
+		},
+		{
+			name: "Deleted",
+			event: &storagev1.StorageObjectDeletedEvent{
+				Object: &storagev1.StorageObject{Bucket: "bucket", Name: "a", Generation: 3},
+				Envelope: &storagev1.StorageObjectEventEnvelope{
+					PayloadFormat:           storagev1.StoragePayloadFormatNone,
+					OverwrittenByGeneration: 4,
+				},
+			},
+			want: map[string]string{
+				"eventType":               "OBJECT_DELETE",
+				"payloadFormat":           storagev1.StoragePayloadFormatNone,
+				"bucketId":                "bucket",
+				"objectId":                "a",
+				"objectGeneration":        "3",
+				"overwrittenByGeneration": "4",
+				"source":                  "backfill",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, client := newPubsubClient(t)
+
+			publisher := client.Publisher(topicName)
+			defer publisher.Stop()
+
+			handler := &storagev1sdk.StorageEventPubsubPublisher{
+				Publisher: publisher,
+				// the custom attributes do not override the attributes of the
+				// service
+				Attributes: map[string]string{"source": "backfill", "eventType": "custom"},
+			}
+
+			if err := handler.HandleStorageObjectEvent(context.Background(), tc.event); err != nil {
+				t.Fatalf("HandleStorageObjectEvent: %v", err)
+			}
+
+			messages := server.Messages()
+			if len(messages) != 1 {
+				t.Fatalf("messages = %d, want 1", len(messages))
+			}
+
+			attrs := messages[0].Attributes
+			if len(attrs) != len(tc.want) {
+				t.Errorf("attributes = %v, want %v", attrs, tc.want)
+			}
+
+			for key, want := range tc.want {
+				if got := attrs[key]; got != want {
+					t.Errorf("attribute %s = %q, want %q", key, got, want)
+				}
+			}
+
+			if !tc.wantData {
+				if len(messages[0].Data) != 0 {
+					t.Errorf("data = %s, want none", messages[0].Data)
+				}
+
+				return
+			}
+
+			var object map[string]any
+			if err := json.Unmarshal(messages[0].Data, &object); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+
+			if object["bucket"] != "bucket" || object["name"] != "a" {
+				t.Errorf("data = %s, want the object", messages[0].Data)
+			}
+		})
+	}
+}
+
+func TestStorageEventPubsubPublisherError(t *testing.T) {
+	_, client := newPubsubClient(t)
+
+	publisher := client.Publisher("projects/project/topics/missing")
+	defer publisher.Stop()
+
+	handler := &storagev1sdk.StorageEventPubsubPublisher{Publisher: publisher}
+
+	event := &storagev1.StorageObjectFinalizedEvent{Object: &storagev1.StorageObject{Bucket: "bucket", Name: "a"}}
+	if err := handler.HandleStorageObjectEvent(context.Background(), event); !storagev1.IsRetryableStorageEventError(err) {
+		t.Errorf("HandleStorageObjectEvent() = %v, want a retryable error", err)
+	}
+}
@@ -0,0 +1,123 @@
+package storagev1sdk
+
+import (
+	"context"
+	"time"
+
+	pubsub "cloud.google.com/go/pubsub/v2"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+)
+
+// StorageEventPubsubReceiver represents a storage consumer of a Pub/Sub pull
+// subscription, for the workers that cannot receive the push requests. It
+// decodes the messages as StorageEventPubsubPushHandler does, and it passes
+// the ID and the publish time of the message in the envelope of the event.
+//
+// A message is acknowledged when the handler succeeds and negatively
+// acknowledged when the handler fails, so that it is redelivered. A message
+// that is not a valid storage notification fails with a permanent error
+// without calling the handler. A message that fails with a permanent error is
+// acknowledged when AcknowledgePermanentErrors is set, and it is otherwise
+// redelivered until a dead letter policy takes it, as the push handlers do.
+//
+// The settings of the receiver override the ReceiveSettings of the subscriber
+// while Receive runs, and the subscriber gets its own settings back when
+// Receive returns.
+//
+// The receiver works against the Pub/Sub service, the Pub/Sub emulator when
+// PUBSUB_EMULATOR_HOST is set, and the in-process pstest server.
+//
+// Example:
+//
+//	client, err := pubsub.NewClient(ctx, "my-project")
+//	if err != nil {
+//		return err
+//	}
+//
+//	receiver := &storagev1sdk.StorageEventPubsubReceiver{
+//		Subscriber:                client.Subscriber("my-subscription"),
+//		StorageObjectEventHandler: handler,
+//		MaxOutstandingMessages:    10,
+//	}
+//
+//	return receiver.Receive(ctx)
+type StorageEventPubsubReceiver struct {
+	// Subscriber is the subscriber of the pull subscription.
+	Subscriber *pubsub.Subscriber
+	// StorageObjectEventHandler is a storage object event handler.
+	StorageObjectEventHandler storagev1.StorageObjectEventHandler
+	// NumGoroutines is the number of the streams that pull the messages. Zero
+	// keeps the setting of the subscriber.
+	NumGoroutines int
+	// MaxOutstandingMessages is the maximum number of the messages that are
+	// handled and not yet acknowledged, which bounds the number of the
+	// concurrent handlers. Zero keeps the setting of the subscriber, and a
+	// negative value means no limit.
+	MaxOutstandingMessages int
+	// MaxOutstandingBytes is the maximum size of the messages that are handled
+	// and not yet acknowledged. Zero keeps the setting of the subscriber, and
+	// a negative value means no limit.
+	MaxOutstandingBytes int
+	// MaxExtension is the maximum period for which the ack deadline of a
+	// message is extended while it is handled. Zero keeps the setting of the
+	// subscriber, and a negative value means no extension.
+	MaxExtension time.Duration
+	// MaxDurationPerAckExtension is the maximum period by which the ack
+	// deadline is extended at a time. Zero keeps the setting of the
+	// subscriber.
+	MaxDurationPerAckExtension time.Duration
+	// AcknowledgePermanentErrors acknowledges the messages whose handler fails
+	// with a permanent error, instead of redelivering them until a dead letter
+	// policy takes them.
+	AcknowledgePermanentErrors bool
+}
+
+// Receive pulls the messages and handles their events until the context is
+// done or the subscriber fails. It returns nil when the context is done.
+func (x *StorageEventPubsubReceiver) Receive(ctx context.Context) error {
+	settings := &x.Subscriber.ReceiveSettings
+	// restore the settings of the subscriber when done
+	defer func(saved pubsub.ReceiveSettings) {
+		*settings = saved
+	}(*settings)
+	// override the settings of the subscriber
+	if x.NumGoroutines != 0 {
+		settings.NumGoroutines = x.NumGoroutines
+	}
+
+	if x.MaxOutstandingMessages != 0 {
+		settings.MaxOutstandingMessages = x.MaxOutstandingMessages
+	}
+
+	if x.MaxOutstandingBytes != 0 {
+		settings.MaxOutstandingBytes = x.MaxOutstandingBytes
+	}
+
+	if x.MaxExtension != 0 {
+		settings.MaxExtension = x.MaxExtension
+	}
+
+	if x.MaxDurationPerAckExtension != 0 {
+		settings.MaxDurationPerAckExtension = x.MaxDurationPerAckExtension
+	}
+
+	// done!
+	return x.Subscriber.Receive(ctx, x.receive)
+}
+
+func (x *StorageEventPubsubReceiver) receive(ctx context.Context, message *pubsub.Message) {
+	event, err := newPubsubEvent(message.Attributes, message.Data, message.ID, message.PublishTime)
+	if err == nil {
+		ctx = storagev1.WithStorageObjectEventAttributes(ctx, message.Attributes)
+		// handle the event
+		err = x.StorageObjectEventHandler.HandleStorageObjectEvent(ctx, event)
+	}
+
+	if err != nil && !acknowledge(x.AcknowledgePermanentErrors, err) {
+		message.Nack()
+		return
+	}
+
+	message.Ack()
+}
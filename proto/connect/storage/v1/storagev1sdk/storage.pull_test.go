@@ -0,0 +1,191 @@
+package storagev1sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pubsub "cloud.google.com/go/pubsub/v2"
+	pubsubpb "cloud.google.com/go/pubsub/v2/apiv1/pubsubpb"
+	"cloud.google.com/go/pubsub/v2/pstest"
+	option "google.golang.org/api/option"
+	grpc "google.golang.org/grpc"
+	insecure "google.golang.org/grpc/credentials/insecure"
+
+	storagev1 "github.com/connect-sdk/storage-api/proto/connect/storage/v1"
+	"github.com/connect-sdk/storage-api/proto/connect/storage/v1/storagev1sdk"
+)
+
+const (
+	topicName        = "projects/project/topics/topic"
+	subscriptionName = "projects/project/subscriptions/subscription"
+)
+
+// newPubsubClient returns a client of the in-process Pub/Sub server, which
+// has a topic and a subscription of it.
+func newPubsubClient(t *testing.T) (*pstest.Server, *pubsub.Client) {
+	t.Helper()
+
+	server := pstest.NewServer()
+	t.Cleanup(func() { server.Close() })
+
+	ctx := context.Background()
+
+	if _, err := server.GServer.CreateTopic(ctx, &pubsubpb.Topic{Name: topicName}); err != nil {
+		t.Fatalf("CreateTopic: %v", err)
+	}
+
+	if _, err := server.GServer.CreateSubscription(ctx, &pubsubpb.Subscription{Name: subscriptionName, Topic: topicName, AckDeadlineSeconds: 10}); err != nil {
+		t.Fatalf("CreateSubscription: %v", err)
+	}
+
+	conn, err := grpc.NewClient(server.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+
+	client, err := pubsub.NewClient(ctx, "project", option.WithGRPCConn(conn))
+	if err != nil {
+		t.Fatalf("pubsub.NewClient: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+
+	return server, client
+}
+
+// newSubscriber returns the subscriber of a subscription of the in-process
+// Pub/Sub server.
+func newSubscriber(t *testing.T) (*pstest.Server, *pubsub.Subscriber) {
+	t.Helper()
+
+	server, client := newPubsubClient(t)
+	return server, client.Subscriber(subscriptionName)
+}
+
+// receive runs the receiver until the message satisfies done.
+func receive(t *testing.T, server *pstest.Server, receiver *storagev1sdk.StorageEventPubsubReceiver, done func(*pstest.Message) bool) *pstest.Message {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	errc := make(chan error, 1)
+	go func() { errc <- receiver.Receive(ctx) }()
+
+	for {
+		if messages := server.Messages(); len(messages) == 1 && done(messages[0]) {
+			cancel()
+
+			if err := <-errc; err != nil {
+				t.Fatalf("Receive: %v", err)
+			}
+
+			return messages[0]
+		}
+
+		select {
+		case <-ctx.Done():
+			t.Fatalf("Receive: %v", ctx.Err())
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func acked(m *pstest.Message) bool {
+	return m.Acks > 0
+}
+
+// nacked reports whether the message is negatively acknowledged, which sets
+// its ack deadline to zero.
+func nacked(m *pstest.Message) bool {
+	for _, modack := range m.Modacks {
+		if modack.AckDeadline == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func TestStorageEventPubsubReceiver(t *testing.T) {
+	server, subscriber := newSubscriber(t)
+	handler := &recorder{}
+
+	id := server.Publish(topicName, []byte(objectJSON), pushAttributes)
+
+	receive(t, server, &storagev1sdk.StorageEventPubsubReceiver{
+		Subscriber:                subscriber,
+		StorageObjectEventHandler: handler,
+	}, acked)
+
+	if len(handler.events) != 1 {
+		t.Fatalf("HandleStorageObjectEvent calls = %d, want 1", len(handler.events))
+	}
+
+	envelope := handler.events[0].GetEnvelope()
+	if envelope.GetMessageId() != id {
+		t.Errorf("envelope.MessageId = %q, want %q", envelope.GetMessageId(), id)
+	}
+
+	if envelope.GetPublishTime() == nil {
+		t.Errorf("envelope.PublishTime = nil, want the publish time")
+	}
+}
+
+func TestStorageEventPubsubReceiverErrors(t *testing.T) {
+	invalid := map[string]string{"eventType": "BUCKET_CREATE"}
+
+	cases := []struct {
+		name        string
+		attrs       map[string]string
+		err         error
+		acknowledge bool
+		done        func(*pstest.Message) bool
+	}{
+		{"Invalid", invalid, nil, false, nacked},
+		{"InvalidAcknowledged", invalid, nil, true, acked},
+		{"Permanent", pushAttributes, storagev1.NewPermanentStorageEventError(errors.New("bad")), false, nacked},
+		{"PermanentAcknowledged", pushAttributes, storagev1.NewPermanentStorageEventError(errors.New("bad")), true, acked},
+		{"RetryableAcknowledged", pushAttributes, storagev1.NewRetryableStorageEventError(errors.New("busy")), true, nacked},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			server, subscriber := newSubscriber(t)
+			server.Publish(topicName, []byte(objectJSON), tc.attrs)
+
+			message := receive(t, server, &storagev1sdk.StorageEventPubsubReceiver{
+				Subscriber:                 subscriber,
+				StorageObjectEventHandler:  &recorder{err: tc.err},
+				AcknowledgePermanentErrors: tc.acknowledge,
+			}, tc.done)
+
+			if acked(message) && nacked(message) {
+				t.Errorf("message acks = %d and nacks, want either", message.Acks)
+			}
+		})
+	}
+}
+
+func TestStorageEventPubsubReceiverSettings(t *testing.T) {
+	server, subscriber := newSubscriber(t)
+	server.Publish(topicName, []byte(objectJSON), pushAttributes)
+
+	subscriber.ReceiveSettings.MaxOutstandingMessages = 7
+	subscriber.ReceiveSettings.MaxExtension = time.Minute
+
+	want := subscriber.ReceiveSettings
+
+	receive(t, server, &storagev1sdk.StorageEventPubsubReceiver{
+		Subscriber:                subscriber,
+		StorageObjectEventHandler: &recorder{},
+		NumGoroutines:             1,
+		MaxOutstandingMessages:    1,
+		MaxExtension:              time.Second,
+	}, acked)
+
+	if subscriber.ReceiveSettings != want {
+		t.Errorf("ReceiveSettings = %+v, want %+v", subscriber.ReceiveSettings, want)
+	}
+}